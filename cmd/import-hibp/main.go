@@ -0,0 +1,41 @@
+// import-hibp builds the breach index used by CheckBreached from a directory of Pwned Passwords
+// SHA-1 range files, e.g. as downloaded by https://github.com/HaveIBeenPwned/PwnedPasswordsDownloader.
+//
+//	go run ./cmd/import-hibp -src ./pwnedpasswords -out ./breach.idx
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/AleksZelenchuk/vault-server/pkg/breach"
+)
+
+func main() {
+	src := flag.String("src", "", "directory with <PREFIX>.txt range files")
+	out := flag.String("out", "", "index file to write, e.g. the BREACH_INDEX_PATH of the server")
+	flag.Parse()
+	if *src == "" || *out == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	stats, err := breach.Import(*src, *out, func(prefix int) {
+		if prefix%0x10000 == 0xFFFF {
+			fmt.Printf("imported ranges up to %05X\n", prefix)
+		}
+	})
+	if err != nil {
+		log.Fatalf("import failed: %v", err)
+	}
+
+	fmt.Printf("wrote %d hashes to %s\n", stats.Records, *out)
+	if stats.Skipped > 0 {
+		fmt.Printf("skipped %d invalid or padding lines\n", stats.Skipped)
+	}
+	if stats.MissingRanges > 0 {
+		fmt.Printf("warning: %d ranges were missing, passwords in them will not be detected\n", stats.MissingRanges)
+	}
+}
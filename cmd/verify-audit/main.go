@@ -0,0 +1,45 @@
+// verify-audit walks the audit_events hash chain and reports gaps, modified events and
+// checkpoints that no longer match. It exits with status 1 when any problem is found.
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/AleksZelenchuk/vault-server/pkg/config"
+	"github.com/AleksZelenchuk/vault-server/pkg/storage"
+	"github.com/jmoiron/sqlx"
+	_ "github.com/lib/pq"
+)
+
+func main() {
+	cfg := config.LoadConfig()
+
+	db, err := sqlx.Connect("postgres", cfg.DatabaseURL)
+	if err != nil {
+		log.Fatalf("DB connection failed: %v", err)
+	}
+	defer func() { _ = db.Close() }()
+
+	report, err := storage.NewAuditStore(db).VerifyChain(context.Background())
+	if err != nil {
+		log.Fatalf("audit verification failed: %v", err)
+	}
+
+	fmt.Printf("checked %d events (chain head %d, %d checkpoints)\n", report.Checked, report.Head, report.Checkpoints)
+	if report.Unchained > 0 {
+		fmt.Printf("%d events were recorded before chaining was enabled and cannot be verified\n", report.Unchained)
+	}
+	if len(report.Problems) == 0 {
+		fmt.Println("audit chain OK")
+		return
+	}
+
+	for _, p := range report.Problems {
+		fmt.Printf("seq %d: %s\n", p.Seq, p.Problem)
+	}
+	fmt.Printf("%d problems found\n", len(report.Problems))
+	os.Exit(1)
+}
@@ -7,7 +7,6 @@
 package vaultpb
 
 import (
-	"fmt"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
@@ -22,35 +21,5409 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// EntryType selects the payload of an entry. Logins keep their secret in password and secure notes in
+// notes, the other types carry a typed payload
+type EntryType int32
+
+const (
+	EntryType_ENTRY_TYPE_LOGIN       EntryType = 0
+	EntryType_ENTRY_TYPE_SECURE_NOTE EntryType = 1
+	EntryType_ENTRY_TYPE_CARD        EntryType = 2
+	EntryType_ENTRY_TYPE_IDENTITY    EntryType = 3
+	EntryType_ENTRY_TYPE_SSH_KEY     EntryType = 4
+	EntryType_ENTRY_TYPE_API_KEY     EntryType = 5
+)
+
+// Enum value maps for EntryType.
+var (
+	EntryType_name = map[int32]string{
+		0: "ENTRY_TYPE_LOGIN",
+		1: "ENTRY_TYPE_SECURE_NOTE",
+		2: "ENTRY_TYPE_CARD",
+		3: "ENTRY_TYPE_IDENTITY",
+		4: "ENTRY_TYPE_SSH_KEY",
+		5: "ENTRY_TYPE_API_KEY",
+	}
+	EntryType_value = map[string]int32{
+		"ENTRY_TYPE_LOGIN":       0,
+		"ENTRY_TYPE_SECURE_NOTE": 1,
+		"ENTRY_TYPE_CARD":        2,
+		"ENTRY_TYPE_IDENTITY":    3,
+		"ENTRY_TYPE_SSH_KEY":     4,
+		"ENTRY_TYPE_API_KEY":     5,
+	}
+)
+
+func (x EntryType) Enum() *EntryType {
+	p := new(EntryType)
+	*p = x
+	return p
+}
+
+func (x EntryType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EntryType) Descriptor() protoreflect.EnumDescriptor {
+	return file_vault_proto_enumTypes[0].Descriptor()
+}
+
+func (EntryType) Type() protoreflect.EnumType {
+	return &file_vault_proto_enumTypes[0]
+}
+
+func (x EntryType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EntryType.Descriptor instead.
+func (EntryType) EnumDescriptor() ([]byte, []int) {
+	return file_vault_proto_rawDescGZIP(), []int{0}
+}
+
+type CustomFieldType int32
+
+const (
+	CustomFieldType_CUSTOM_FIELD_TYPE_TEXT    CustomFieldType = 0
+	CustomFieldType_CUSTOM_FIELD_TYPE_HIDDEN  CustomFieldType = 1
+	CustomFieldType_CUSTOM_FIELD_TYPE_BOOLEAN CustomFieldType = 2
+	CustomFieldType_CUSTOM_FIELD_TYPE_LINKED  CustomFieldType = 3
+)
+
+// Enum value maps for CustomFieldType.
+var (
+	CustomFieldType_name = map[int32]string{
+		0: "CUSTOM_FIELD_TYPE_TEXT",
+		1: "CUSTOM_FIELD_TYPE_HIDDEN",
+		2: "CUSTOM_FIELD_TYPE_BOOLEAN",
+		3: "CUSTOM_FIELD_TYPE_LINKED",
+	}
+	CustomFieldType_value = map[string]int32{
+		"CUSTOM_FIELD_TYPE_TEXT":    0,
+		"CUSTOM_FIELD_TYPE_HIDDEN":  1,
+		"CUSTOM_FIELD_TYPE_BOOLEAN": 2,
+		"CUSTOM_FIELD_TYPE_LINKED":  3,
+	}
+)
+
+func (x CustomFieldType) Enum() *CustomFieldType {
+	p := new(CustomFieldType)
+	*p = x
+	return p
+}
+
+func (x CustomFieldType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CustomFieldType) Descriptor() protoreflect.EnumDescriptor {
+	return file_vault_proto_enumTypes[1].Descriptor()
+}
+
+func (CustomFieldType) Type() protoreflect.EnumType {
+	return &file_vault_proto_enumTypes[1]
+}
+
+func (x CustomFieldType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CustomFieldType.Descriptor instead.
+func (CustomFieldType) EnumDescriptor() ([]byte, []int) {
+	return file_vault_proto_rawDescGZIP(), []int{1}
+}
+
+// UriMatch is how URLs are matched against an entry URI for autofill
+type UriMatch int32
+
+const (
+	// URI_MATCH_BASE_DOMAIN matches URLs of the same registrable domain, e.g. https://login.bank.com for
+	// bank.com, using the public suffix list
+	UriMatch_URI_MATCH_BASE_DOMAIN UriMatch = 0
+	// URI_MATCH_HOST matches URLs with the same host and port, a missing port is the default port of the scheme
+	UriMatch_URI_MATCH_HOST UriMatch = 1
+	// URI_MATCH_STARTS_WITH matches URLs on the same host starting with the URI, which must include a scheme
+	UriMatch_URI_MATCH_STARTS_WITH UriMatch = 2
+	// URI_MATCH_REGEX matches URLs matching the URI as an RE2 regular expression
+	UriMatch_URI_MATCH_REGEX UriMatch = 3
+	// URI_MATCH_NEVER never matches, the URI is only kept for reference
+	UriMatch_URI_MATCH_NEVER UriMatch = 4
+)
+
+// Enum value maps for UriMatch.
+var (
+	UriMatch_name = map[int32]string{
+		0: "URI_MATCH_BASE_DOMAIN",
+		1: "URI_MATCH_HOST",
+		2: "URI_MATCH_STARTS_WITH",
+		3: "URI_MATCH_REGEX",
+		4: "URI_MATCH_NEVER",
+	}
+	UriMatch_value = map[string]int32{
+		"URI_MATCH_BASE_DOMAIN": 0,
+		"URI_MATCH_HOST":        1,
+		"URI_MATCH_STARTS_WITH": 2,
+		"URI_MATCH_REGEX":       3,
+		"URI_MATCH_NEVER":       4,
+	}
+)
+
+func (x UriMatch) Enum() *UriMatch {
+	p := new(UriMatch)
+	*p = x
+	return p
+}
+
+func (x UriMatch) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UriMatch) Descriptor() protoreflect.EnumDescriptor {
+	return file_vault_proto_enumTypes[2].Descriptor()
+}
+
+func (UriMatch) Type() protoreflect.EnumType {
+	return &file_vault_proto_enumTypes[2]
+}
+
+func (x UriMatch) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UriMatch.Descriptor instead.
+func (UriMatch) EnumDescriptor() ([]byte, []int) {
+	return file_vault_proto_rawDescGZIP(), []int{2}
+}
+
+type SecretField int32
+
+const (
+	SecretField_SECRET_FIELD_PASSWORD                 SecretField = 0
+	SecretField_SECRET_FIELD_TOTP                     SecretField = 1
+	SecretField_SECRET_FIELD_CARD_NUMBER              SecretField = 2
+	SecretField_SECRET_FIELD_CARD_CODE                SecretField = 3
+	SecretField_SECRET_FIELD_IDENTITY_SSN             SecretField = 4
+	SecretField_SECRET_FIELD_IDENTITY_PASSPORT_NUMBER SecretField = 5
+	SecretField_SECRET_FIELD_IDENTITY_LICENSE_NUMBER  SecretField = 6
+	SecretField_SECRET_FIELD_SSH_PRIVATE_KEY          SecretField = 7
+	SecretField_SECRET_FIELD_API_KEY_SECRET           SecretField = 8
+	// SECRET_FIELD_CUSTOM_FIELD reveals the hidden custom field named by custom_field_name
+	SecretField_SECRET_FIELD_CUSTOM_FIELD SecretField = 9
+)
+
+// Enum value maps for SecretField.
+var (
+	SecretField_name = map[int32]string{
+		0: "SECRET_FIELD_PASSWORD",
+		1: "SECRET_FIELD_TOTP",
+		2: "SECRET_FIELD_CARD_NUMBER",
+		3: "SECRET_FIELD_CARD_CODE",
+		4: "SECRET_FIELD_IDENTITY_SSN",
+		5: "SECRET_FIELD_IDENTITY_PASSPORT_NUMBER",
+		6: "SECRET_FIELD_IDENTITY_LICENSE_NUMBER",
+		7: "SECRET_FIELD_SSH_PRIVATE_KEY",
+		8: "SECRET_FIELD_API_KEY_SECRET",
+		9: "SECRET_FIELD_CUSTOM_FIELD",
+	}
+	SecretField_value = map[string]int32{
+		"SECRET_FIELD_PASSWORD":                 0,
+		"SECRET_FIELD_TOTP":                     1,
+		"SECRET_FIELD_CARD_NUMBER":              2,
+		"SECRET_FIELD_CARD_CODE":                3,
+		"SECRET_FIELD_IDENTITY_SSN":             4,
+		"SECRET_FIELD_IDENTITY_PASSPORT_NUMBER": 5,
+		"SECRET_FIELD_IDENTITY_LICENSE_NUMBER":  6,
+		"SECRET_FIELD_SSH_PRIVATE_KEY":          7,
+		"SECRET_FIELD_API_KEY_SECRET":           8,
+		"SECRET_FIELD_CUSTOM_FIELD":             9,
+	}
+)
+
+func (x SecretField) Enum() *SecretField {
+	p := new(SecretField)
+	*p = x
+	return p
+}
+
+func (x SecretField) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SecretField) Descriptor() protoreflect.EnumDescriptor {
+	return file_vault_proto_enumTypes[3].Descriptor()
+}
+
+func (SecretField) Type() protoreflect.EnumType {
+	return &file_vault_proto_enumTypes[3]
+}
+
+func (x SecretField) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SecretField.Descriptor instead.
+func (SecretField) EnumDescriptor() ([]byte, []int) {
+	return file_vault_proto_rawDescGZIP(), []int{3}
+}
+
+// Card is the payload of a payment card, number and code are secret
+type Card struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	CardholderName string                 `protobuf:"bytes,1,opt,name=cardholder_name,json=cardholderName,proto3" json:"cardholder_name,omitempty"`
+	// brand is detected from the number when left empty
+	Brand    string `protobuf:"bytes,2,opt,name=brand,proto3" json:"brand,omitempty"`
+	Number   string `protobuf:"bytes,3,opt,name=number,proto3" json:"number,omitempty"`
+	ExpMonth int32  `protobuf:"varint,4,opt,name=exp_month,json=expMonth,proto3" json:"exp_month,omitempty"`
+	ExpYear  int32  `protobuf:"varint,5,opt,name=exp_year,json=expYear,proto3" json:"exp_year,omitempty"`
+	Code     string `protobuf:"bytes,6,opt,name=code,proto3" json:"code,omitempty"`
+	// last_four is set by the server when number is redacted
+	LastFour      string `protobuf:"bytes,7,opt,name=last_four,json=lastFour,proto3" json:"last_four,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Card) Reset() {
+	*x = Card{}
+	mi := &file_vault_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Card) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Card) ProtoMessage() {}
+
+func (x *Card) ProtoReflect() protoreflect.Message {
+	mi := &file_vault_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Card.ProtoReflect.Descriptor instead.
+func (*Card) Descriptor() ([]byte, []int) {
+	return file_vault_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Card) GetCardholderName() string {
+	if x != nil {
+		return x.CardholderName
+	}
+	return ""
+}
+
+func (x *Card) GetBrand() string {
+	if x != nil {
+		return x.Brand
+	}
+	return ""
+}
+
+func (x *Card) GetNumber() string {
+	if x != nil {
+		return x.Number
+	}
+	return ""
+}
+
+func (x *Card) GetExpMonth() int32 {
+	if x != nil {
+		return x.ExpMonth
+	}
+	return 0
+}
+
+func (x *Card) GetExpYear() int32 {
+	if x != nil {
+		return x.ExpYear
+	}
+	return 0
+}
+
+func (x *Card) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Card) GetLastFour() string {
+	if x != nil {
+		return x.LastFour
+	}
+	return ""
+}
+
+// Identity is the payload of personal details, ssn, passport_number and license_number are secret
+type Identity struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	FirstName      string                 `protobuf:"bytes,1,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	MiddleName     string                 `protobuf:"bytes,2,opt,name=middle_name,json=middleName,proto3" json:"middle_name,omitempty"`
+	LastName       string                 `protobuf:"bytes,3,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
+	Email          string                 `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
+	Phone          string                 `protobuf:"bytes,5,opt,name=phone,proto3" json:"phone,omitempty"`
+	Company        string                 `protobuf:"bytes,6,opt,name=company,proto3" json:"company,omitempty"`
+	AddressLine1   string                 `protobuf:"bytes,7,opt,name=address_line1,json=addressLine1,proto3" json:"address_line1,omitempty"`
+	AddressLine2   string                 `protobuf:"bytes,8,opt,name=address_line2,json=addressLine2,proto3" json:"address_line2,omitempty"`
+	City           string                 `protobuf:"bytes,9,opt,name=city,proto3" json:"city,omitempty"`
+	State          string                 `protobuf:"bytes,10,opt,name=state,proto3" json:"state,omitempty"`
+	PostalCode     string                 `protobuf:"bytes,11,opt,name=postal_code,json=postalCode,proto3" json:"postal_code,omitempty"`
+	Country        string                 `protobuf:"bytes,12,opt,name=country,proto3" json:"country,omitempty"`
+	Ssn            string                 `protobuf:"bytes,13,opt,name=ssn,proto3" json:"ssn,omitempty"`
+	PassportNumber string                 `protobuf:"bytes,14,opt,name=passport_number,json=passportNumber,proto3" json:"passport_number,omitempty"`
+	LicenseNumber  string                 `protobuf:"bytes,15,opt,name=license_number,json=licenseNumber,proto3" json:"license_number,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Identity) Reset() {
+	*x = Identity{}
+	mi := &file_vault_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Identity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Identity) ProtoMessage() {}
+
+func (x *Identity) ProtoReflect() protoreflect.Message {
+	mi := &file_vault_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Identity.ProtoReflect.Descriptor instead.
+func (*Identity) Descriptor() ([]byte, []int) {
+	return file_vault_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Identity) GetFirstName() string {
+	if x != nil {
+		return x.FirstName
+	}
+	return ""
+}
+
+func (x *Identity) GetMiddleName() string {
+	if x != nil {
+		return x.MiddleName
+	}
+	return ""
+}
+
+func (x *Identity) GetLastName() string {
+	if x != nil {
+		return x.LastName
+	}
+	return ""
+}
+
+func (x *Identity) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *Identity) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *Identity) GetCompany() string {
+	if x != nil {
+		return x.Company
+	}
+	return ""
+}
+
+func (x *Identity) GetAddressLine1() string {
+	if x != nil {
+		return x.AddressLine1
+	}
+	return ""
+}
+
+func (x *Identity) GetAddressLine2() string {
+	if x != nil {
+		return x.AddressLine2
+	}
+	return ""
+}
+
+func (x *Identity) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *Identity) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *Identity) GetPostalCode() string {
+	if x != nil {
+		return x.PostalCode
+	}
+	return ""
+}
+
+func (x *Identity) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *Identity) GetSsn() string {
+	if x != nil {
+		return x.Ssn
+	}
+	return ""
+}
+
+func (x *Identity) GetPassportNumber() string {
+	if x != nil {
+		return x.PassportNumber
+	}
+	return ""
+}
+
+func (x *Identity) GetLicenseNumber() string {
+	if x != nil {
+		return x.LicenseNumber
+	}
+	return ""
+}
+
+// SshKey is the payload of an SSH key pair, private_key is secret. public_key and fingerprint are
+// derived from the private key by the server when it is not client encrypted
+type SshKey struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PrivateKey    string                 `protobuf:"bytes,1,opt,name=private_key,json=privateKey,proto3" json:"private_key,omitempty"`
+	PublicKey     string                 `protobuf:"bytes,2,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	Fingerprint   string                 `protobuf:"bytes,3,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SshKey) Reset() {
+	*x = SshKey{}
+	mi := &file_vault_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SshKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SshKey) ProtoMessage() {}
+
+func (x *SshKey) ProtoReflect() protoreflect.Message {
+	mi := &file_vault_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SshKey.ProtoReflect.Descriptor instead.
+func (*SshKey) Descriptor() ([]byte, []int) {
+	return file_vault_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SshKey) GetPrivateKey() string {
+	if x != nil {
+		return x.PrivateKey
+	}
+	return ""
+}
+
+func (x *SshKey) GetPublicKey() string {
+	if x != nil {
+		return x.PublicKey
+	}
+	return ""
+}
+
+func (x *SshKey) GetFingerprint() string {
+	if x != nil {
+		return x.Fingerprint
+	}
+	return ""
+}
+
+// ApiKey is the payload of an API credential, secret is secret
+type ApiKey struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	KeyId    string                 `protobuf:"bytes,1,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	Secret   string                 `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`
+	Endpoint string                 `protobuf:"bytes,3,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	// expires_at is a unix timestamp, 0 when the key does not expire
+	ExpiresAt     int64 `protobuf:"varint,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApiKey) Reset() {
+	*x = ApiKey{}
+	mi := &file_vault_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApiKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApiKey) ProtoMessage() {}
+
+func (x *ApiKey) ProtoReflect() protoreflect.Message {
+	mi := &file_vault_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApiKey.ProtoReflect.Descriptor instead.
+func (*ApiKey) Descriptor() ([]byte, []int) {
+	return file_vault_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ApiKey) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
+	}
+	return ""
+}
+
+func (x *ApiKey) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *ApiKey) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *ApiKey) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+type EntryUri struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uri           string                 `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Match         UriMatch               `protobuf:"varint,2,opt,name=match,proto3,enum=vault.UriMatch" json:"match,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EntryUri) Reset() {
+	*x = EntryUri{}
+	mi := &file_vault_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EntryUri) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EntryUri) ProtoMessage() {}
+
+func (x *EntryUri) ProtoReflect() protoreflect.Message {
+	mi := &file_vault_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EntryUri.ProtoReflect.Descriptor instead.
+func (*EntryUri) Descriptor() ([]byte, []int) {
+	return file_vault_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *EntryUri) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *EntryUri) GetMatch() UriMatch {
+	if x != nil {
+		return x.Match
+	}
+	return UriMatch_URI_MATCH_BASE_DOMAIN
+}
+
+// CustomField is an extra named value of an entry. Hidden values are encrypted and redacted like
+// password, boolean values are "true" or "false" and linked values name the entry field they mirror,
+// e.g. "username" or a payload field such as "number"
+type CustomField struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type          CustomFieldType        `protobuf:"varint,2,opt,name=type,proto3,enum=vault.CustomFieldType" json:"type,omitempty"`
+	Value         string                 `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CustomField) Reset() {
+	*x = CustomField{}
+	mi := &file_vault_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CustomField) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CustomField) ProtoMessage() {}
+
+func (x *CustomField) ProtoReflect() protoreflect.Message {
+	mi := &file_vault_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CustomField.ProtoReflect.Descriptor instead.
+func (*CustomField) Descriptor() ([]byte, []int) {
+	return file_vault_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CustomField) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CustomField) GetType() CustomFieldType {
+	if x != nil {
+		return x.Type
+	}
+	return CustomFieldType_CUSTOM_FIELD_TYPE_TEXT
+}
+
+func (x *CustomField) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
 type VaultEntry struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Id       string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title    string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Username string                 `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	Password string                 `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`
+	Notes    string                 `protobuf:"bytes,5,opt,name=notes,proto3" json:"notes,omitempty"`
+	Tags     []string               `protobuf:"bytes,6,rep,name=tags,proto3" json:"tags,omitempty"`
+	// folder is the path of the entry's folder, e.g. "Work/Dev". It is set by the server from folder_id, on
+	// create and update it is only used when folder_id is empty and creates missing folders along the path
+	Folder string `protobuf:"bytes,7,opt,name=folder,proto3" json:"folder,omitempty"`
+	Domain string `protobuf:"bytes,8,opt,name=domain,proto3" json:"domain,omitempty"`
+	// client_encrypted entries carry client side ciphertext in password and notes,
+	// the server stores and returns them as opaque blobs
+	ClientEncrypted bool `protobuf:"varint,9,opt,name=client_encrypted,json=clientEncrypted,proto3" json:"client_encrypted,omitempty"`
+	// reused is set by ListEntries when another entry of the owner has the same password
+	Reused bool `protobuf:"varint,10,opt,name=reused,proto3" json:"reused,omitempty"`
+	// rotation_interval_days overrides the interval of the entry's folder, 0 disables rotation for the
+	// entry and unset inherits the folder's interval
+	RotationIntervalDays *int32 `protobuf:"varint,11,opt,name=rotation_interval_days,json=rotationIntervalDays,proto3,oneof" json:"rotation_interval_days,omitempty"`
+	// password_changed_at is a unix timestamp set by the server
+	PasswordChangedAt int64 `protobuf:"varint,12,opt,name=password_changed_at,json=passwordChangedAt,proto3" json:"password_changed_at,omitempty"`
+	// totp is an otpauth:// or steam:// URI. It is only accepted on create and update and never returned,
+	// use GetTOTPCode or RevealPassword with SECRET_FIELD_TOTP. On update unset keeps and empty removes it
+	Totp *string `protobuf:"bytes,13,opt,name=totp,proto3,oneof" json:"totp,omitempty"`
+	// has_totp is set by the server when the entry stores a TOTP secret
+	HasTotp bool      `protobuf:"varint,14,opt,name=has_totp,json=hasTotp,proto3" json:"has_totp,omitempty"`
+	Type    EntryType `protobuf:"varint,15,opt,name=type,proto3,enum=vault.EntryType" json:"type,omitempty"`
+	// payload must match type, secret payload fields are redacted in responses like password and can be
+	// read with RevealPassword. On update an empty secret field keeps the stored value
+	//
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*VaultEntry_Card
+	//	*VaultEntry_Identity
+	//	*VaultEntry_SshKey
+	//	*VaultEntry_ApiKey
+	Payload isVaultEntry_Payload `protobuf_oneof:"payload"`
+	// custom_fields are replaced as a whole on update, an empty hidden value keeps the stored value of
+	// the hidden field with the same name
+	CustomFields []*CustomField `protobuf:"bytes,20,rep,name=custom_fields,json=customFields,proto3" json:"custom_fields,omitempty"`
+	// folder_id files the entry in a folder, empty keeps it at the top level
+	FolderId string `protobuf:"bytes,21,opt,name=folder_id,json=folderId,proto3" json:"folder_id,omitempty"`
+	// favorite is set on create and with SetFavorite, UpdateEntry keeps it
+	Favorite bool `protobuf:"varint,22,opt,name=favorite,proto3" json:"favorite,omitempty"`
+	// last_used_at is the last time the owner read the entry or revealed a secret, 0 if never. Output only
+	LastUsedAt int64 `protobuf:"varint,23,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"`
+	// uris are replaced as a whole on update. Without uris a domain becomes a single base domain URI, and
+	// without a domain the host of the first uri with a host becomes the domain
+	Uris          []*EntryUri `protobuf:"bytes,24,rep,name=uris,proto3" json:"uris,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VaultEntry) Reset() {
+	*x = VaultEntry{}
+	mi := &file_vault_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VaultEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VaultEntry) ProtoMessage() {}
+
+func (x *VaultEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_vault_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VaultEntry.ProtoReflect.Descriptor instead.
+func (*VaultEntry) Descriptor() ([]byte, []int) {
+	return file_vault_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *VaultEntry) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *VaultEntry) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *VaultEntry) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *VaultEntry) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *VaultEntry) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *VaultEntry) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *VaultEntry) GetFolder() string {
+	if x != nil {
+		return x.Folder
+	}
+	return ""
+}
+
+func (x *VaultEntry) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *VaultEntry) GetClientEncrypted() bool {
+	if x != nil {
+		return x.ClientEncrypted
+	}
+	return false
+}
+
+func (x *VaultEntry) GetReused() bool {
+	if x != nil {
+		return x.Reused
+	}
+	return false
+}
+
+func (x *VaultEntry) GetRotationIntervalDays() int32 {
+	if x != nil && x.RotationIntervalDays != nil {
+		return *x.RotationIntervalDays
+	}
+	return 0
+}
+
+func (x *VaultEntry) GetPasswordChangedAt() int64 {
+	if x != nil {
+		return x.PasswordChangedAt
+	}
+	return 0
+}
+
+func (x *VaultEntry) GetTotp() string {
+	if x != nil && x.Totp != nil {
+		return *x.Totp
+	}
+	return ""
+}
+
+func (x *VaultEntry) GetHasTotp() bool {
+	if x != nil {
+		return x.HasTotp
+	}
+	return false
+}
+
+func (x *VaultEntry) GetType() EntryType {
+	if x != nil {
+		return x.Type
+	}
+	return EntryType_ENTRY_TYPE_LOGIN
+}
+
+func (x *VaultEntry) GetPayload() isVaultEntry_Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *VaultEntry) GetCard() *Card {
+	if x != nil {
+		if x, ok := x.Payload.(*VaultEntry_Card); ok {
+			return x.Card
+		}
+	}
+	return nil
+}
+
+func (x *VaultEntry) GetIdentity() *Identity {
+	if x != nil {
+		if x, ok := x.Payload.(*VaultEntry_Identity); ok {
+			return x.Identity
+		}
+	}
+	return nil
+}
+
+func (x *VaultEntry) GetSshKey() *SshKey {
+	if x != nil {
+		if x, ok := x.Payload.(*VaultEntry_SshKey); ok {
+			return x.SshKey
+		}
+	}
+	return nil
+}
+
+func (x *VaultEntry) GetApiKey() *ApiKey {
+	if x != nil {
+		if x, ok := x.Payload.(*VaultEntry_ApiKey); ok {
+			return x.ApiKey
+		}
+	}
+	return nil
+}
+
+func (x *VaultEntry) GetCustomFields() []*CustomField {
+	if x != nil {
+		return x.CustomFields
+	}
+	return nil
+}
+
+func (x *VaultEntry) GetFolderId() string {
+	if x != nil {
+		return x.FolderId
+	}
+	return ""
+}
+
+func (x *VaultEntry) GetFavorite() bool {
+	if x != nil {
+		return x.Favorite
+	}
+	return false
+}
+
+func (x *VaultEntry) GetLastUsedAt() int64 {
+	if x != nil {
+		return x.LastUsedAt
+	}
+	return 0
+}
+
+func (x *VaultEntry) GetUris() []*EntryUri {
+	if x != nil {
+		return x.Uris
+	}
+	return nil
+}
+
+type isVaultEntry_Payload interface {
+	isVaultEntry_Payload()
+}
+
+type VaultEntry_Card struct {
+	Card *Card `protobuf:"bytes,16,opt,name=card,proto3,oneof"`
+}
+
+type VaultEntry_Identity struct {
+	Identity *Identity `protobuf:"bytes,17,opt,name=identity,proto3,oneof"`
+}
+
+type VaultEntry_SshKey struct {
+	SshKey *SshKey `protobuf:"bytes,18,opt,name=ssh_key,json=sshKey,proto3,oneof"`
+}
+
+type VaultEntry_ApiKey struct {
+	ApiKey *ApiKey `protobuf:"bytes,19,opt,name=api_key,json=apiKey,proto3,oneof"`
+}
+
+func (*VaultEntry_Card) isVaultEntry_Payload() {}
+
+func (*VaultEntry_Identity) isVaultEntry_Payload() {}
+
+func (*VaultEntry_SshKey) isVaultEntry_Payload() {}
+
+func (*VaultEntry_ApiKey) isVaultEntry_Payload() {}
+
+type CreateEntryRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Entry *VaultEntry            `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+	// owner_id requires an emergency takeover grant from that user
+	OwnerId string `protobuf:"bytes,2,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	// enforce_policy rejects passwords that do not satisfy the effective password policy
+	EnforcePolicy bool `protobuf:"varint,3,opt,name=enforce_policy,json=enforcePolicy,proto3" json:"enforce_policy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateEntryRequest) Reset() {
+	*x = CreateEntryRequest{}
+	mi := &file_vault_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateEntryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateEntryRequest) ProtoMessage() {}
+
+func (x *CreateEntryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vault_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateEntryRequest.ProtoReflect.Descriptor instead.
+func (*CreateEntryRequest) Descriptor() ([]byte, []int) {
+	return file_vault_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CreateEntryRequest) GetEntry() *VaultEntry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+func (x *CreateEntryRequest) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *CreateEntryRequest) GetEnforcePolicy() bool {
+	if x != nil {
+		return x.EnforcePolicy
+	}
+	return false
+}
+
+type CreateEntryResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// strength of the stored password, not set for client encrypted entries
+	Strength *PasswordStrength `protobuf:"bytes,2,opt,name=strength,proto3" json:"strength,omitempty"`
+	// breach check of the stored password, not set for client encrypted entries or without a breach index
+	Breach        *BreachCheck `protobuf:"bytes,3,opt,name=breach,proto3" json:"breach,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateEntryResponse) Reset() {
+	*x = CreateEntryResponse{}
+	mi := &file_vault_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateEntryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateEntryResponse) ProtoMessage() {}
+
+func (x *CreateEntryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vault_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateEntryResponse.ProtoReflect.Descriptor instead.
+func (*CreateEntryResponse) Descriptor() ([]byte, []int) {
+	return file_vault_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CreateEntryResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CreateEntryResponse) GetStrength() *PasswordStrength {
+	if x != nil {
+		return x.Strength
+	}
+	return nil
+}
+
+func (x *CreateEntryResponse) GetBreach() *BreachCheck {
+	if x != nil {
+		return x.Breach
+	}
+	return nil
+}
+
+type BreachCheck struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Breached bool                   `protobuf:"varint,1,opt,name=breached,proto3" json:"breached,omitempty"`
+	// how often the password appears in the breach dataset
+	Count         int64 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BreachCheck) Reset() {
+	*x = BreachCheck{}
+	mi := &file_vault_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BreachCheck) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BreachCheck) ProtoMessage() {}
+
+func (x *BreachCheck) ProtoReflect() protoreflect.Message {
+	mi := &file_vault_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BreachCheck.ProtoReflect.Descriptor instead.
+func (*BreachCheck) Descriptor() ([]byte, []int) {
+	return file_vault_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *BreachCheck) GetBreached() bool {
+	if x != nil {
+		return x.Breached
+	}
+	return false
+}
+
+func (x *BreachCheck) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type PasswordStrength struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// score from 0 (too guessable) to 4 (very unguessable)
+	Score        int32   `protobuf:"varint,1,opt,name=score,proto3" json:"score,omitempty"`
+	GuessesLog10 float64 `protobuf:"fixed64,2,opt,name=guesses_log10,json=guessesLog10,proto3" json:"guesses_log10,omitempty"`
+	// warning explains scores of 2 and below
+	Warning       string `protobuf:"bytes,3,opt,name=warning,proto3" json:"warning,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PasswordStrength) Reset() {
+	*x = PasswordStrength{}
+	mi := &file_vault_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PasswordStrength) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PasswordStrength) ProtoMessage() {}
+
+func (x *PasswordStrength) ProtoReflect() protoreflect.Message {
+	mi := &file_vault_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PasswordStrength.ProtoReflect.Descriptor instead.
+func (*PasswordStrength) Descriptor() ([]byte, []int) {
+	return file_vault_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *PasswordStrength) GetScore() int32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *PasswordStrength) GetGuessesLog10() float64 {
+	if x != nil {
+		return x.GuessesLog10
+	}
+	return 0
+}
+
+func (x *PasswordStrength) GetWarning() string {
+	if x != nil {
+		return x.Warning
+	}
+	return ""
+}
+
+// UpdateEntryRequest replaces all fields of the entry with entry.id. An empty password keeps the stored
+// password, so metadata can be edited without revealing it first
+type UpdateEntryRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Entry *VaultEntry            `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+	// owner_id requires an emergency takeover grant from that user
+	OwnerId string `protobuf:"bytes,2,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	// enforce_policy rejects a new password that does not satisfy the effective password policy
+	EnforcePolicy bool `protobuf:"varint,3,opt,name=enforce_policy,json=enforcePolicy,proto3" json:"enforce_policy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateEntryRequest) Reset() {
+	*x = UpdateEntryRequest{}
+	mi := &file_vault_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateEntryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateEntryRequest) ProtoMessage() {}
+
+func (x *UpdateEntryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vault_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateEntryRequest.ProtoReflect.Descriptor instead.
+func (*UpdateEntryRequest) Descriptor() ([]byte, []int) {
+	return file_vault_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *UpdateEntryRequest) GetEntry() *VaultEntry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+func (x *UpdateEntryRequest) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *UpdateEntryRequest) GetEnforcePolicy() bool {
+	if x != nil {
+		return x.EnforcePolicy
+	}
+	return false
+}
+
+type UpdateEntryResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// strength and breach are only set when the password was changed
+	Strength      *PasswordStrength `protobuf:"bytes,1,opt,name=strength,proto3" json:"strength,omitempty"`
+	Breach        *BreachCheck      `protobuf:"bytes,2,opt,name=breach,proto3" json:"breach,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateEntryResponse) Reset() {
+	*x = UpdateEntryResponse{}
+	mi := &file_vault_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateEntryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateEntryResponse) ProtoMessage() {}
+
+func (x *UpdateEntryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vault_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateEntryResponse.ProtoReflect.Descriptor instead.
+func (*UpdateEntryResponse) Descriptor() ([]byte, []int) {
+	return file_vault_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *UpdateEntryResponse) GetStrength() *PasswordStrength {
+	if x != nil {
+		return x.Strength
+	}
+	return nil
+}
+
+func (x *UpdateEntryResponse) GetBreach() *BreachCheck {
+	if x != nil {
+		return x.Breach
+	}
+	return nil
+}
+
+type GetEntryRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// owner_id is set by an emergency contact to read the grantor's entry
+	OwnerId       string `protobuf:"bytes,2,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEntryRequest) Reset() {
+	*x = GetEntryRequest{}
+	mi := &file_vault_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEntryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEntryRequest) ProtoMessage() {}
+
+func (x *GetEntryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vault_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEntryRequest.ProtoReflect.Descriptor instead.
+func (*GetEntryRequest) Descriptor() ([]byte, []int) {
+	return file_vault_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetEntryRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetEntryRequest) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+type GetEntryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entry         *VaultEntry            `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEntryResponse) Reset() {
+	*x = GetEntryResponse{}
+	mi := &file_vault_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEntryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEntryResponse) ProtoMessage() {}
+
+func (x *GetEntryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vault_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEntryResponse.ProtoReflect.Descriptor instead.
+func (*GetEntryResponse) Descriptor() ([]byte, []int) {
+	return file_vault_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetEntryResponse) GetEntry() *VaultEntry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+type ListEntriesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// folder matches entries in the folder at exactly this path
+	Folder string   `protobuf:"bytes,1,opt,name=folder,proto3" json:"folder,omitempty"`
+	Tags   []string `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty"`
+	// domain matches entries with a URI in the registrable domain of a URL or host, e.g. "bank.com" matches
+	// URIs on login.bank.com but not on notbank.com
+	Domain string `protobuf:"bytes,3,opt,name=domain,proto3" json:"domain,omitempty"`
+	// owner_id is set by an emergency contact to list the grantor's entries
+	OwnerId string `protobuf:"bytes,4,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	// types limits the listing to entries of the given types, empty lists all types
+	Types []EntryType `protobuf:"varint,5,rep,packed,name=types,proto3,enum=vault.EntryType" json:"types,omitempty"`
+	// field_names limits the listing to entries with a custom field of any of these names
+	FieldNames []string `protobuf:"bytes,6,rep,name=field_names,json=fieldNames,proto3" json:"field_names,omitempty"`
+	// page_size is the maximum number of entries returned, 0 uses the default of 100, at most 1000
+	PageSize int32 `protobuf:"varint,7,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// page_token is the next_page_token of the previous page, the other fields must not change between pages
+	PageToken string `protobuf:"bytes,8,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// order_by is one of title, created_at, updated_at, favorite (favorites first, then by title) or
+	// last_used_at (never used entries first, "last_used_at desc" lists the most recently used first),
+	// optionally followed by " desc", default created_at
+	OrderBy string `protobuf:"bytes,9,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	// tag_sets matches entries carrying all tags of at least one set, in addition to tags
+	TagSets []*TagSet `protobuf:"bytes,10,rep,name=tag_sets,json=tagSets,proto3" json:"tag_sets,omitempty"`
+	// folder_prefix matches entries in the folder at this path or any of its subfolders
+	FolderPrefix string `protobuf:"bytes,11,opt,name=folder_prefix,json=folderPrefix,proto3" json:"folder_prefix,omitempty"`
+	// unix timestamps bounding created_at and updated_at, after is inclusive, before exclusive, 0 is open
+	CreatedAfter  int64 `protobuf:"varint,12,opt,name=created_after,json=createdAfter,proto3" json:"created_after,omitempty"`
+	CreatedBefore int64 `protobuf:"varint,13,opt,name=created_before,json=createdBefore,proto3" json:"created_before,omitempty"`
+	UpdatedAfter  int64 `protobuf:"varint,14,opt,name=updated_after,json=updatedAfter,proto3" json:"updated_after,omitempty"`
+	UpdatedBefore int64 `protobuf:"varint,15,opt,name=updated_before,json=updatedBefore,proto3" json:"updated_before,omitempty"`
+	// folder_id matches entries in the folder, with include_subfolders also in any of its subfolders
+	FolderId          string `protobuf:"bytes,16,opt,name=folder_id,json=folderId,proto3" json:"folder_id,omitempty"`
+	IncludeSubfolders bool   `protobuf:"varint,17,opt,name=include_subfolders,json=includeSubfolders,proto3" json:"include_subfolders,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ListEntriesRequest) Reset() {
+	*x = ListEntriesRequest{}
+	mi := &file_vault_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEntriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEntriesRequest) ProtoMessage() {}
+
+func (x *ListEntriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vault_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEntriesRequest.ProtoReflect.Descriptor instead.
+func (*ListEntriesRequest) Descriptor() ([]byte, []int) {
+	return file_vault_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListEntriesRequest) GetFolder() string {
+	if x != nil {
+		return x.Folder
+	}
+	return ""
+}
+
+func (x *ListEntriesRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *ListEntriesRequest) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *ListEntriesRequest) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *ListEntriesRequest) GetTypes() []EntryType {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+func (x *ListEntriesRequest) GetFieldNames() []string {
+	if x != nil {
+		return x.FieldNames
+	}
+	return nil
+}
+
+func (x *ListEntriesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListEntriesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListEntriesRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *ListEntriesRequest) GetTagSets() []*TagSet {
+	if x != nil {
+		return x.TagSets
+	}
+	return nil
+}
+
+func (x *ListEntriesRequest) GetFolderPrefix() string {
+	if x != nil {
+		return x.FolderPrefix
+	}
+	return ""
+}
+
+func (x *ListEntriesRequest) GetCreatedAfter() int64 {
+	if x != nil {
+		return x.CreatedAfter
+	}
+	return 0
+}
+
+func (x *ListEntriesRequest) GetCreatedBefore() int64 {
+	if x != nil {
+		return x.CreatedBefore
+	}
+	return 0
+}
+
+func (x *ListEntriesRequest) GetUpdatedAfter() int64 {
+	if x != nil {
+		return x.UpdatedAfter
+	}
+	return 0
+}
+
+func (x *ListEntriesRequest) GetUpdatedBefore() int64 {
+	if x != nil {
+		return x.UpdatedBefore
+	}
+	return 0
+}
+
+func (x *ListEntriesRequest) GetFolderId() string {
+	if x != nil {
+		return x.FolderId
+	}
+	return ""
+}
+
+func (x *ListEntriesRequest) GetIncludeSubfolders() bool {
+	if x != nil {
+		return x.IncludeSubfolders
+	}
+	return false
+}
+
+type TagSet struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tags          []string               `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TagSet) Reset() {
+	*x = TagSet{}
+	mi := &file_vault_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TagSet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TagSet) ProtoMessage() {}
+
+func (x *TagSet) ProtoReflect() protoreflect.Message {
+	mi := &file_vault_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TagSet.ProtoReflect.Descriptor instead.
+func (*TagSet) Descriptor() ([]byte, []int) {
+	return file_vault_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *TagSet) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type ListEntriesResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Entries []*VaultEntry          `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	// next_page_token is empty on the last page
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// total_size is the number of entries matching the filters across all pages
+	TotalSize     int32 `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEntriesResponse) Reset() {
+	*x = ListEntriesResponse{}
+	mi := &file_vault_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEntriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEntriesResponse) ProtoMessage() {}
+
+func (x *ListEntriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vault_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEntriesResponse.ProtoReflect.Descriptor instead.
+func (*ListEntriesResponse) Descriptor() ([]byte, []int) {
+	return file_vault_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListEntriesResponse) GetEntries() []*VaultEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *ListEntriesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListEntriesResponse) GetTotalSize() int32 {
+	if x != nil {
+		return x.TotalSize
+	}
+	return 0
+}
+
+type DeleteEntryRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// owner_id requires an emergency takeover grant from that user
+	OwnerId       string `protobuf:"bytes,2,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteEntryRequest) Reset() {
+	*x = DeleteEntryRequest{}
+	mi := &file_vault_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteEntryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteEntryRequest) ProtoMessage() {}
+
+func (x *DeleteEntryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vault_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteEntryRequest.ProtoReflect.Descriptor instead.
+func (*DeleteEntryRequest) Descriptor() ([]byte, []int) {
+	return file_vault_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *DeleteEntryRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeleteEntryRequest) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+type DeleteEntryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteEntryResponse) Reset() {
+	*x = DeleteEntryResponse{}
+	mi := &file_vault_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteEntryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteEntryResponse) ProtoMessage() {}
+
+func (x *DeleteEntryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vault_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteEntryResponse.ProtoReflect.Descriptor instead.
+func (*DeleteEntryResponse) Descriptor() ([]byte, []int) {
+	return file_vault_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *DeleteEntryResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type RevealPasswordRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Field SecretField            `protobuf:"varint,2,opt,name=field,proto3,enum=vault.SecretField" json:"field,omitempty"`
+	// owner_id is set by an emergency contact to reveal the grantor's secret
+	OwnerId         string `protobuf:"bytes,3,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	CustomFieldName string `protobuf:"bytes,4,opt,name=custom_field_name,json=customFieldName,proto3" json:"custom_field_name,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *RevealPasswordRequest) Reset() {
+	*x = RevealPasswordRequest{}
+	mi := &file_vault_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevealPasswordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevealPasswordRequest) ProtoMessage() {}
+
+func (x *RevealPasswordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vault_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevealPasswordRequest.ProtoReflect.Descriptor instead.
+func (*RevealPasswordRequest) Descriptor() ([]byte, []int) {
+	return file_vault_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *RevealPasswordRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RevealPasswordRequest) GetField() SecretField {
+	if x != nil {
+		return x.Field
+	}
+	return SecretField_SECRET_FIELD_PASSWORD
+}
+
+func (x *RevealPasswordRequest) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *RevealPasswordRequest) GetCustomFieldName() string {
+	if x != nil {
+		return x.CustomFieldName
+	}
+	return ""
+}
+
+type RevealPasswordResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         string                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevealPasswordResponse) Reset() {
+	*x = RevealPasswordResponse{}
+	mi := &file_vault_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevealPasswordResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevealPasswordResponse) ProtoMessage() {}
+
+func (x *RevealPasswordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vault_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevealPasswordResponse.ProtoReflect.Descriptor instead.
+func (*RevealPasswordResponse) Descriptor() ([]byte, []int) {
+	return file_vault_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *RevealPasswordResponse) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type AuditEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ActorId       string                 `protobuf:"bytes,2,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	OwnerId       string                 `protobuf:"bytes,3,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	Action        string                 `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"`
+	EntryId       string                 `protobuf:"bytes,5,opt,name=entry_id,json=entryId,proto3" json:"entry_id,omitempty"`
+	Peer          string                 `protobuf:"bytes,6,opt,name=peer,proto3" json:"peer,omitempty"`
+	Outcome       string                 `protobuf:"bytes,7,opt,name=outcome,proto3" json:"outcome,omitempty"`
+	Timestamp     int64                  `protobuf:"varint,8,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditEvent) Reset() {
+	*x = AuditEvent{}
+	mi := &file_vault_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditEvent) ProtoMessage() {}
+
+func (x *AuditEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_vault_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditEvent.ProtoReflect.Descriptor instead.
+func (*AuditEvent) Descriptor() ([]byte, []int) {
+	return file_vault_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *AuditEvent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetEntryId() string {
+	if x != nil {
+		return x.EntryId
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetPeer() string {
+	if x != nil {
+		return x.Peer
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetOutcome() string {
+	if x != nil {
+		return x.Outcome
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+type QueryAuditLogRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	ActorId string                 `protobuf:"bytes,1,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	EntryId string                 `protobuf:"bytes,2,opt,name=entry_id,json=entryId,proto3" json:"entry_id,omitempty"`
+	Action  string                 `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`
+	Since   int64                  `protobuf:"varint,4,opt,name=since,proto3" json:"since,omitempty"`
+	Until   int64                  `protobuf:"varint,5,opt,name=until,proto3" json:"until,omitempty"`
+	Limit   int32                  `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"`
+	// all_users is only allowed for admins
+	AllUsers      bool `protobuf:"varint,7,opt,name=all_users,json=allUsers,proto3" json:"all_users,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryAuditLogRequest) Reset() {
+	*x = QueryAuditLogRequest{}
+	mi := &file_vault_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryAuditLogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryAuditLogRequest) ProtoMessage() {}
+
+func (x *QueryAuditLogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vault_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryAuditLogRequest.ProtoReflect.Descriptor instead.
+func (*QueryAuditLogRequest) Descriptor() ([]byte, []int) {
+	return file_vault_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *QueryAuditLogRequest) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *QueryAuditLogRequest) GetEntryId() string {
+	if x != nil {
+		return x.EntryId
+	}
+	return ""
+}
+
+func (x *QueryAuditLogRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *QueryAuditLogRequest) GetSince() int64 {
+	if x != nil {
+		return x.Since
+	}
+	return 0
+}
+
+func (x *QueryAuditLogRequest) GetUntil() int64 {
+	if x != nil {
+		return x.Until
+	}
+	return 0
+}
+
+func (x *QueryAuditLogRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *QueryAuditLogRequest) GetAllUsers() bool {
+	if x != nil {
+		return x.AllUsers
+	}
+	return false
+}
+
+type QueryAuditLogResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Events        []*AuditEvent          `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryAuditLogResponse) Reset() {
+	*x = QueryAuditLogResponse{}
+	mi := &file_vault_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryAuditLogResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryAuditLogResponse) ProtoMessage() {}
+
+func (x *QueryAuditLogResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vault_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryAuditLogResponse.ProtoReflect.Descriptor instead.
+func (*QueryAuditLogResponse) Descriptor() ([]byte, []int) {
+	return file_vault_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *QueryAuditLogResponse) GetEvents() []*AuditEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+// PasswordPolicy fields are minimums, zero values do not restrict anything
+type PasswordPolicy struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	MinLength        int32                  `protobuf:"varint,1,opt,name=min_length,json=minLength,proto3" json:"min_length,omitempty"`
+	RequireLowercase bool                   `protobuf:"varint,2,opt,name=require_lowercase,json=requireLowercase,proto3" json:"require_lowercase,omitempty"`
+	RequireUppercase bool                   `protobuf:"varint,3,opt,name=require_uppercase,json=requireUppercase,proto3" json:"require_uppercase,omitempty"`
+	RequireDigits    bool                   `protobuf:"varint,4,opt,name=require_digits,json=requireDigits,proto3" json:"require_digits,omitempty"`
+	RequireSymbols   bool                   `protobuf:"varint,5,opt,name=require_symbols,json=requireSymbols,proto3" json:"require_symbols,omitempty"`
+	// min_words applies to generated passphrases
+	MinWords      int32 `protobuf:"varint,6,opt,name=min_words,json=minWords,proto3" json:"min_words,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PasswordPolicy) Reset() {
+	*x = PasswordPolicy{}
+	mi := &file_vault_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PasswordPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PasswordPolicy) ProtoMessage() {}
+
+func (x *PasswordPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_vault_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PasswordPolicy.ProtoReflect.Descriptor instead.
+func (*PasswordPolicy) Descriptor() ([]byte, []int) {
+	return file_vault_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *PasswordPolicy) GetMinLength() int32 {
+	if x != nil {
+		return x.MinLength
+	}
+	return 0
+}
+
+func (x *PasswordPolicy) GetRequireLowercase() bool {
+	if x != nil {
+		return x.RequireLowercase
+	}
+	return false
+}
+
+func (x *PasswordPolicy) GetRequireUppercase() bool {
+	if x != nil {
+		return x.RequireUppercase
+	}
+	return false
+}
+
+func (x *PasswordPolicy) GetRequireDigits() bool {
+	if x != nil {
+		return x.RequireDigits
+	}
+	return false
+}
+
+func (x *PasswordPolicy) GetRequireSymbols() bool {
+	if x != nil {
+		return x.RequireSymbols
+	}
+	return false
+}
+
+func (x *PasswordPolicy) GetMinWords() int32 {
+	if x != nil {
+		return x.MinWords
+	}
+	return 0
+}
+
+type GeneratePasswordRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// length of a random password, defaults to 20
+	Length int32 `protobuf:"varint,1,opt,name=length,proto3" json:"length,omitempty"`
+	// when no character class is selected all of them are used
+	Lowercase        bool `protobuf:"varint,2,opt,name=lowercase,proto3" json:"lowercase,omitempty"`
+	Uppercase        bool `protobuf:"varint,3,opt,name=uppercase,proto3" json:"uppercase,omitempty"`
+	Digits           bool `protobuf:"varint,4,opt,name=digits,proto3" json:"digits,omitempty"`
+	Symbols          bool `protobuf:"varint,5,opt,name=symbols,proto3" json:"symbols,omitempty"`
+	ExcludeAmbiguous bool `protobuf:"varint,6,opt,name=exclude_ambiguous,json=excludeAmbiguous,proto3" json:"exclude_ambiguous,omitempty"`
+	// passphrase generates diceware words instead of random characters
+	Passphrase bool `protobuf:"varint,7,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
+	// words of a passphrase, defaults to 6
+	Words int32 `protobuf:"varint,8,opt,name=words,proto3" json:"words,omitempty"`
+	// separator between words, defaults to "-"
+	Separator     *string `protobuf:"bytes,9,opt,name=separator,proto3,oneof" json:"separator,omitempty"`
+	Capitalize    bool    `protobuf:"varint,10,opt,name=capitalize,proto3" json:"capitalize,omitempty"`
+	IncludeNumber bool    `protobuf:"varint,11,opt,name=include_number,json=includeNumber,proto3" json:"include_number,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GeneratePasswordRequest) Reset() {
+	*x = GeneratePasswordRequest{}
+	mi := &file_vault_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GeneratePasswordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GeneratePasswordRequest) ProtoMessage() {}
+
+func (x *GeneratePasswordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vault_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GeneratePasswordRequest.ProtoReflect.Descriptor instead.
+func (*GeneratePasswordRequest) Descriptor() ([]byte, []int) {
+	return file_vault_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GeneratePasswordRequest) GetLength() int32 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+func (x *GeneratePasswordRequest) GetLowercase() bool {
+	if x != nil {
+		return x.Lowercase
+	}
+	return false
+}
+
+func (x *GeneratePasswordRequest) GetUppercase() bool {
+	if x != nil {
+		return x.Uppercase
+	}
+	return false
+}
+
+func (x *GeneratePasswordRequest) GetDigits() bool {
+	if x != nil {
+		return x.Digits
+	}
+	return false
+}
+
+func (x *GeneratePasswordRequest) GetSymbols() bool {
+	if x != nil {
+		return x.Symbols
+	}
+	return false
+}
+
+func (x *GeneratePasswordRequest) GetExcludeAmbiguous() bool {
+	if x != nil {
+		return x.ExcludeAmbiguous
+	}
+	return false
+}
+
+func (x *GeneratePasswordRequest) GetPassphrase() bool {
+	if x != nil {
+		return x.Passphrase
+	}
+	return false
+}
+
+func (x *GeneratePasswordRequest) GetWords() int32 {
+	if x != nil {
+		return x.Words
+	}
+	return 0
+}
+
+func (x *GeneratePasswordRequest) GetSeparator() string {
+	if x != nil && x.Separator != nil {
+		return *x.Separator
+	}
+	return ""
+}
+
+func (x *GeneratePasswordRequest) GetCapitalize() bool {
+	if x != nil {
+		return x.Capitalize
+	}
+	return false
+}
+
+func (x *GeneratePasswordRequest) GetIncludeNumber() bool {
+	if x != nil {
+		return x.IncludeNumber
+	}
+	return false
+}
+
+type GeneratePasswordResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Password      string                 `protobuf:"bytes,1,opt,name=password,proto3" json:"password,omitempty"`
+	EntropyBits   float64                `protobuf:"fixed64,2,opt,name=entropy_bits,json=entropyBits,proto3" json:"entropy_bits,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GeneratePasswordResponse) Reset() {
+	*x = GeneratePasswordResponse{}
+	mi := &file_vault_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GeneratePasswordResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GeneratePasswordResponse) ProtoMessage() {}
+
+func (x *GeneratePasswordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vault_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GeneratePasswordResponse.ProtoReflect.Descriptor instead.
+func (*GeneratePasswordResponse) Descriptor() ([]byte, []int) {
+	return file_vault_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GeneratePasswordResponse) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *GeneratePasswordResponse) GetEntropyBits() float64 {
+	if x != nil {
+		return x.EntropyBits
+	}
+	return 0
+}
+
+type GetPasswordPolicyRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// organization returns the organization wide policy instead of the caller's own
+	Organization  bool `protobuf:"varint,1,opt,name=organization,proto3" json:"organization,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPasswordPolicyRequest) Reset() {
+	*x = GetPasswordPolicyRequest{}
+	mi := &file_vault_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPasswordPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPasswordPolicyRequest) ProtoMessage() {}
+
+func (x *GetPasswordPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vault_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPasswordPolicyRequest.ProtoReflect.Descriptor instead.
+func (*GetPasswordPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_vault_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetPasswordPolicyRequest) GetOrganization() bool {
+	if x != nil {
+		return x.Organization
+	}
+	return false
+}
+
+type GetPasswordPolicyResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Policy *PasswordPolicy        `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
+	// effective is the policy applied to the caller, the organization policy tightened by the caller's own
+	Effective     *PasswordPolicy `protobuf:"bytes,2,opt,name=effective,proto3" json:"effective,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPasswordPolicyResponse) Reset() {
+	*x = GetPasswordPolicyResponse{}
+	mi := &file_vault_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPasswordPolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPasswordPolicyResponse) ProtoMessage() {}
+
+func (x *GetPasswordPolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vault_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPasswordPolicyResponse.ProtoReflect.Descriptor instead.
+func (*GetPasswordPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_vault_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetPasswordPolicyResponse) GetPolicy() *PasswordPolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+func (x *GetPasswordPolicyResponse) GetEffective() *PasswordPolicy {
+	if x != nil {
+		return x.Effective
+	}
+	return nil
+}
+
+type SetPasswordPolicyRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Policy *PasswordPolicy        `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
+	// organization sets the organization wide policy, only allowed for admins
+	Organization  bool `protobuf:"varint,2,opt,name=organization,proto3" json:"organization,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetPasswordPolicyRequest) Reset() {
+	*x = SetPasswordPolicyRequest{}
+	mi := &file_vault_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPasswordPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPasswordPolicyRequest) ProtoMessage() {}
+
+func (x *SetPasswordPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vault_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPasswordPolicyRequest.ProtoReflect.Descriptor instead.
+func (*SetPasswordPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_vault_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *SetPasswordPolicyRequest) GetPolicy() *PasswordPolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+func (x *SetPasswordPolicyRequest) GetOrganization() bool {
+	if x != nil {
+		return x.Organization
+	}
+	return false
+}
+
+type SetPasswordPolicyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Policy        *PasswordPolicy        `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetPasswordPolicyResponse) Reset() {
+	*x = SetPasswordPolicyResponse{}
+	mi := &file_vault_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPasswordPolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPasswordPolicyResponse) ProtoMessage() {}
+
+func (x *SetPasswordPolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vault_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPasswordPolicyResponse.ProtoReflect.Descriptor instead.
+func (*SetPasswordPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_vault_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *SetPasswordPolicyResponse) GetPolicy() *PasswordPolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+type GetVaultHealthReportRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// passwords scoring below min_score are weak, defaults to 3
+	MinScore int32 `protobuf:"varint,1,opt,name=min_score,json=minScore,proto3" json:"min_score,omitempty"`
+	// passwords not changed for more than max_age_days are old, defaults to 365
+	MaxAgeDays    int32 `protobuf:"varint,2,opt,name=max_age_days,json=maxAgeDays,proto3" json:"max_age_days,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVaultHealthReportRequest) Reset() {
+	*x = GetVaultHealthReportRequest{}
+	mi := &file_vault_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVaultHealthReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVaultHealthReportRequest) ProtoMessage() {}
+
+func (x *GetVaultHealthReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vault_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVaultHealthReportRequest.ProtoReflect.Descriptor instead.
+func (*GetVaultHealthReportRequest) Descriptor() ([]byte, []int) {
+	return file_vault_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetVaultHealthReportRequest) GetMinScore() int32 {
+	if x != nil {
+		return x.MinScore
+	}
+	return 0
+}
+
+func (x *GetVaultHealthReportRequest) GetMaxAgeDays() int32 {
+	if x != nil {
+		return x.MaxAgeDays
+	}
+	return 0
+}
+
+type EntryRef struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Username      string                 `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	Domain        string                 `protobuf:"bytes,4,opt,name=domain,proto3" json:"domain,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EntryRef) Reset() {
+	*x = EntryRef{}
+	mi := &file_vault_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EntryRef) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EntryRef) ProtoMessage() {}
+
+func (x *EntryRef) ProtoReflect() protoreflect.Message {
+	mi := &file_vault_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EntryRef.ProtoReflect.Descriptor instead.
+func (*EntryRef) Descriptor() ([]byte, []int) {
+	return file_vault_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *EntryRef) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *EntryRef) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *EntryRef) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *EntryRef) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+type WeakPassword struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entry         *EntryRef              `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+	Strength      *PasswordStrength      `protobuf:"bytes,2,opt,name=strength,proto3" json:"strength,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WeakPassword) Reset() {
+	*x = WeakPassword{}
+	mi := &file_vault_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WeakPassword) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WeakPassword) ProtoMessage() {}
+
+func (x *WeakPassword) ProtoReflect() protoreflect.Message {
+	mi := &file_vault_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WeakPassword.ProtoReflect.Descriptor instead.
+func (*WeakPassword) Descriptor() ([]byte, []int) {
+	return file_vault_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *WeakPassword) GetEntry() *EntryRef {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+func (x *WeakPassword) GetStrength() *PasswordStrength {
+	if x != nil {
+		return x.Strength
+	}
+	return nil
+}
+
+type ReusedPassword struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// entries sharing the same password
+	Entries       []*EntryRef `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReusedPassword) Reset() {
+	*x = ReusedPassword{}
+	mi := &file_vault_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReusedPassword) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReusedPassword) ProtoMessage() {}
+
+func (x *ReusedPassword) ProtoReflect() protoreflect.Message {
+	mi := &file_vault_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReusedPassword.ProtoReflect.Descriptor instead.
+func (*ReusedPassword) Descriptor() ([]byte, []int) {
+	return file_vault_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ReusedPassword) GetEntries() []*EntryRef {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type OldPassword struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Entry *EntryRef              `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+	// unix timestamp of the last change
+	ChangedAt     int64 `protobuf:"varint,2,opt,name=changed_at,json=changedAt,proto3" json:"changed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OldPassword) Reset() {
+	*x = OldPassword{}
+	mi := &file_vault_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OldPassword) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OldPassword) ProtoMessage() {}
+
+func (x *OldPassword) ProtoReflect() protoreflect.Message {
+	mi := &file_vault_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OldPassword.ProtoReflect.Descriptor instead.
+func (*OldPassword) Descriptor() ([]byte, []int) {
+	return file_vault_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *OldPassword) GetEntry() *EntryRef {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+func (x *OldPassword) GetChangedAt() int64 {
+	if x != nil {
+		return x.ChangedAt
+	}
+	return 0
+}
+
+type GetVaultHealthReportResponse struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Weak         []*WeakPassword        `protobuf:"bytes,1,rep,name=weak,proto3" json:"weak,omitempty"`
+	Reused       []*ReusedPassword      `protobuf:"bytes,2,rep,name=reused,proto3" json:"reused,omitempty"`
+	Old          []*OldPassword         `protobuf:"bytes,3,rep,name=old,proto3" json:"old,omitempty"`
+	TotalEntries int32                  `protobuf:"varint,4,opt,name=total_entries,json=totalEntries,proto3" json:"total_entries,omitempty"`
+	// client encrypted entries cannot be analysed by the server and are skipped
+	SkippedEntries int32               `protobuf:"varint,5,opt,name=skipped_entries,json=skippedEntries,proto3" json:"skipped_entries,omitempty"`
+	Breached       []*BreachedPassword `protobuf:"bytes,6,rep,name=breached,proto3" json:"breached,omitempty"`
+	// breach_check_enabled is false when the server has no breach index, breached is empty then
+	BreachCheckEnabled bool `protobuf:"varint,7,opt,name=breach_check_enabled,json=breachCheckEnabled,proto3" json:"breach_check_enabled,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *GetVaultHealthReportResponse) Reset() {
+	*x = GetVaultHealthReportResponse{}
+	mi := &file_vault_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVaultHealthReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVaultHealthReportResponse) ProtoMessage() {}
+
+func (x *GetVaultHealthReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vault_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVaultHealthReportResponse.ProtoReflect.Descriptor instead.
+func (*GetVaultHealthReportResponse) Descriptor() ([]byte, []int) {
+	return file_vault_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetVaultHealthReportResponse) GetWeak() []*WeakPassword {
+	if x != nil {
+		return x.Weak
+	}
+	return nil
+}
+
+func (x *GetVaultHealthReportResponse) GetReused() []*ReusedPassword {
+	if x != nil {
+		return x.Reused
+	}
+	return nil
+}
+
+func (x *GetVaultHealthReportResponse) GetOld() []*OldPassword {
+	if x != nil {
+		return x.Old
+	}
+	return nil
+}
+
+func (x *GetVaultHealthReportResponse) GetTotalEntries() int32 {
+	if x != nil {
+		return x.TotalEntries
+	}
+	return 0
+}
+
+func (x *GetVaultHealthReportResponse) GetSkippedEntries() int32 {
+	if x != nil {
+		return x.SkippedEntries
+	}
+	return 0
+}
+
+func (x *GetVaultHealthReportResponse) GetBreached() []*BreachedPassword {
+	if x != nil {
+		return x.Breached
+	}
+	return nil
+}
+
+func (x *GetVaultHealthReportResponse) GetBreachCheckEnabled() bool {
+	if x != nil {
+		return x.BreachCheckEnabled
+	}
+	return false
+}
+
+type BreachedPassword struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entry         *EntryRef              `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+	Count         int64                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BreachedPassword) Reset() {
+	*x = BreachedPassword{}
+	mi := &file_vault_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BreachedPassword) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BreachedPassword) ProtoMessage() {}
+
+func (x *BreachedPassword) ProtoReflect() protoreflect.Message {
+	mi := &file_vault_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BreachedPassword.ProtoReflect.Descriptor instead.
+func (*BreachedPassword) Descriptor() ([]byte, []int) {
+	return file_vault_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *BreachedPassword) GetEntry() *EntryRef {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+func (x *BreachedPassword) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type FindReusedPasswordsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FindReusedPasswordsRequest) Reset() {
+	*x = FindReusedPasswordsRequest{}
+	mi := &file_vault_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindReusedPasswordsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindReusedPasswordsRequest) ProtoMessage() {}
+
+func (x *FindReusedPasswordsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vault_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindReusedPasswordsRequest.ProtoReflect.Descriptor instead.
+func (*FindReusedPasswordsRequest) Descriptor() ([]byte, []int) {
+	return file_vault_proto_rawDescGZIP(), []int{39}
+}
+
+type FindReusedPasswordsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Groups        []*ReusedPassword      `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FindReusedPasswordsResponse) Reset() {
+	*x = FindReusedPasswordsResponse{}
+	mi := &file_vault_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindReusedPasswordsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindReusedPasswordsResponse) ProtoMessage() {}
+
+func (x *FindReusedPasswordsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vault_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindReusedPasswordsResponse.ProtoReflect.Descriptor instead.
+func (*FindReusedPasswordsResponse) Descriptor() ([]byte, []int) {
+	return file_vault_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *FindReusedPasswordsResponse) GetGroups() []*ReusedPassword {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+type ListEntriesDueForRotationRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// within_days also lists entries that become due within that many days
+	WithinDays    int32 `protobuf:"varint,1,opt,name=within_days,json=withinDays,proto3" json:"within_days,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEntriesDueForRotationRequest) Reset() {
+	*x = ListEntriesDueForRotationRequest{}
+	mi := &file_vault_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEntriesDueForRotationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEntriesDueForRotationRequest) ProtoMessage() {}
+
+func (x *ListEntriesDueForRotationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vault_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEntriesDueForRotationRequest.ProtoReflect.Descriptor instead.
+func (*ListEntriesDueForRotationRequest) Descriptor() ([]byte, []int) {
+	return file_vault_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ListEntriesDueForRotationRequest) GetWithinDays() int32 {
+	if x != nil {
+		return x.WithinDays
+	}
+	return 0
+}
+
+type DueEntry struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Entry *VaultEntry            `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+	// unix timestamp the password is or was due
+	DueAt         int64 `protobuf:"varint,2,opt,name=due_at,json=dueAt,proto3" json:"due_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DueEntry) Reset() {
+	*x = DueEntry{}
+	mi := &file_vault_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DueEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DueEntry) ProtoMessage() {}
+
+func (x *DueEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_vault_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DueEntry.ProtoReflect.Descriptor instead.
+func (*DueEntry) Descriptor() ([]byte, []int) {
+	return file_vault_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *DueEntry) GetEntry() *VaultEntry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+func (x *DueEntry) GetDueAt() int64 {
+	if x != nil {
+		return x.DueAt
+	}
+	return 0
+}
+
+type ListEntriesDueForRotationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*DueEntry            `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEntriesDueForRotationResponse) Reset() {
+	*x = ListEntriesDueForRotationResponse{}
+	mi := &file_vault_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEntriesDueForRotationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEntriesDueForRotationResponse) ProtoMessage() {}
+
+func (x *ListEntriesDueForRotationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vault_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEntriesDueForRotationResponse.ProtoReflect.Descriptor instead.
+func (*ListEntriesDueForRotationResponse) Descriptor() ([]byte, []int) {
+	return file_vault_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ListEntriesDueForRotationResponse) GetEntries() []*DueEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type FolderRotation struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// folder is the path of the folder
+	Folder               string `protobuf:"bytes,1,opt,name=folder,proto3" json:"folder,omitempty"`
+	RotationIntervalDays int32  `protobuf:"varint,2,opt,name=rotation_interval_days,json=rotationIntervalDays,proto3" json:"rotation_interval_days,omitempty"`
+	FolderId             string `protobuf:"bytes,3,opt,name=folder_id,json=folderId,proto3" json:"folder_id,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *FolderRotation) Reset() {
+	*x = FolderRotation{}
+	mi := &file_vault_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FolderRotation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FolderRotation) ProtoMessage() {}
+
+func (x *FolderRotation) ProtoReflect() protoreflect.Message {
+	mi := &file_vault_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FolderRotation.ProtoReflect.Descriptor instead.
+func (*FolderRotation) Descriptor() ([]byte, []int) {
+	return file_vault_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *FolderRotation) GetFolder() string {
+	if x != nil {
+		return x.Folder
+	}
+	return ""
+}
+
+func (x *FolderRotation) GetRotationIntervalDays() int32 {
+	if x != nil {
+		return x.RotationIntervalDays
+	}
+	return 0
+}
+
+func (x *FolderRotation) GetFolderId() string {
+	if x != nil {
+		return x.FolderId
+	}
+	return ""
+}
+
+type SetFolderRotationRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// folder is the path of an existing folder, it is only used when folder_id is empty
+	Folder string `protobuf:"bytes,1,opt,name=folder,proto3" json:"folder,omitempty"`
+	// 0 removes the folder's rotation interval, subfolders without an interval inherit it
+	RotationIntervalDays int32  `protobuf:"varint,2,opt,name=rotation_interval_days,json=rotationIntervalDays,proto3" json:"rotation_interval_days,omitempty"`
+	FolderId             string `protobuf:"bytes,3,opt,name=folder_id,json=folderId,proto3" json:"folder_id,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *SetFolderRotationRequest) Reset() {
+	*x = SetFolderRotationRequest{}
+	mi := &file_vault_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetFolderRotationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetFolderRotationRequest) ProtoMessage() {}
+
+func (x *SetFolderRotationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vault_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetFolderRotationRequest.ProtoReflect.Descriptor instead.
+func (*SetFolderRotationRequest) Descriptor() ([]byte, []int) {
+	return file_vault_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *SetFolderRotationRequest) GetFolder() string {
+	if x != nil {
+		return x.Folder
+	}
+	return ""
+}
+
+func (x *SetFolderRotationRequest) GetRotationIntervalDays() int32 {
+	if x != nil {
+		return x.RotationIntervalDays
+	}
+	return 0
+}
+
+func (x *SetFolderRotationRequest) GetFolderId() string {
+	if x != nil {
+		return x.FolderId
+	}
+	return ""
+}
+
+type SetFolderRotationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetFolderRotationResponse) Reset() {
+	*x = SetFolderRotationResponse{}
+	mi := &file_vault_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetFolderRotationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetFolderRotationResponse) ProtoMessage() {}
+
+func (x *SetFolderRotationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vault_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetFolderRotationResponse.ProtoReflect.Descriptor instead.
+func (*SetFolderRotationResponse) Descriptor() ([]byte, []int) {
+	return file_vault_proto_rawDescGZIP(), []int{46}
+}
+
+type ListFolderRotationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFolderRotationsRequest) Reset() {
+	*x = ListFolderRotationsRequest{}
+	mi := &file_vault_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFolderRotationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFolderRotationsRequest) ProtoMessage() {}
+
+func (x *ListFolderRotationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vault_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFolderRotationsRequest.ProtoReflect.Descriptor instead.
+func (*ListFolderRotationsRequest) Descriptor() ([]byte, []int) {
+	return file_vault_proto_rawDescGZIP(), []int{47}
+}
+
+type ListFolderRotationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rotations     []*FolderRotation      `protobuf:"bytes,1,rep,name=rotations,proto3" json:"rotations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFolderRotationsResponse) Reset() {
+	*x = ListFolderRotationsResponse{}
+	mi := &file_vault_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFolderRotationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFolderRotationsResponse) ProtoMessage() {}
+
+func (x *ListFolderRotationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vault_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFolderRotationsResponse.ProtoReflect.Descriptor instead.
+func (*ListFolderRotationsResponse) Descriptor() ([]byte, []int) {
+	return file_vault_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ListFolderRotationsResponse) GetRotations() []*FolderRotation {
+	if x != nil {
+		return x.Rotations
+	}
+	return nil
+}
+
+// CheckBreachedRequest checks either a candidate password or the password of a stored entry
+type CheckBreachedRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Password      string                 `protobuf:"bytes,1,opt,name=password,proto3" json:"password,omitempty"`
+	EntryId       string                 `protobuf:"bytes,2,opt,name=entry_id,json=entryId,proto3" json:"entry_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckBreachedRequest) Reset() {
+	*x = CheckBreachedRequest{}
+	mi := &file_vault_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckBreachedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckBreachedRequest) ProtoMessage() {}
+
+func (x *CheckBreachedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vault_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckBreachedRequest.ProtoReflect.Descriptor instead.
+func (*CheckBreachedRequest) Descriptor() ([]byte, []int) {
+	return file_vault_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *CheckBreachedRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *CheckBreachedRequest) GetEntryId() string {
+	if x != nil {
+		return x.EntryId
+	}
+	return ""
+}
+
+type CheckBreachedResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        *BreachCheck           `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckBreachedResponse) Reset() {
+	*x = CheckBreachedResponse{}
+	mi := &file_vault_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckBreachedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckBreachedResponse) ProtoMessage() {}
+
+func (x *CheckBreachedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vault_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckBreachedResponse.ProtoReflect.Descriptor instead.
+func (*CheckBreachedResponse) Descriptor() ([]byte, []int) {
+	return file_vault_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *CheckBreachedResponse) GetResult() *BreachCheck {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type Attachment struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	EntryId     string                 `protobuf:"bytes,2,opt,name=entry_id,json=entryId,proto3" json:"entry_id,omitempty"`
+	FileName    string                 `protobuf:"bytes,3,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	ContentType string                 `protobuf:"bytes,4,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	// size is the plaintext size in bytes
+	Size          int64 `protobuf:"varint,5,opt,name=size,proto3" json:"size,omitempty"`
+	CreatedAt     int64 `protobuf:"varint,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Attachment) Reset() {
+	*x = Attachment{}
+	mi := &file_vault_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Attachment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Attachment) ProtoMessage() {}
+
+func (x *Attachment) ProtoReflect() protoreflect.Message {
+	mi := &file_vault_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Attachment.ProtoReflect.Descriptor instead.
+func (*Attachment) Descriptor() ([]byte, []int) {
+	return file_vault_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *Attachment) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Attachment) GetEntryId() string {
+	if x != nil {
+		return x.EntryId
+	}
+	return ""
+}
+
+func (x *Attachment) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *Attachment) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *Attachment) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *Attachment) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+// UploadAttachmentHeader is the first message of an upload, the content follows in chunks
+type UploadAttachmentHeader struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	EntryId     string                 `protobuf:"bytes,1,opt,name=entry_id,json=entryId,proto3" json:"entry_id,omitempty"`
+	FileName    string                 `protobuf:"bytes,2,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	ContentType string                 `protobuf:"bytes,3,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	// size is the expected size, when set an upload that cannot fit the quota fails before any content
+	Size int64 `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+	// owner_id requires an emergency takeover grant from that user
+	OwnerId       string `protobuf:"bytes,5,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadAttachmentHeader) Reset() {
+	*x = UploadAttachmentHeader{}
+	mi := &file_vault_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadAttachmentHeader) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadAttachmentHeader) ProtoMessage() {}
+
+func (x *UploadAttachmentHeader) ProtoReflect() protoreflect.Message {
+	mi := &file_vault_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadAttachmentHeader.ProtoReflect.Descriptor instead.
+func (*UploadAttachmentHeader) Descriptor() ([]byte, []int) {
+	return file_vault_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *UploadAttachmentHeader) GetEntryId() string {
+	if x != nil {
+		return x.EntryId
+	}
+	return ""
+}
+
+func (x *UploadAttachmentHeader) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *UploadAttachmentHeader) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *UploadAttachmentHeader) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *UploadAttachmentHeader) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+type UploadAttachmentRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Data:
+	//
+	//	*UploadAttachmentRequest_Header
+	//	*UploadAttachmentRequest_Chunk
+	Data          isUploadAttachmentRequest_Data `protobuf_oneof:"data"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadAttachmentRequest) Reset() {
+	*x = UploadAttachmentRequest{}
+	mi := &file_vault_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadAttachmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadAttachmentRequest) ProtoMessage() {}
+
+func (x *UploadAttachmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vault_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadAttachmentRequest.ProtoReflect.Descriptor instead.
+func (*UploadAttachmentRequest) Descriptor() ([]byte, []int) {
+	return file_vault_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *UploadAttachmentRequest) GetData() isUploadAttachmentRequest_Data {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *UploadAttachmentRequest) GetHeader() *UploadAttachmentHeader {
+	if x != nil {
+		if x, ok := x.Data.(*UploadAttachmentRequest_Header); ok {
+			return x.Header
+		}
+	}
+	return nil
+}
+
+func (x *UploadAttachmentRequest) GetChunk() []byte {
+	if x != nil {
+		if x, ok := x.Data.(*UploadAttachmentRequest_Chunk); ok {
+			return x.Chunk
+		}
+	}
+	return nil
+}
+
+type isUploadAttachmentRequest_Data interface {
+	isUploadAttachmentRequest_Data()
+}
+
+type UploadAttachmentRequest_Header struct {
+	Header *UploadAttachmentHeader `protobuf:"bytes,1,opt,name=header,proto3,oneof"`
+}
+
+type UploadAttachmentRequest_Chunk struct {
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+func (*UploadAttachmentRequest_Header) isUploadAttachmentRequest_Data() {}
+
+func (*UploadAttachmentRequest_Chunk) isUploadAttachmentRequest_Data() {}
+
+type UploadAttachmentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Attachment    *Attachment            `protobuf:"bytes,1,opt,name=attachment,proto3" json:"attachment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadAttachmentResponse) Reset() {
+	*x = UploadAttachmentResponse{}
+	mi := &file_vault_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadAttachmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadAttachmentResponse) ProtoMessage() {}
+
+func (x *UploadAttachmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vault_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadAttachmentResponse.ProtoReflect.Descriptor instead.
+func (*UploadAttachmentResponse) Descriptor() ([]byte, []int) {
+	return file_vault_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *UploadAttachmentResponse) GetAttachment() *Attachment {
+	if x != nil {
+		return x.Attachment
+	}
+	return nil
+}
+
+type DownloadAttachmentRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// owner_id is set by an emergency contact to download the grantor's attachment
+	OwnerId       string `protobuf:"bytes,2,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DownloadAttachmentRequest) Reset() {
+	*x = DownloadAttachmentRequest{}
+	mi := &file_vault_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DownloadAttachmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadAttachmentRequest) ProtoMessage() {}
+
+func (x *DownloadAttachmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vault_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadAttachmentRequest.ProtoReflect.Descriptor instead.
+func (*DownloadAttachmentRequest) Descriptor() ([]byte, []int) {
+	return file_vault_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *DownloadAttachmentRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DownloadAttachmentRequest) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+// DownloadAttachmentResponse carries the attachment's metadata in the first message and its content in
+// the following ones
+type DownloadAttachmentResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Data:
+	//
+	//	*DownloadAttachmentResponse_Attachment
+	//	*DownloadAttachmentResponse_Chunk
+	Data          isDownloadAttachmentResponse_Data `protobuf_oneof:"data"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DownloadAttachmentResponse) Reset() {
+	*x = DownloadAttachmentResponse{}
+	mi := &file_vault_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DownloadAttachmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadAttachmentResponse) ProtoMessage() {}
+
+func (x *DownloadAttachmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vault_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadAttachmentResponse.ProtoReflect.Descriptor instead.
+func (*DownloadAttachmentResponse) Descriptor() ([]byte, []int) {
+	return file_vault_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *DownloadAttachmentResponse) GetData() isDownloadAttachmentResponse_Data {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *DownloadAttachmentResponse) GetAttachment() *Attachment {
+	if x != nil {
+		if x, ok := x.Data.(*DownloadAttachmentResponse_Attachment); ok {
+			return x.Attachment
+		}
+	}
+	return nil
+}
+
+func (x *DownloadAttachmentResponse) GetChunk() []byte {
+	if x != nil {
+		if x, ok := x.Data.(*DownloadAttachmentResponse_Chunk); ok {
+			return x.Chunk
+		}
+	}
+	return nil
+}
+
+type isDownloadAttachmentResponse_Data interface {
+	isDownloadAttachmentResponse_Data()
+}
+
+type DownloadAttachmentResponse_Attachment struct {
+	Attachment *Attachment `protobuf:"bytes,1,opt,name=attachment,proto3,oneof"`
+}
+
+type DownloadAttachmentResponse_Chunk struct {
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+func (*DownloadAttachmentResponse_Attachment) isDownloadAttachmentResponse_Data() {}
+
+func (*DownloadAttachmentResponse_Chunk) isDownloadAttachmentResponse_Data() {}
+
+type ListAttachmentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EntryId       string                 `protobuf:"bytes,1,opt,name=entry_id,json=entryId,proto3" json:"entry_id,omitempty"`
+	OwnerId       string                 `protobuf:"bytes,2,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAttachmentsRequest) Reset() {
+	*x = ListAttachmentsRequest{}
+	mi := &file_vault_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAttachmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAttachmentsRequest) ProtoMessage() {}
+
+func (x *ListAttachmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vault_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAttachmentsRequest.ProtoReflect.Descriptor instead.
+func (*ListAttachmentsRequest) Descriptor() ([]byte, []int) {
+	return file_vault_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ListAttachmentsRequest) GetEntryId() string {
+	if x != nil {
+		return x.EntryId
+	}
+	return ""
+}
+
+func (x *ListAttachmentsRequest) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+type ListAttachmentsResponse struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Attachments []*Attachment          `protobuf:"bytes,1,rep,name=attachments,proto3" json:"attachments,omitempty"`
+	// used_bytes and quota_bytes describe the owner's attachment quota
+	UsedBytes     int64 `protobuf:"varint,2,opt,name=used_bytes,json=usedBytes,proto3" json:"used_bytes,omitempty"`
+	QuotaBytes    int64 `protobuf:"varint,3,opt,name=quota_bytes,json=quotaBytes,proto3" json:"quota_bytes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAttachmentsResponse) Reset() {
+	*x = ListAttachmentsResponse{}
+	mi := &file_vault_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAttachmentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAttachmentsResponse) ProtoMessage() {}
+
+func (x *ListAttachmentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vault_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAttachmentsResponse.ProtoReflect.Descriptor instead.
+func (*ListAttachmentsResponse) Descriptor() ([]byte, []int) {
+	return file_vault_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ListAttachmentsResponse) GetAttachments() []*Attachment {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
+func (x *ListAttachmentsResponse) GetUsedBytes() int64 {
+	if x != nil {
+		return x.UsedBytes
+	}
+	return 0
+}
+
+func (x *ListAttachmentsResponse) GetQuotaBytes() int64 {
+	if x != nil {
+		return x.QuotaBytes
+	}
+	return 0
+}
+
+type DeleteAttachmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OwnerId       string                 `protobuf:"bytes,2,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAttachmentRequest) Reset() {
+	*x = DeleteAttachmentRequest{}
+	mi := &file_vault_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAttachmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAttachmentRequest) ProtoMessage() {}
+
+func (x *DeleteAttachmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vault_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAttachmentRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAttachmentRequest) Descriptor() ([]byte, []int) {
+	return file_vault_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *DeleteAttachmentRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeleteAttachmentRequest) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+type DeleteAttachmentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAttachmentResponse) Reset() {
+	*x = DeleteAttachmentResponse{}
+	mi := &file_vault_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAttachmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAttachmentResponse) ProtoMessage() {}
+
+func (x *DeleteAttachmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vault_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAttachmentResponse.ProtoReflect.Descriptor instead.
+func (*DeleteAttachmentResponse) Descriptor() ([]byte, []int) {
+	return file_vault_proto_rawDescGZIP(), []int{60}
+}
+
+type GetTOTPCodeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// owner_id is set by an emergency contact to read the grantor's code
+	OwnerId       string `protobuf:"bytes,2,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTOTPCodeRequest) Reset() {
+	*x = GetTOTPCodeRequest{}
+	mi := &file_vault_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTOTPCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTOTPCodeRequest) ProtoMessage() {}
+
+func (x *GetTOTPCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vault_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTOTPCodeRequest.ProtoReflect.Descriptor instead.
+func (*GetTOTPCodeRequest) Descriptor() ([]byte, []int) {
+	return file_vault_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *GetTOTPCodeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetTOTPCodeRequest) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+type GetTOTPCodeResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Code  string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	// remaining_seconds is how long the code stays valid
+	RemainingSeconds int32 `protobuf:"varint,2,opt,name=remaining_seconds,json=remainingSeconds,proto3" json:"remaining_seconds,omitempty"`
+	Period           int32 `protobuf:"varint,3,opt,name=period,proto3" json:"period,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GetTOTPCodeResponse) Reset() {
+	*x = GetTOTPCodeResponse{}
+	mi := &file_vault_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTOTPCodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTOTPCodeResponse) ProtoMessage() {}
+
+func (x *GetTOTPCodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vault_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTOTPCodeResponse.ProtoReflect.Descriptor instead.
+func (*GetTOTPCodeResponse) Descriptor() ([]byte, []int) {
+	return file_vault_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *GetTOTPCodeResponse) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *GetTOTPCodeResponse) GetRemainingSeconds() int32 {
+	if x != nil {
+		return x.RemainingSeconds
+	}
+	return 0
+}
+
+func (x *GetTOTPCodeResponse) GetPeriod() int32 {
+	if x != nil {
+		return x.Period
+	}
+	return 0
+}
+
+type StreamEntriesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// owner_id is set by an emergency contact to stream the grantor's entries
+	OwnerId string `protobuf:"bytes,1,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	// types limits the stream to entries of the given types, empty streams all types
+	Types []EntryType `protobuf:"varint,2,rep,packed,name=types,proto3,enum=vault.EntryType" json:"types,omitempty"`
+	// batch_size is the maximum number of entries per message, 0 uses the default of 100, at most 1000
+	BatchSize     int32 `protobuf:"varint,3,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamEntriesRequest) Reset() {
+	*x = StreamEntriesRequest{}
+	mi := &file_vault_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamEntriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamEntriesRequest) ProtoMessage() {}
+
+func (x *StreamEntriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vault_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamEntriesRequest.ProtoReflect.Descriptor instead.
+func (*StreamEntriesRequest) Descriptor() ([]byte, []int) {
+	return file_vault_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *StreamEntriesRequest) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *StreamEntriesRequest) GetTypes() []EntryType {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+func (x *StreamEntriesRequest) GetBatchSize() int32 {
+	if x != nil {
+		return x.BatchSize
+	}
+	return 0
+}
+
+// StreamEntriesResponse carries one batch of entries in creation order
+type StreamEntriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*VaultEntry          `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamEntriesResponse) Reset() {
+	*x = StreamEntriesResponse{}
+	mi := &file_vault_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamEntriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamEntriesResponse) ProtoMessage() {}
+
+func (x *StreamEntriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vault_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamEntriesResponse.ProtoReflect.Descriptor instead.
+func (*StreamEntriesResponse) Descriptor() ([]byte, []int) {
+	return file_vault_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *StreamEntriesResponse) GetEntries() []*VaultEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type SearchEntriesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// query combines free text with title:, user:, tag:, folder: and domain: terms, e.g.
+	// `bank title:"online banking" tag:finance`. Values with spaces are quoted, all terms must match.
+	// domain: terms match entries with a URI in the registrable domain of their value
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	// owner_id is set by an emergency contact to search the grantor's entries
+	OwnerId string `protobuf:"bytes,2,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	// limit is the maximum number of results, 0 uses the default of 100, at most 1000
+	Limit         int32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchEntriesRequest) Reset() {
+	*x = SearchEntriesRequest{}
+	mi := &file_vault_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchEntriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchEntriesRequest) ProtoMessage() {}
+
+func (x *SearchEntriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vault_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchEntriesRequest.ProtoReflect.Descriptor instead.
+func (*SearchEntriesRequest) Descriptor() ([]byte, []int) {
+	return file_vault_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *SearchEntriesRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchEntriesRequest) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *SearchEntriesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type SearchResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Entry *VaultEntry            `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+	// rank orders the results, higher ranks match the query better
+	Rank          float32 `protobuf:"fixed32,2,opt,name=rank,proto3" json:"rank,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchResult) Reset() {
+	*x = SearchResult{}
+	mi := &file_vault_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchResult) ProtoMessage() {}
+
+func (x *SearchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_vault_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchResult.ProtoReflect.Descriptor instead.
+func (*SearchResult) Descriptor() ([]byte, []int) {
+	return file_vault_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *SearchResult) GetEntry() *VaultEntry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+func (x *SearchResult) GetRank() float32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+type SearchEntriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*SearchResult        `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchEntriesResponse) Reset() {
+	*x = SearchEntriesResponse{}
+	mi := &file_vault_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchEntriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchEntriesResponse) ProtoMessage() {}
+
+func (x *SearchEntriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vault_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchEntriesResponse.ProtoReflect.Descriptor instead.
+func (*SearchEntriesResponse) Descriptor() ([]byte, []int) {
+	return file_vault_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *SearchEntriesResponse) GetResults() []*SearchResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type Folder struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// parent_id is empty for top level folders
+	ParentId string `protobuf:"bytes,2,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	Name     string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// path is the names from the top level folder down to this one separated by "/", e.g. "Work/Dev"
+	Path                 string `protobuf:"bytes,4,opt,name=path,proto3" json:"path,omitempty"`
+	RotationIntervalDays *int32 `protobuf:"varint,5,opt,name=rotation_interval_days,json=rotationIntervalDays,proto3,oneof" json:"rotation_interval_days,omitempty"`
+	// entry_count is the number of entries directly in the folder
+	EntryCount    int32 `protobuf:"varint,6,opt,name=entry_count,json=entryCount,proto3" json:"entry_count,omitempty"`
+	CreatedAt     int64 `protobuf:"varint,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     int64 `protobuf:"varint,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Folder) Reset() {
+	*x = Folder{}
+	mi := &file_vault_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Folder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Folder) ProtoMessage() {}
+
+func (x *Folder) ProtoReflect() protoreflect.Message {
+	mi := &file_vault_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Folder.ProtoReflect.Descriptor instead.
+func (*Folder) Descriptor() ([]byte, []int) {
+	return file_vault_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *Folder) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Folder) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+func (x *Folder) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Folder) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *Folder) GetRotationIntervalDays() int32 {
+	if x != nil && x.RotationIntervalDays != nil {
+		return *x.RotationIntervalDays
+	}
+	return 0
+}
+
+func (x *Folder) GetEntryCount() int32 {
+	if x != nil {
+		return x.EntryCount
+	}
+	return 0
+}
+
+func (x *Folder) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Folder) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+type CreateFolderRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// parent_id creates a subfolder, empty creates a top level folder
+	ParentId      string `protobuf:"bytes,2,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateFolderRequest) Reset() {
+	*x = CreateFolderRequest{}
+	mi := &file_vault_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateFolderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateFolderRequest) ProtoMessage() {}
+
+func (x *CreateFolderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vault_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateFolderRequest.ProtoReflect.Descriptor instead.
+func (*CreateFolderRequest) Descriptor() ([]byte, []int) {
+	return file_vault_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *CreateFolderRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateFolderRequest) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+type CreateFolderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Folder        *Folder                `protobuf:"bytes,1,opt,name=folder,proto3" json:"folder,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateFolderResponse) Reset() {
+	*x = CreateFolderResponse{}
+	mi := &file_vault_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateFolderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateFolderResponse) ProtoMessage() {}
+
+func (x *CreateFolderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vault_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateFolderResponse.ProtoReflect.Descriptor instead.
+func (*CreateFolderResponse) Descriptor() ([]byte, []int) {
+	return file_vault_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *CreateFolderResponse) GetFolder() *Folder {
+	if x != nil {
+		return x.Folder
+	}
+	return nil
+}
+
+type RenameFolderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenameFolderRequest) Reset() {
+	*x = RenameFolderRequest{}
+	mi := &file_vault_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenameFolderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameFolderRequest) ProtoMessage() {}
+
+func (x *RenameFolderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vault_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameFolderRequest.ProtoReflect.Descriptor instead.
+func (*RenameFolderRequest) Descriptor() ([]byte, []int) {
+	return file_vault_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *RenameFolderRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RenameFolderRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type RenameFolderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Folder        *Folder                `protobuf:"bytes,1,opt,name=folder,proto3" json:"folder,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenameFolderResponse) Reset() {
+	*x = RenameFolderResponse{}
+	mi := &file_vault_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenameFolderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameFolderResponse) ProtoMessage() {}
+
+func (x *RenameFolderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vault_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameFolderResponse.ProtoReflect.Descriptor instead.
+func (*RenameFolderResponse) Descriptor() ([]byte, []int) {
+	return file_vault_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *RenameFolderResponse) GetFolder() *Folder {
+	if x != nil {
+		return x.Folder
+	}
+	return nil
+}
+
+type MoveFolderRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// parent_id is the new parent, empty moves the folder to the top level
+	ParentId      string `protobuf:"bytes,2,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveFolderRequest) Reset() {
+	*x = MoveFolderRequest{}
+	mi := &file_vault_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveFolderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveFolderRequest) ProtoMessage() {}
+
+func (x *MoveFolderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vault_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveFolderRequest.ProtoReflect.Descriptor instead.
+func (*MoveFolderRequest) Descriptor() ([]byte, []int) {
+	return file_vault_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *MoveFolderRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MoveFolderRequest) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+type MoveFolderResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Username      string                 `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
-	Password      string                 `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`
-	Notes         string                 `protobuf:"bytes,5,opt,name=notes,proto3" json:"notes,omitempty"`
-	Tags          []string               `protobuf:"bytes,6,rep,name=tags,proto3" json:"tags,omitempty"`
-	Folder        string                 `protobuf:"bytes,7,opt,name=folder,proto3" json:"folder,omitempty"`
-	Domain        string                 `protobuf:"bytes,8,opt,name=domain,proto3" json:"domain,omitempty"`
+	Folder        *Folder                `protobuf:"bytes,1,opt,name=folder,proto3" json:"folder,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *VaultEntry) Reset() {
-	*x = VaultEntry{}
-	mi := &file_vault_proto_msgTypes[0]
+func (x *MoveFolderResponse) Reset() {
+	*x = MoveFolderResponse{}
+	mi := &file_vault_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveFolderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveFolderResponse) ProtoMessage() {}
+
+func (x *MoveFolderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vault_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveFolderResponse.ProtoReflect.Descriptor instead.
+func (*MoveFolderResponse) Descriptor() ([]byte, []int) {
+	return file_vault_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *MoveFolderResponse) GetFolder() *Folder {
+	if x != nil {
+		return x.Folder
+	}
+	return nil
+}
+
+type DeleteFolderRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// recursive also deletes subfolders, otherwise only empty folders can be deleted. The entries of deleted
+	// folders move to the parent of the deleted folder
+	Recursive     bool `protobuf:"varint,2,opt,name=recursive,proto3" json:"recursive,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteFolderRequest) Reset() {
+	*x = DeleteFolderRequest{}
+	mi := &file_vault_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteFolderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteFolderRequest) ProtoMessage() {}
+
+func (x *DeleteFolderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vault_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteFolderRequest.ProtoReflect.Descriptor instead.
+func (*DeleteFolderRequest) Descriptor() ([]byte, []int) {
+	return file_vault_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *DeleteFolderRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeleteFolderRequest) GetRecursive() bool {
+	if x != nil {
+		return x.Recursive
+	}
+	return false
+}
+
+type DeleteFolderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteFolderResponse) Reset() {
+	*x = DeleteFolderResponse{}
+	mi := &file_vault_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteFolderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteFolderResponse) ProtoMessage() {}
+
+func (x *DeleteFolderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vault_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteFolderResponse.ProtoReflect.Descriptor instead.
+func (*DeleteFolderResponse) Descriptor() ([]byte, []int) {
+	return file_vault_proto_rawDescGZIP(), []int{76}
+}
+
+type ListFoldersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFoldersRequest) Reset() {
+	*x = ListFoldersRequest{}
+	mi := &file_vault_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFoldersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFoldersRequest) ProtoMessage() {}
+
+func (x *ListFoldersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vault_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFoldersRequest.ProtoReflect.Descriptor instead.
+func (*ListFoldersRequest) Descriptor() ([]byte, []int) {
+	return file_vault_proto_rawDescGZIP(), []int{77}
+}
+
+// ListFoldersResponse lists all folders ordered by path, parents precede their subfolders
+type ListFoldersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Folders       []*Folder              `protobuf:"bytes,1,rep,name=folders,proto3" json:"folders,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFoldersResponse) Reset() {
+	*x = ListFoldersResponse{}
+	mi := &file_vault_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFoldersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFoldersResponse) ProtoMessage() {}
+
+func (x *ListFoldersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vault_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFoldersResponse.ProtoReflect.Descriptor instead.
+func (*ListFoldersResponse) Descriptor() ([]byte, []int) {
+	return file_vault_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *ListFoldersResponse) GetFolders() []*Folder {
+	if x != nil {
+		return x.Folders
+	}
+	return nil
+}
+
+// Tag is a tag of the caller's entries with the number of entries carrying it
+type Tag struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Tag) Reset() {
+	*x = Tag{}
+	mi := &file_vault_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Tag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tag) ProtoMessage() {}
+
+func (x *Tag) ProtoReflect() protoreflect.Message {
+	mi := &file_vault_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tag.ProtoReflect.Descriptor instead.
+func (*Tag) Descriptor() ([]byte, []int) {
+	return file_vault_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *Tag) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Tag) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type ListTagsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTagsRequest) Reset() {
+	*x = ListTagsRequest{}
+	mi := &file_vault_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTagsRequest) ProtoMessage() {}
+
+func (x *ListTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vault_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTagsRequest.ProtoReflect.Descriptor instead.
+func (*ListTagsRequest) Descriptor() ([]byte, []int) {
+	return file_vault_proto_rawDescGZIP(), []int{80}
+}
+
+// ListTagsResponse lists all tags ordered by name
+type ListTagsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tags          []*Tag                 `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTagsResponse) Reset() {
+	*x = ListTagsResponse{}
+	mi := &file_vault_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTagsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTagsResponse) ProtoMessage() {}
+
+func (x *ListTagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vault_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTagsResponse.ProtoReflect.Descriptor instead.
+func (*ListTagsResponse) Descriptor() ([]byte, []int) {
+	return file_vault_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *ListTagsResponse) GetTags() []*Tag {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type RenameTagRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To            string                 `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenameTagRequest) Reset() {
+	*x = RenameTagRequest{}
+	mi := &file_vault_proto_msgTypes[82]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *VaultEntry) String() string {
+func (x *RenameTagRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VaultEntry) ProtoMessage() {}
+func (*RenameTagRequest) ProtoMessage() {}
 
-func (x *VaultEntry) ProtoReflect() protoreflect.Message {
-	mi := &file_vault_proto_msgTypes[0]
+func (x *RenameTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vault_proto_msgTypes[82]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -61,90 +5434,146 @@ func (x *VaultEntry) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VaultEntry.ProtoReflect.Descriptor instead.
-func (*VaultEntry) Descriptor() ([]byte, []int) {
-	return file_vault_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use RenameTagRequest.ProtoReflect.Descriptor instead.
+func (*RenameTagRequest) Descriptor() ([]byte, []int) {
+	return file_vault_proto_rawDescGZIP(), []int{82}
 }
 
-func (x *VaultEntry) GetId() string {
+func (x *RenameTagRequest) GetFrom() string {
 	if x != nil {
-		return x.Id
+		return x.From
 	}
 	return ""
 }
 
-func (x *VaultEntry) GetTitle() string {
+func (x *RenameTagRequest) GetTo() string {
 	if x != nil {
-		return x.Title
+		return x.To
 	}
 	return ""
 }
 
-func (x *VaultEntry) GetUsername() string {
-	if x != nil {
-		return x.Username
-	}
-	return ""
+type RenameTagResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// updated_entries is the number of entries that carried the tag
+	UpdatedEntries int32 `protobuf:"varint,1,opt,name=updated_entries,json=updatedEntries,proto3" json:"updated_entries,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *VaultEntry) GetPassword() string {
+func (x *RenameTagResponse) Reset() {
+	*x = RenameTagResponse{}
+	mi := &file_vault_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenameTagResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameTagResponse) ProtoMessage() {}
+
+func (x *RenameTagResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vault_proto_msgTypes[83]
 	if x != nil {
-		return x.Password
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *VaultEntry) GetNotes() string {
+// Deprecated: Use RenameTagResponse.ProtoReflect.Descriptor instead.
+func (*RenameTagResponse) Descriptor() ([]byte, []int) {
+	return file_vault_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *RenameTagResponse) GetUpdatedEntries() int32 {
 	if x != nil {
-		return x.Notes
+		return x.UpdatedEntries
 	}
-	return ""
+	return 0
 }
 
-func (x *VaultEntry) GetTags() []string {
+type MergeTagsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// sources are replaced by target on every entry, target may already be in use
+	Sources       []string `protobuf:"bytes,1,rep,name=sources,proto3" json:"sources,omitempty"`
+	Target        string   `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeTagsRequest) Reset() {
+	*x = MergeTagsRequest{}
+	mi := &file_vault_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeTagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeTagsRequest) ProtoMessage() {}
+
+func (x *MergeTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vault_proto_msgTypes[84]
 	if x != nil {
-		return x.Tags
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *VaultEntry) GetFolder() string {
+// Deprecated: Use MergeTagsRequest.ProtoReflect.Descriptor instead.
+func (*MergeTagsRequest) Descriptor() ([]byte, []int) {
+	return file_vault_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *MergeTagsRequest) GetSources() []string {
 	if x != nil {
-		return x.Folder
+		return x.Sources
 	}
-	return ""
+	return nil
 }
 
-func (x *VaultEntry) GetDomain() string {
-	fmt.Println(x.Domain)
+func (x *MergeTagsRequest) GetTarget() string {
 	if x != nil {
-		return x.Domain
+		return x.Target
 	}
 	return ""
 }
 
-type CreateEntryRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Entry         *VaultEntry            `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type MergeTagsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// updated_entries is the number of entries that carried any of the sources
+	UpdatedEntries int32 `protobuf:"varint,1,opt,name=updated_entries,json=updatedEntries,proto3" json:"updated_entries,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *CreateEntryRequest) Reset() {
-	*x = CreateEntryRequest{}
-	mi := &file_vault_proto_msgTypes[1]
+func (x *MergeTagsResponse) Reset() {
+	*x = MergeTagsResponse{}
+	mi := &file_vault_proto_msgTypes[85]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateEntryRequest) String() string {
+func (x *MergeTagsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateEntryRequest) ProtoMessage() {}
+func (*MergeTagsResponse) ProtoMessage() {}
 
-func (x *CreateEntryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_vault_proto_msgTypes[1]
+func (x *MergeTagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vault_proto_msgTypes[85]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -155,40 +5584,43 @@ func (x *CreateEntryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateEntryRequest.ProtoReflect.Descriptor instead.
-func (*CreateEntryRequest) Descriptor() ([]byte, []int) {
-	return file_vault_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use MergeTagsResponse.ProtoReflect.Descriptor instead.
+func (*MergeTagsResponse) Descriptor() ([]byte, []int) {
+	return file_vault_proto_rawDescGZIP(), []int{85}
 }
 
-func (x *CreateEntryRequest) GetEntry() *VaultEntry {
+func (x *MergeTagsResponse) GetUpdatedEntries() int32 {
 	if x != nil {
-		return x.Entry
+		return x.UpdatedEntries
 	}
-	return nil
+	return 0
 }
 
-type CreateEntryResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+type SuggestTagsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// prefix is matched ignoring case, an empty prefix suggests the most used tags
+	Prefix string `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	// limit defaults to 10, at most 100
+	Limit         int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateEntryResponse) Reset() {
-	*x = CreateEntryResponse{}
-	mi := &file_vault_proto_msgTypes[2]
+func (x *SuggestTagsRequest) Reset() {
+	*x = SuggestTagsRequest{}
+	mi := &file_vault_proto_msgTypes[86]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateEntryResponse) String() string {
+func (x *SuggestTagsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateEntryResponse) ProtoMessage() {}
+func (*SuggestTagsRequest) ProtoMessage() {}
 
-func (x *CreateEntryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_vault_proto_msgTypes[2]
+func (x *SuggestTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vault_proto_msgTypes[86]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -199,40 +5631,48 @@ func (x *CreateEntryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateEntryResponse.ProtoReflect.Descriptor instead.
-func (*CreateEntryResponse) Descriptor() ([]byte, []int) {
-	return file_vault_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use SuggestTagsRequest.ProtoReflect.Descriptor instead.
+func (*SuggestTagsRequest) Descriptor() ([]byte, []int) {
+	return file_vault_proto_rawDescGZIP(), []int{86}
 }
 
-func (x *CreateEntryResponse) GetId() string {
+func (x *SuggestTagsRequest) GetPrefix() string {
 	if x != nil {
-		return x.Id
+		return x.Prefix
 	}
 	return ""
 }
 
-type GetEntryRequest struct {
+func (x *SuggestTagsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// SuggestTagsResponse lists the matching tags, the most used first
+type SuggestTagsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Tags          []*Tag                 `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetEntryRequest) Reset() {
-	*x = GetEntryRequest{}
-	mi := &file_vault_proto_msgTypes[3]
+func (x *SuggestTagsResponse) Reset() {
+	*x = SuggestTagsResponse{}
+	mi := &file_vault_proto_msgTypes[87]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetEntryRequest) String() string {
+func (x *SuggestTagsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetEntryRequest) ProtoMessage() {}
+func (*SuggestTagsResponse) ProtoMessage() {}
 
-func (x *GetEntryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_vault_proto_msgTypes[3]
+func (x *SuggestTagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vault_proto_msgTypes[87]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -243,40 +5683,41 @@ func (x *GetEntryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetEntryRequest.ProtoReflect.Descriptor instead.
-func (*GetEntryRequest) Descriptor() ([]byte, []int) {
-	return file_vault_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use SuggestTagsResponse.ProtoReflect.Descriptor instead.
+func (*SuggestTagsResponse) Descriptor() ([]byte, []int) {
+	return file_vault_proto_rawDescGZIP(), []int{87}
 }
 
-func (x *GetEntryRequest) GetId() string {
+func (x *SuggestTagsResponse) GetTags() []*Tag {
 	if x != nil {
-		return x.Id
+		return x.Tags
 	}
-	return ""
+	return nil
 }
 
-type GetEntryResponse struct {
+type SetFavoriteRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Entry         *VaultEntry            `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Favorite      bool                   `protobuf:"varint,2,opt,name=favorite,proto3" json:"favorite,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetEntryResponse) Reset() {
-	*x = GetEntryResponse{}
-	mi := &file_vault_proto_msgTypes[4]
+func (x *SetFavoriteRequest) Reset() {
+	*x = SetFavoriteRequest{}
+	mi := &file_vault_proto_msgTypes[88]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetEntryResponse) String() string {
+func (x *SetFavoriteRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetEntryResponse) ProtoMessage() {}
+func (*SetFavoriteRequest) ProtoMessage() {}
 
-func (x *GetEntryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_vault_proto_msgTypes[4]
+func (x *SetFavoriteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vault_proto_msgTypes[88]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -287,42 +5728,46 @@ func (x *GetEntryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetEntryResponse.ProtoReflect.Descriptor instead.
-func (*GetEntryResponse) Descriptor() ([]byte, []int) {
-	return file_vault_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use SetFavoriteRequest.ProtoReflect.Descriptor instead.
+func (*SetFavoriteRequest) Descriptor() ([]byte, []int) {
+	return file_vault_proto_rawDescGZIP(), []int{88}
 }
 
-func (x *GetEntryResponse) GetEntry() *VaultEntry {
+func (x *SetFavoriteRequest) GetId() string {
 	if x != nil {
-		return x.Entry
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-type ListEntriesRequest struct {
+func (x *SetFavoriteRequest) GetFavorite() bool {
+	if x != nil {
+		return x.Favorite
+	}
+	return false
+}
+
+type SetFavoriteResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Folder        string                 `protobuf:"bytes,1,opt,name=folder,proto3" json:"folder,omitempty"`
-	Tags          []string               `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty"`
-	Domain        string                 `protobuf:"bytes,3,opt,name=domain,proto3" json:"domain,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListEntriesRequest) Reset() {
-	*x = ListEntriesRequest{}
-	mi := &file_vault_proto_msgTypes[5]
+func (x *SetFavoriteResponse) Reset() {
+	*x = SetFavoriteResponse{}
+	mi := &file_vault_proto_msgTypes[89]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListEntriesRequest) String() string {
+func (x *SetFavoriteResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListEntriesRequest) ProtoMessage() {}
+func (*SetFavoriteResponse) ProtoMessage() {}
 
-func (x *ListEntriesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_vault_proto_msgTypes[5]
+func (x *SetFavoriteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vault_proto_msgTypes[89]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -333,54 +5778,79 @@ func (x *ListEntriesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListEntriesRequest.ProtoReflect.Descriptor instead.
-func (*ListEntriesRequest) Descriptor() ([]byte, []int) {
-	return file_vault_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use SetFavoriteResponse.ProtoReflect.Descriptor instead.
+func (*SetFavoriteResponse) Descriptor() ([]byte, []int) {
+	return file_vault_proto_rawDescGZIP(), []int{89}
 }
 
-func (x *ListEntriesRequest) GetFolder() string {
-	if x != nil {
-		return x.Folder
-	}
-	return ""
+type ListRecentRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// limit defaults to 20, at most 100
+	Limit         int32 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListEntriesRequest) GetTags() []string {
+func (x *ListRecentRequest) Reset() {
+	*x = ListRecentRequest{}
+	mi := &file_vault_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRecentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRecentRequest) ProtoMessage() {}
+
+func (x *ListRecentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vault_proto_msgTypes[90]
 	if x != nil {
-		return x.Tags
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *ListEntriesRequest) GetDomain() string {
+// Deprecated: Use ListRecentRequest.ProtoReflect.Descriptor instead.
+func (*ListRecentRequest) Descriptor() ([]byte, []int) {
+	return file_vault_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *ListRecentRequest) GetLimit() int32 {
 	if x != nil {
-		return x.Domain
+		return x.Limit
 	}
-	return ""
+	return 0
 }
 
-type ListEntriesResponse struct {
+// ListRecentResponse lists the caller's used entries, the most recently used first
+type ListRecentResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Entries       []*VaultEntry          `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListEntriesResponse) Reset() {
-	*x = ListEntriesResponse{}
-	mi := &file_vault_proto_msgTypes[6]
+func (x *ListRecentResponse) Reset() {
+	*x = ListRecentResponse{}
+	mi := &file_vault_proto_msgTypes[91]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListEntriesResponse) String() string {
+func (x *ListRecentResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListEntriesResponse) ProtoMessage() {}
+func (*ListRecentResponse) ProtoMessage() {}
 
-func (x *ListEntriesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_vault_proto_msgTypes[6]
+func (x *ListRecentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vault_proto_msgTypes[91]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -391,40 +5861,41 @@ func (x *ListEntriesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListEntriesResponse.ProtoReflect.Descriptor instead.
-func (*ListEntriesResponse) Descriptor() ([]byte, []int) {
-	return file_vault_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use ListRecentResponse.ProtoReflect.Descriptor instead.
+func (*ListRecentResponse) Descriptor() ([]byte, []int) {
+	return file_vault_proto_rawDescGZIP(), []int{91}
 }
 
-func (x *ListEntriesResponse) GetEntries() []*VaultEntry {
+func (x *ListRecentResponse) GetEntries() []*VaultEntry {
 	if x != nil {
 		return x.Entries
 	}
 	return nil
 }
 
-type DeleteEntryRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+type FindEntriesForURLRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// url is the page to fill in, a missing scheme defaults to https
+	Url           string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteEntryRequest) Reset() {
-	*x = DeleteEntryRequest{}
-	mi := &file_vault_proto_msgTypes[7]
+func (x *FindEntriesForURLRequest) Reset() {
+	*x = FindEntriesForURLRequest{}
+	mi := &file_vault_proto_msgTypes[92]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteEntryRequest) String() string {
+func (x *FindEntriesForURLRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteEntryRequest) ProtoMessage() {}
+func (*FindEntriesForURLRequest) ProtoMessage() {}
 
-func (x *DeleteEntryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_vault_proto_msgTypes[7]
+func (x *FindEntriesForURLRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vault_proto_msgTypes[92]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -435,40 +5906,41 @@ func (x *DeleteEntryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteEntryRequest.ProtoReflect.Descriptor instead.
-func (*DeleteEntryRequest) Descriptor() ([]byte, []int) {
-	return file_vault_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use FindEntriesForURLRequest.ProtoReflect.Descriptor instead.
+func (*FindEntriesForURLRequest) Descriptor() ([]byte, []int) {
+	return file_vault_proto_rawDescGZIP(), []int{92}
 }
 
-func (x *DeleteEntryRequest) GetId() string {
+func (x *FindEntriesForURLRequest) GetUrl() string {
 	if x != nil {
-		return x.Id
+		return x.Url
 	}
 	return ""
 }
 
-type DeleteEntryResponse struct {
+// FindEntriesForURLResponse lists the caller's entries with a URI matching the url, ordered by title
+type FindEntriesForURLResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Entries       []*VaultEntry          `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteEntryResponse) Reset() {
-	*x = DeleteEntryResponse{}
-	mi := &file_vault_proto_msgTypes[8]
+func (x *FindEntriesForURLResponse) Reset() {
+	*x = FindEntriesForURLResponse{}
+	mi := &file_vault_proto_msgTypes[93]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteEntryResponse) String() string {
+func (x *FindEntriesForURLResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteEntryResponse) ProtoMessage() {}
+func (*FindEntriesForURLResponse) ProtoMessage() {}
 
-func (x *DeleteEntryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_vault_proto_msgTypes[8]
+func (x *FindEntriesForURLResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vault_proto_msgTypes[93]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -479,23 +5951,70 @@ func (x *DeleteEntryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteEntryResponse.ProtoReflect.Descriptor instead.
-func (*DeleteEntryResponse) Descriptor() ([]byte, []int) {
-	return file_vault_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use FindEntriesForURLResponse.ProtoReflect.Descriptor instead.
+func (*FindEntriesForURLResponse) Descriptor() ([]byte, []int) {
+	return file_vault_proto_rawDescGZIP(), []int{93}
 }
 
-func (x *DeleteEntryResponse) GetSuccess() bool {
+func (x *FindEntriesForURLResponse) GetEntries() []*VaultEntry {
 	if x != nil {
-		return x.Success
+		return x.Entries
 	}
-	return false
+	return nil
 }
 
 var File_vault_proto protoreflect.FileDescriptor
 
 const file_vault_proto_rawDesc = "" +
 	"\n" +
-	"\vvault.proto\x12\x05vault\"\xc4\x01\n" +
+	"\vvault.proto\x12\x05vault\"\xc6\x01\n" +
+	"\x04Card\x12'\n" +
+	"\x0fcardholder_name\x18\x01 \x01(\tR\x0ecardholderName\x12\x14\n" +
+	"\x05brand\x18\x02 \x01(\tR\x05brand\x12\x16\n" +
+	"\x06number\x18\x03 \x01(\tR\x06number\x12\x1b\n" +
+	"\texp_month\x18\x04 \x01(\x05R\bexpMonth\x12\x19\n" +
+	"\bexp_year\x18\x05 \x01(\x05R\aexpYear\x12\x12\n" +
+	"\x04code\x18\x06 \x01(\tR\x04code\x12\x1b\n" +
+	"\tlast_four\x18\a \x01(\tR\blastFour\"\xbe\x03\n" +
+	"\bIdentity\x12\x1d\n" +
+	"\n" +
+	"first_name\x18\x01 \x01(\tR\tfirstName\x12\x1f\n" +
+	"\vmiddle_name\x18\x02 \x01(\tR\n" +
+	"middleName\x12\x1b\n" +
+	"\tlast_name\x18\x03 \x01(\tR\blastName\x12\x14\n" +
+	"\x05email\x18\x04 \x01(\tR\x05email\x12\x14\n" +
+	"\x05phone\x18\x05 \x01(\tR\x05phone\x12\x18\n" +
+	"\acompany\x18\x06 \x01(\tR\acompany\x12#\n" +
+	"\raddress_line1\x18\a \x01(\tR\faddressLine1\x12#\n" +
+	"\raddress_line2\x18\b \x01(\tR\faddressLine2\x12\x12\n" +
+	"\x04city\x18\t \x01(\tR\x04city\x12\x14\n" +
+	"\x05state\x18\n" +
+	" \x01(\tR\x05state\x12\x1f\n" +
+	"\vpostal_code\x18\v \x01(\tR\n" +
+	"postalCode\x12\x18\n" +
+	"\acountry\x18\f \x01(\tR\acountry\x12\x10\n" +
+	"\x03ssn\x18\r \x01(\tR\x03ssn\x12'\n" +
+	"\x0fpassport_number\x18\x0e \x01(\tR\x0epassportNumber\x12%\n" +
+	"\x0elicense_number\x18\x0f \x01(\tR\rlicenseNumber\"j\n" +
+	"\x06SshKey\x12\x1f\n" +
+	"\vprivate_key\x18\x01 \x01(\tR\n" +
+	"privateKey\x12\x1d\n" +
+	"\n" +
+	"public_key\x18\x02 \x01(\tR\tpublicKey\x12 \n" +
+	"\vfingerprint\x18\x03 \x01(\tR\vfingerprint\"r\n" +
+	"\x06ApiKey\x12\x15\n" +
+	"\x06key_id\x18\x01 \x01(\tR\x05keyId\x12\x16\n" +
+	"\x06secret\x18\x02 \x01(\tR\x06secret\x12\x1a\n" +
+	"\bendpoint\x18\x03 \x01(\tR\bendpoint\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x04 \x01(\x03R\texpiresAt\"C\n" +
+	"\bEntryUri\x12\x10\n" +
+	"\x03uri\x18\x01 \x01(\tR\x03uri\x12%\n" +
+	"\x05match\x18\x02 \x01(\x0e2\x0f.vault.UriMatchR\x05match\"c\n" +
+	"\vCustomField\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12*\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x16.vault.CustomFieldTypeR\x04type\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\tR\x05value\"\xda\x06\n" +
 	"\n" +
 	"VaultEntry\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
@@ -505,30 +6024,417 @@ const file_vault_proto_rawDesc = "" +
 	"\x05notes\x18\x05 \x01(\tR\x05notes\x12\x12\n" +
 	"\x04tags\x18\x06 \x03(\tR\x04tags\x12\x16\n" +
 	"\x06folder\x18\a \x01(\tR\x06folder\x12\x16\n" +
-	"\x06domain\x18\b \x01(\tR\x06domain\"=\n" +
+	"\x06domain\x18\b \x01(\tR\x06domain\x12)\n" +
+	"\x10client_encrypted\x18\t \x01(\bR\x0fclientEncrypted\x12\x16\n" +
+	"\x06reused\x18\n" +
+	" \x01(\bR\x06reused\x129\n" +
+	"\x16rotation_interval_days\x18\v \x01(\x05H\x01R\x14rotationIntervalDays\x88\x01\x01\x12.\n" +
+	"\x13password_changed_at\x18\f \x01(\x03R\x11passwordChangedAt\x12\x17\n" +
+	"\x04totp\x18\r \x01(\tH\x02R\x04totp\x88\x01\x01\x12\x19\n" +
+	"\bhas_totp\x18\x0e \x01(\bR\ahasTotp\x12$\n" +
+	"\x04type\x18\x0f \x01(\x0e2\x10.vault.EntryTypeR\x04type\x12!\n" +
+	"\x04card\x18\x10 \x01(\v2\v.vault.CardH\x00R\x04card\x12-\n" +
+	"\bidentity\x18\x11 \x01(\v2\x0f.vault.IdentityH\x00R\bidentity\x12(\n" +
+	"\assh_key\x18\x12 \x01(\v2\r.vault.SshKeyH\x00R\x06sshKey\x12(\n" +
+	"\aapi_key\x18\x13 \x01(\v2\r.vault.ApiKeyH\x00R\x06apiKey\x127\n" +
+	"\rcustom_fields\x18\x14 \x03(\v2\x12.vault.CustomFieldR\fcustomFields\x12\x1b\n" +
+	"\tfolder_id\x18\x15 \x01(\tR\bfolderId\x12\x1a\n" +
+	"\bfavorite\x18\x16 \x01(\bR\bfavorite\x12 \n" +
+	"\flast_used_at\x18\x17 \x01(\x03R\n" +
+	"lastUsedAt\x12#\n" +
+	"\x04uris\x18\x18 \x03(\v2\x0f.vault.EntryUriR\x04urisB\t\n" +
+	"\apayloadB\x19\n" +
+	"\x17_rotation_interval_daysB\a\n" +
+	"\x05_totp\"\x7f\n" +
 	"\x12CreateEntryRequest\x12'\n" +
-	"\x05entry\x18\x01 \x01(\v2\x11.vault.VaultEntryR\x05entry\"%\n" +
+	"\x05entry\x18\x01 \x01(\v2\x11.vault.VaultEntryR\x05entry\x12\x19\n" +
+	"\bowner_id\x18\x02 \x01(\tR\aownerId\x12%\n" +
+	"\x0eenforce_policy\x18\x03 \x01(\bR\renforcePolicy\"\x86\x01\n" +
 	"\x13CreateEntryResponse\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"!\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x123\n" +
+	"\bstrength\x18\x02 \x01(\v2\x17.vault.PasswordStrengthR\bstrength\x12*\n" +
+	"\x06breach\x18\x03 \x01(\v2\x12.vault.BreachCheckR\x06breach\"?\n" +
+	"\vBreachCheck\x12\x1a\n" +
+	"\bbreached\x18\x01 \x01(\bR\bbreached\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x03R\x05count\"g\n" +
+	"\x10PasswordStrength\x12\x14\n" +
+	"\x05score\x18\x01 \x01(\x05R\x05score\x12#\n" +
+	"\rguesses_log10\x18\x02 \x01(\x01R\fguessesLog10\x12\x18\n" +
+	"\awarning\x18\x03 \x01(\tR\awarning\"\x7f\n" +
+	"\x12UpdateEntryRequest\x12'\n" +
+	"\x05entry\x18\x01 \x01(\v2\x11.vault.VaultEntryR\x05entry\x12\x19\n" +
+	"\bowner_id\x18\x02 \x01(\tR\aownerId\x12%\n" +
+	"\x0eenforce_policy\x18\x03 \x01(\bR\renforcePolicy\"v\n" +
+	"\x13UpdateEntryResponse\x123\n" +
+	"\bstrength\x18\x01 \x01(\v2\x17.vault.PasswordStrengthR\bstrength\x12*\n" +
+	"\x06breach\x18\x02 \x01(\v2\x12.vault.BreachCheckR\x06breach\"<\n" +
 	"\x0fGetEntryRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\";\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bowner_id\x18\x02 \x01(\tR\aownerId\";\n" +
 	"\x10GetEntryResponse\x12'\n" +
-	"\x05entry\x18\x01 \x01(\v2\x11.vault.VaultEntryR\x05entry\"X\n" +
+	"\x05entry\x18\x01 \x01(\v2\x11.vault.VaultEntryR\x05entry\"\xc6\x04\n" +
 	"\x12ListEntriesRequest\x12\x16\n" +
 	"\x06folder\x18\x01 \x01(\tR\x06folder\x12\x12\n" +
 	"\x04tags\x18\x02 \x03(\tR\x04tags\x12\x16\n" +
-	"\x06domain\x18\x03 \x01(\tR\x06domain\"B\n" +
+	"\x06domain\x18\x03 \x01(\tR\x06domain\x12\x19\n" +
+	"\bowner_id\x18\x04 \x01(\tR\aownerId\x12&\n" +
+	"\x05types\x18\x05 \x03(\x0e2\x10.vault.EntryTypeR\x05types\x12\x1f\n" +
+	"\vfield_names\x18\x06 \x03(\tR\n" +
+	"fieldNames\x12\x1b\n" +
+	"\tpage_size\x18\a \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\b \x01(\tR\tpageToken\x12\x19\n" +
+	"\border_by\x18\t \x01(\tR\aorderBy\x12(\n" +
+	"\btag_sets\x18\n" +
+	" \x03(\v2\r.vault.TagSetR\atagSets\x12#\n" +
+	"\rfolder_prefix\x18\v \x01(\tR\ffolderPrefix\x12#\n" +
+	"\rcreated_after\x18\f \x01(\x03R\fcreatedAfter\x12%\n" +
+	"\x0ecreated_before\x18\r \x01(\x03R\rcreatedBefore\x12#\n" +
+	"\rupdated_after\x18\x0e \x01(\x03R\fupdatedAfter\x12%\n" +
+	"\x0eupdated_before\x18\x0f \x01(\x03R\rupdatedBefore\x12\x1b\n" +
+	"\tfolder_id\x18\x10 \x01(\tR\bfolderId\x12-\n" +
+	"\x12include_subfolders\x18\x11 \x01(\bR\x11includeSubfolders\"\x1c\n" +
+	"\x06TagSet\x12\x12\n" +
+	"\x04tags\x18\x01 \x03(\tR\x04tags\"\x89\x01\n" +
 	"\x13ListEntriesResponse\x12+\n" +
-	"\aentries\x18\x01 \x03(\v2\x11.vault.VaultEntryR\aentries\"$\n" +
+	"\aentries\x18\x01 \x03(\v2\x11.vault.VaultEntryR\aentries\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1d\n" +
+	"\n" +
+	"total_size\x18\x03 \x01(\x05R\ttotalSize\"?\n" +
 	"\x12DeleteEntryRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"/\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bowner_id\x18\x02 \x01(\tR\aownerId\"/\n" +
 	"\x13DeleteEntryResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess2\x9d\x02\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x98\x01\n" +
+	"\x15RevealPasswordRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12(\n" +
+	"\x05field\x18\x02 \x01(\x0e2\x12.vault.SecretFieldR\x05field\x12\x19\n" +
+	"\bowner_id\x18\x03 \x01(\tR\aownerId\x12*\n" +
+	"\x11custom_field_name\x18\x04 \x01(\tR\x0fcustomFieldName\".\n" +
+	"\x16RevealPasswordResponse\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\"\xd1\x01\n" +
+	"\n" +
+	"AuditEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bactor_id\x18\x02 \x01(\tR\aactorId\x12\x19\n" +
+	"\bowner_id\x18\x03 \x01(\tR\aownerId\x12\x16\n" +
+	"\x06action\x18\x04 \x01(\tR\x06action\x12\x19\n" +
+	"\bentry_id\x18\x05 \x01(\tR\aentryId\x12\x12\n" +
+	"\x04peer\x18\x06 \x01(\tR\x04peer\x12\x18\n" +
+	"\aoutcome\x18\a \x01(\tR\aoutcome\x12\x1c\n" +
+	"\ttimestamp\x18\b \x01(\x03R\ttimestamp\"\xc3\x01\n" +
+	"\x14QueryAuditLogRequest\x12\x19\n" +
+	"\bactor_id\x18\x01 \x01(\tR\aactorId\x12\x19\n" +
+	"\bentry_id\x18\x02 \x01(\tR\aentryId\x12\x16\n" +
+	"\x06action\x18\x03 \x01(\tR\x06action\x12\x14\n" +
+	"\x05since\x18\x04 \x01(\x03R\x05since\x12\x14\n" +
+	"\x05until\x18\x05 \x01(\x03R\x05until\x12\x14\n" +
+	"\x05limit\x18\x06 \x01(\x05R\x05limit\x12\x1b\n" +
+	"\tall_users\x18\a \x01(\bR\ballUsers\"B\n" +
+	"\x15QueryAuditLogResponse\x12)\n" +
+	"\x06events\x18\x01 \x03(\v2\x11.vault.AuditEventR\x06events\"\xf6\x01\n" +
+	"\x0ePasswordPolicy\x12\x1d\n" +
+	"\n" +
+	"min_length\x18\x01 \x01(\x05R\tminLength\x12+\n" +
+	"\x11require_lowercase\x18\x02 \x01(\bR\x10requireLowercase\x12+\n" +
+	"\x11require_uppercase\x18\x03 \x01(\bR\x10requireUppercase\x12%\n" +
+	"\x0erequire_digits\x18\x04 \x01(\bR\rrequireDigits\x12'\n" +
+	"\x0frequire_symbols\x18\x05 \x01(\bR\x0erequireSymbols\x12\x1b\n" +
+	"\tmin_words\x18\x06 \x01(\x05R\bminWords\"\xfa\x02\n" +
+	"\x17GeneratePasswordRequest\x12\x16\n" +
+	"\x06length\x18\x01 \x01(\x05R\x06length\x12\x1c\n" +
+	"\tlowercase\x18\x02 \x01(\bR\tlowercase\x12\x1c\n" +
+	"\tuppercase\x18\x03 \x01(\bR\tuppercase\x12\x16\n" +
+	"\x06digits\x18\x04 \x01(\bR\x06digits\x12\x18\n" +
+	"\asymbols\x18\x05 \x01(\bR\asymbols\x12+\n" +
+	"\x11exclude_ambiguous\x18\x06 \x01(\bR\x10excludeAmbiguous\x12\x1e\n" +
+	"\n" +
+	"passphrase\x18\a \x01(\bR\n" +
+	"passphrase\x12\x14\n" +
+	"\x05words\x18\b \x01(\x05R\x05words\x12!\n" +
+	"\tseparator\x18\t \x01(\tH\x00R\tseparator\x88\x01\x01\x12\x1e\n" +
+	"\n" +
+	"capitalize\x18\n" +
+	" \x01(\bR\n" +
+	"capitalize\x12%\n" +
+	"\x0einclude_number\x18\v \x01(\bR\rincludeNumberB\f\n" +
+	"\n" +
+	"_separator\"Y\n" +
+	"\x18GeneratePasswordResponse\x12\x1a\n" +
+	"\bpassword\x18\x01 \x01(\tR\bpassword\x12!\n" +
+	"\fentropy_bits\x18\x02 \x01(\x01R\ventropyBits\">\n" +
+	"\x18GetPasswordPolicyRequest\x12\"\n" +
+	"\forganization\x18\x01 \x01(\bR\forganization\"\x7f\n" +
+	"\x19GetPasswordPolicyResponse\x12-\n" +
+	"\x06policy\x18\x01 \x01(\v2\x15.vault.PasswordPolicyR\x06policy\x123\n" +
+	"\teffective\x18\x02 \x01(\v2\x15.vault.PasswordPolicyR\teffective\"m\n" +
+	"\x18SetPasswordPolicyRequest\x12-\n" +
+	"\x06policy\x18\x01 \x01(\v2\x15.vault.PasswordPolicyR\x06policy\x12\"\n" +
+	"\forganization\x18\x02 \x01(\bR\forganization\"J\n" +
+	"\x19SetPasswordPolicyResponse\x12-\n" +
+	"\x06policy\x18\x01 \x01(\v2\x15.vault.PasswordPolicyR\x06policy\"\\\n" +
+	"\x1bGetVaultHealthReportRequest\x12\x1b\n" +
+	"\tmin_score\x18\x01 \x01(\x05R\bminScore\x12 \n" +
+	"\fmax_age_days\x18\x02 \x01(\x05R\n" +
+	"maxAgeDays\"d\n" +
+	"\bEntryRef\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x1a\n" +
+	"\busername\x18\x03 \x01(\tR\busername\x12\x16\n" +
+	"\x06domain\x18\x04 \x01(\tR\x06domain\"j\n" +
+	"\fWeakPassword\x12%\n" +
+	"\x05entry\x18\x01 \x01(\v2\x0f.vault.EntryRefR\x05entry\x123\n" +
+	"\bstrength\x18\x02 \x01(\v2\x17.vault.PasswordStrengthR\bstrength\";\n" +
+	"\x0eReusedPassword\x12)\n" +
+	"\aentries\x18\x01 \x03(\v2\x0f.vault.EntryRefR\aentries\"S\n" +
+	"\vOldPassword\x12%\n" +
+	"\x05entry\x18\x01 \x01(\v2\x0f.vault.EntryRefR\x05entry\x12\x1d\n" +
+	"\n" +
+	"changed_at\x18\x02 \x01(\x03R\tchangedAt\"\xd1\x02\n" +
+	"\x1cGetVaultHealthReportResponse\x12'\n" +
+	"\x04weak\x18\x01 \x03(\v2\x13.vault.WeakPasswordR\x04weak\x12-\n" +
+	"\x06reused\x18\x02 \x03(\v2\x15.vault.ReusedPasswordR\x06reused\x12$\n" +
+	"\x03old\x18\x03 \x03(\v2\x12.vault.OldPasswordR\x03old\x12#\n" +
+	"\rtotal_entries\x18\x04 \x01(\x05R\ftotalEntries\x12'\n" +
+	"\x0fskipped_entries\x18\x05 \x01(\x05R\x0eskippedEntries\x123\n" +
+	"\bbreached\x18\x06 \x03(\v2\x17.vault.BreachedPasswordR\bbreached\x120\n" +
+	"\x14breach_check_enabled\x18\a \x01(\bR\x12breachCheckEnabled\"O\n" +
+	"\x10BreachedPassword\x12%\n" +
+	"\x05entry\x18\x01 \x01(\v2\x0f.vault.EntryRefR\x05entry\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x03R\x05count\"\x1c\n" +
+	"\x1aFindReusedPasswordsRequest\"L\n" +
+	"\x1bFindReusedPasswordsResponse\x12-\n" +
+	"\x06groups\x18\x01 \x03(\v2\x15.vault.ReusedPasswordR\x06groups\"C\n" +
+	" ListEntriesDueForRotationRequest\x12\x1f\n" +
+	"\vwithin_days\x18\x01 \x01(\x05R\n" +
+	"withinDays\"J\n" +
+	"\bDueEntry\x12'\n" +
+	"\x05entry\x18\x01 \x01(\v2\x11.vault.VaultEntryR\x05entry\x12\x15\n" +
+	"\x06due_at\x18\x02 \x01(\x03R\x05dueAt\"N\n" +
+	"!ListEntriesDueForRotationResponse\x12)\n" +
+	"\aentries\x18\x01 \x03(\v2\x0f.vault.DueEntryR\aentries\"{\n" +
+	"\x0eFolderRotation\x12\x16\n" +
+	"\x06folder\x18\x01 \x01(\tR\x06folder\x124\n" +
+	"\x16rotation_interval_days\x18\x02 \x01(\x05R\x14rotationIntervalDays\x12\x1b\n" +
+	"\tfolder_id\x18\x03 \x01(\tR\bfolderId\"\x85\x01\n" +
+	"\x18SetFolderRotationRequest\x12\x16\n" +
+	"\x06folder\x18\x01 \x01(\tR\x06folder\x124\n" +
+	"\x16rotation_interval_days\x18\x02 \x01(\x05R\x14rotationIntervalDays\x12\x1b\n" +
+	"\tfolder_id\x18\x03 \x01(\tR\bfolderId\"\x1b\n" +
+	"\x19SetFolderRotationResponse\"\x1c\n" +
+	"\x1aListFolderRotationsRequest\"R\n" +
+	"\x1bListFolderRotationsResponse\x123\n" +
+	"\trotations\x18\x01 \x03(\v2\x15.vault.FolderRotationR\trotations\"M\n" +
+	"\x14CheckBreachedRequest\x12\x1a\n" +
+	"\bpassword\x18\x01 \x01(\tR\bpassword\x12\x19\n" +
+	"\bentry_id\x18\x02 \x01(\tR\aentryId\"C\n" +
+	"\x15CheckBreachedResponse\x12*\n" +
+	"\x06result\x18\x01 \x01(\v2\x12.vault.BreachCheckR\x06result\"\xaa\x01\n" +
+	"\n" +
+	"Attachment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bentry_id\x18\x02 \x01(\tR\aentryId\x12\x1b\n" +
+	"\tfile_name\x18\x03 \x01(\tR\bfileName\x12!\n" +
+	"\fcontent_type\x18\x04 \x01(\tR\vcontentType\x12\x12\n" +
+	"\x04size\x18\x05 \x01(\x03R\x04size\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\x03R\tcreatedAt\"\xa2\x01\n" +
+	"\x16UploadAttachmentHeader\x12\x19\n" +
+	"\bentry_id\x18\x01 \x01(\tR\aentryId\x12\x1b\n" +
+	"\tfile_name\x18\x02 \x01(\tR\bfileName\x12!\n" +
+	"\fcontent_type\x18\x03 \x01(\tR\vcontentType\x12\x12\n" +
+	"\x04size\x18\x04 \x01(\x03R\x04size\x12\x19\n" +
+	"\bowner_id\x18\x05 \x01(\tR\aownerId\"r\n" +
+	"\x17UploadAttachmentRequest\x127\n" +
+	"\x06header\x18\x01 \x01(\v2\x1d.vault.UploadAttachmentHeaderH\x00R\x06header\x12\x16\n" +
+	"\x05chunk\x18\x02 \x01(\fH\x00R\x05chunkB\x06\n" +
+	"\x04data\"M\n" +
+	"\x18UploadAttachmentResponse\x121\n" +
+	"\n" +
+	"attachment\x18\x01 \x01(\v2\x11.vault.AttachmentR\n" +
+	"attachment\"F\n" +
+	"\x19DownloadAttachmentRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bowner_id\x18\x02 \x01(\tR\aownerId\"q\n" +
+	"\x1aDownloadAttachmentResponse\x123\n" +
+	"\n" +
+	"attachment\x18\x01 \x01(\v2\x11.vault.AttachmentH\x00R\n" +
+	"attachment\x12\x16\n" +
+	"\x05chunk\x18\x02 \x01(\fH\x00R\x05chunkB\x06\n" +
+	"\x04data\"N\n" +
+	"\x16ListAttachmentsRequest\x12\x19\n" +
+	"\bentry_id\x18\x01 \x01(\tR\aentryId\x12\x19\n" +
+	"\bowner_id\x18\x02 \x01(\tR\aownerId\"\x8e\x01\n" +
+	"\x17ListAttachmentsResponse\x123\n" +
+	"\vattachments\x18\x01 \x03(\v2\x11.vault.AttachmentR\vattachments\x12\x1d\n" +
+	"\n" +
+	"used_bytes\x18\x02 \x01(\x03R\tusedBytes\x12\x1f\n" +
+	"\vquota_bytes\x18\x03 \x01(\x03R\n" +
+	"quotaBytes\"D\n" +
+	"\x17DeleteAttachmentRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bowner_id\x18\x02 \x01(\tR\aownerId\"\x1a\n" +
+	"\x18DeleteAttachmentResponse\"?\n" +
+	"\x12GetTOTPCodeRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bowner_id\x18\x02 \x01(\tR\aownerId\"n\n" +
+	"\x13GetTOTPCodeResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12+\n" +
+	"\x11remaining_seconds\x18\x02 \x01(\x05R\x10remainingSeconds\x12\x16\n" +
+	"\x06period\x18\x03 \x01(\x05R\x06period\"x\n" +
+	"\x14StreamEntriesRequest\x12\x19\n" +
+	"\bowner_id\x18\x01 \x01(\tR\aownerId\x12&\n" +
+	"\x05types\x18\x02 \x03(\x0e2\x10.vault.EntryTypeR\x05types\x12\x1d\n" +
+	"\n" +
+	"batch_size\x18\x03 \x01(\x05R\tbatchSize\"D\n" +
+	"\x15StreamEntriesResponse\x12+\n" +
+	"\aentries\x18\x01 \x03(\v2\x11.vault.VaultEntryR\aentries\"]\n" +
+	"\x14SearchEntriesRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x19\n" +
+	"\bowner_id\x18\x02 \x01(\tR\aownerId\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"K\n" +
+	"\fSearchResult\x12'\n" +
+	"\x05entry\x18\x01 \x01(\v2\x11.vault.VaultEntryR\x05entry\x12\x12\n" +
+	"\x04rank\x18\x02 \x01(\x02R\x04rank\"F\n" +
+	"\x15SearchEntriesResponse\x12-\n" +
+	"\aresults\x18\x01 \x03(\v2\x13.vault.SearchResultR\aresults\"\x92\x02\n" +
+	"\x06Folder\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\tparent_id\x18\x02 \x01(\tR\bparentId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x12\n" +
+	"\x04path\x18\x04 \x01(\tR\x04path\x129\n" +
+	"\x16rotation_interval_days\x18\x05 \x01(\x05H\x00R\x14rotationIntervalDays\x88\x01\x01\x12\x1f\n" +
+	"\ventry_count\x18\x06 \x01(\x05R\n" +
+	"entryCount\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\a \x01(\x03R\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\x03R\tupdatedAtB\x19\n" +
+	"\x17_rotation_interval_days\"F\n" +
+	"\x13CreateFolderRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1b\n" +
+	"\tparent_id\x18\x02 \x01(\tR\bparentId\"=\n" +
+	"\x14CreateFolderResponse\x12%\n" +
+	"\x06folder\x18\x01 \x01(\v2\r.vault.FolderR\x06folder\"9\n" +
+	"\x13RenameFolderRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"=\n" +
+	"\x14RenameFolderResponse\x12%\n" +
+	"\x06folder\x18\x01 \x01(\v2\r.vault.FolderR\x06folder\"@\n" +
+	"\x11MoveFolderRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\tparent_id\x18\x02 \x01(\tR\bparentId\";\n" +
+	"\x12MoveFolderResponse\x12%\n" +
+	"\x06folder\x18\x01 \x01(\v2\r.vault.FolderR\x06folder\"C\n" +
+	"\x13DeleteFolderRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1c\n" +
+	"\trecursive\x18\x02 \x01(\bR\trecursive\"\x16\n" +
+	"\x14DeleteFolderResponse\"\x14\n" +
+	"\x12ListFoldersRequest\">\n" +
+	"\x13ListFoldersResponse\x12'\n" +
+	"\afolders\x18\x01 \x03(\v2\r.vault.FolderR\afolders\"/\n" +
+	"\x03Tag\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\"\x11\n" +
+	"\x0fListTagsRequest\"2\n" +
+	"\x10ListTagsResponse\x12\x1e\n" +
+	"\x04tags\x18\x01 \x03(\v2\n" +
+	".vault.TagR\x04tags\"6\n" +
+	"\x10RenameTagRequest\x12\x12\n" +
+	"\x04from\x18\x01 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x02 \x01(\tR\x02to\"<\n" +
+	"\x11RenameTagResponse\x12'\n" +
+	"\x0fupdated_entries\x18\x01 \x01(\x05R\x0eupdatedEntries\"D\n" +
+	"\x10MergeTagsRequest\x12\x18\n" +
+	"\asources\x18\x01 \x03(\tR\asources\x12\x16\n" +
+	"\x06target\x18\x02 \x01(\tR\x06target\"<\n" +
+	"\x11MergeTagsResponse\x12'\n" +
+	"\x0fupdated_entries\x18\x01 \x01(\x05R\x0eupdatedEntries\"B\n" +
+	"\x12SuggestTagsRequest\x12\x16\n" +
+	"\x06prefix\x18\x01 \x01(\tR\x06prefix\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"5\n" +
+	"\x13SuggestTagsResponse\x12\x1e\n" +
+	"\x04tags\x18\x01 \x03(\v2\n" +
+	".vault.TagR\x04tags\"@\n" +
+	"\x12SetFavoriteRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
+	"\bfavorite\x18\x02 \x01(\bR\bfavorite\"\x15\n" +
+	"\x13SetFavoriteResponse\")\n" +
+	"\x11ListRecentRequest\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x05R\x05limit\"A\n" +
+	"\x12ListRecentResponse\x12+\n" +
+	"\aentries\x18\x01 \x03(\v2\x11.vault.VaultEntryR\aentries\",\n" +
+	"\x18FindEntriesForURLRequest\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\"H\n" +
+	"\x19FindEntriesForURLResponse\x12+\n" +
+	"\aentries\x18\x01 \x03(\v2\x11.vault.VaultEntryR\aentries*\x9b\x01\n" +
+	"\tEntryType\x12\x14\n" +
+	"\x10ENTRY_TYPE_LOGIN\x10\x00\x12\x1a\n" +
+	"\x16ENTRY_TYPE_SECURE_NOTE\x10\x01\x12\x13\n" +
+	"\x0fENTRY_TYPE_CARD\x10\x02\x12\x17\n" +
+	"\x13ENTRY_TYPE_IDENTITY\x10\x03\x12\x16\n" +
+	"\x12ENTRY_TYPE_SSH_KEY\x10\x04\x12\x16\n" +
+	"\x12ENTRY_TYPE_API_KEY\x10\x05*\x88\x01\n" +
+	"\x0fCustomFieldType\x12\x1a\n" +
+	"\x16CUSTOM_FIELD_TYPE_TEXT\x10\x00\x12\x1c\n" +
+	"\x18CUSTOM_FIELD_TYPE_HIDDEN\x10\x01\x12\x1d\n" +
+	"\x19CUSTOM_FIELD_TYPE_BOOLEAN\x10\x02\x12\x1c\n" +
+	"\x18CUSTOM_FIELD_TYPE_LINKED\x10\x03*~\n" +
+	"\bUriMatch\x12\x19\n" +
+	"\x15URI_MATCH_BASE_DOMAIN\x10\x00\x12\x12\n" +
+	"\x0eURI_MATCH_HOST\x10\x01\x12\x19\n" +
+	"\x15URI_MATCH_STARTS_WITH\x10\x02\x12\x13\n" +
+	"\x0fURI_MATCH_REGEX\x10\x03\x12\x13\n" +
+	"\x0fURI_MATCH_NEVER\x10\x04*\xcf\x02\n" +
+	"\vSecretField\x12\x19\n" +
+	"\x15SECRET_FIELD_PASSWORD\x10\x00\x12\x15\n" +
+	"\x11SECRET_FIELD_TOTP\x10\x01\x12\x1c\n" +
+	"\x18SECRET_FIELD_CARD_NUMBER\x10\x02\x12\x1a\n" +
+	"\x16SECRET_FIELD_CARD_CODE\x10\x03\x12\x1d\n" +
+	"\x19SECRET_FIELD_IDENTITY_SSN\x10\x04\x12)\n" +
+	"%SECRET_FIELD_IDENTITY_PASSPORT_NUMBER\x10\x05\x12(\n" +
+	"$SECRET_FIELD_IDENTITY_LICENSE_NUMBER\x10\x06\x12 \n" +
+	"\x1cSECRET_FIELD_SSH_PRIVATE_KEY\x10\a\x12\x1f\n" +
+	"\x1bSECRET_FIELD_API_KEY_SECRET\x10\b\x12\x1d\n" +
+	"\x19SECRET_FIELD_CUSTOM_FIELD\x10\t2\xb7\x15\n" +
 	"\fVaultService\x12D\n" +
-	"\vCreateEntry\x12\x19.vault.CreateEntryRequest\x1a\x1a.vault.CreateEntryResponse\x12;\n" +
+	"\vCreateEntry\x12\x19.vault.CreateEntryRequest\x1a\x1a.vault.CreateEntryResponse\x12D\n" +
+	"\vUpdateEntry\x12\x19.vault.UpdateEntryRequest\x1a\x1a.vault.UpdateEntryResponse\x12;\n" +
 	"\bGetEntry\x12\x16.vault.GetEntryRequest\x1a\x17.vault.GetEntryResponse\x12D\n" +
 	"\vListEntries\x12\x19.vault.ListEntriesRequest\x1a\x1a.vault.ListEntriesResponse\x12D\n" +
-	"\vDeleteEntry\x12\x19.vault.DeleteEntryRequest\x1a\x1a.vault.DeleteEntryResponseB7Z5github.com/AleksZelenchuk/vault-server/gen/go/vaultpbb\x06proto3"
+	"\vDeleteEntry\x12\x19.vault.DeleteEntryRequest\x1a\x1a.vault.DeleteEntryResponse\x12L\n" +
+	"\rStreamEntries\x12\x1b.vault.StreamEntriesRequest\x1a\x1c.vault.StreamEntriesResponse0\x01\x12J\n" +
+	"\rSearchEntries\x12\x1b.vault.SearchEntriesRequest\x1a\x1c.vault.SearchEntriesResponse\x12G\n" +
+	"\fCreateFolder\x12\x1a.vault.CreateFolderRequest\x1a\x1b.vault.CreateFolderResponse\x12G\n" +
+	"\fRenameFolder\x12\x1a.vault.RenameFolderRequest\x1a\x1b.vault.RenameFolderResponse\x12A\n" +
+	"\n" +
+	"MoveFolder\x12\x18.vault.MoveFolderRequest\x1a\x19.vault.MoveFolderResponse\x12G\n" +
+	"\fDeleteFolder\x12\x1a.vault.DeleteFolderRequest\x1a\x1b.vault.DeleteFolderResponse\x12D\n" +
+	"\vListFolders\x12\x19.vault.ListFoldersRequest\x1a\x1a.vault.ListFoldersResponse\x12V\n" +
+	"\x11FindEntriesForURL\x12\x1f.vault.FindEntriesForURLRequest\x1a .vault.FindEntriesForURLResponse\x12D\n" +
+	"\vSetFavorite\x12\x19.vault.SetFavoriteRequest\x1a\x1a.vault.SetFavoriteResponse\x12A\n" +
+	"\n" +
+	"ListRecent\x12\x18.vault.ListRecentRequest\x1a\x19.vault.ListRecentResponse\x12;\n" +
+	"\bListTags\x12\x16.vault.ListTagsRequest\x1a\x17.vault.ListTagsResponse\x12>\n" +
+	"\tRenameTag\x12\x17.vault.RenameTagRequest\x1a\x18.vault.RenameTagResponse\x12>\n" +
+	"\tMergeTags\x12\x17.vault.MergeTagsRequest\x1a\x18.vault.MergeTagsResponse\x12D\n" +
+	"\vSuggestTags\x12\x19.vault.SuggestTagsRequest\x1a\x1a.vault.SuggestTagsResponse\x12J\n" +
+	"\rQueryAuditLog\x12\x1b.vault.QueryAuditLogRequest\x1a\x1c.vault.QueryAuditLogResponse\x12M\n" +
+	"\x0eRevealPassword\x12\x1c.vault.RevealPasswordRequest\x1a\x1d.vault.RevealPasswordResponse\x12S\n" +
+	"\x10GeneratePassword\x12\x1e.vault.GeneratePasswordRequest\x1a\x1f.vault.GeneratePasswordResponse\x12V\n" +
+	"\x11GetPasswordPolicy\x12\x1f.vault.GetPasswordPolicyRequest\x1a .vault.GetPasswordPolicyResponse\x12V\n" +
+	"\x11SetPasswordPolicy\x12\x1f.vault.SetPasswordPolicyRequest\x1a .vault.SetPasswordPolicyResponse\x12_\n" +
+	"\x14GetVaultHealthReport\x12\".vault.GetVaultHealthReportRequest\x1a#.vault.GetVaultHealthReportResponse\x12J\n" +
+	"\rCheckBreached\x12\x1b.vault.CheckBreachedRequest\x1a\x1c.vault.CheckBreachedResponse\x12\\\n" +
+	"\x13FindReusedPasswords\x12!.vault.FindReusedPasswordsRequest\x1a\".vault.FindReusedPasswordsResponse\x12n\n" +
+	"\x19ListEntriesDueForRotation\x12'.vault.ListEntriesDueForRotationRequest\x1a(.vault.ListEntriesDueForRotationResponse\x12V\n" +
+	"\x11SetFolderRotation\x12\x1f.vault.SetFolderRotationRequest\x1a .vault.SetFolderRotationResponse\x12\\\n" +
+	"\x13ListFolderRotations\x12!.vault.ListFolderRotationsRequest\x1a\".vault.ListFolderRotationsResponse\x12D\n" +
+	"\vGetTOTPCode\x12\x19.vault.GetTOTPCodeRequest\x1a\x1a.vault.GetTOTPCodeResponse\x12U\n" +
+	"\x10UploadAttachment\x12\x1e.vault.UploadAttachmentRequest\x1a\x1f.vault.UploadAttachmentResponse(\x01\x12[\n" +
+	"\x12DownloadAttachment\x12 .vault.DownloadAttachmentRequest\x1a!.vault.DownloadAttachmentResponse0\x01\x12P\n" +
+	"\x0fListAttachments\x12\x1d.vault.ListAttachmentsRequest\x1a\x1e.vault.ListAttachmentsResponse\x12S\n" +
+	"\x10DeleteAttachment\x12\x1e.vault.DeleteAttachmentRequest\x1a\x1f.vault.DeleteAttachmentResponseB7Z5github.com/AleksZelenchuk/vault-server/gen/go/vaultpbb\x06proto3"
 
 var (
 	file_vault_proto_rawDescOnce sync.Once
@@ -542,35 +6448,239 @@ func file_vault_proto_rawDescGZIP() []byte {
 	return file_vault_proto_rawDescData
 }
 
-var file_vault_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_vault_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_vault_proto_msgTypes = make([]protoimpl.MessageInfo, 94)
 var file_vault_proto_goTypes = []any{
-	(*VaultEntry)(nil),          // 0: vault.VaultEntry
-	(*CreateEntryRequest)(nil),  // 1: vault.CreateEntryRequest
-	(*CreateEntryResponse)(nil), // 2: vault.CreateEntryResponse
-	(*GetEntryRequest)(nil),     // 3: vault.GetEntryRequest
-	(*GetEntryResponse)(nil),    // 4: vault.GetEntryResponse
-	(*ListEntriesRequest)(nil),  // 5: vault.ListEntriesRequest
-	(*ListEntriesResponse)(nil), // 6: vault.ListEntriesResponse
-	(*DeleteEntryRequest)(nil),  // 7: vault.DeleteEntryRequest
-	(*DeleteEntryResponse)(nil), // 8: vault.DeleteEntryResponse
+	(EntryType)(0),                            // 0: vault.EntryType
+	(CustomFieldType)(0),                      // 1: vault.CustomFieldType
+	(UriMatch)(0),                             // 2: vault.UriMatch
+	(SecretField)(0),                          // 3: vault.SecretField
+	(*Card)(nil),                              // 4: vault.Card
+	(*Identity)(nil),                          // 5: vault.Identity
+	(*SshKey)(nil),                            // 6: vault.SshKey
+	(*ApiKey)(nil),                            // 7: vault.ApiKey
+	(*EntryUri)(nil),                          // 8: vault.EntryUri
+	(*CustomField)(nil),                       // 9: vault.CustomField
+	(*VaultEntry)(nil),                        // 10: vault.VaultEntry
+	(*CreateEntryRequest)(nil),                // 11: vault.CreateEntryRequest
+	(*CreateEntryResponse)(nil),               // 12: vault.CreateEntryResponse
+	(*BreachCheck)(nil),                       // 13: vault.BreachCheck
+	(*PasswordStrength)(nil),                  // 14: vault.PasswordStrength
+	(*UpdateEntryRequest)(nil),                // 15: vault.UpdateEntryRequest
+	(*UpdateEntryResponse)(nil),               // 16: vault.UpdateEntryResponse
+	(*GetEntryRequest)(nil),                   // 17: vault.GetEntryRequest
+	(*GetEntryResponse)(nil),                  // 18: vault.GetEntryResponse
+	(*ListEntriesRequest)(nil),                // 19: vault.ListEntriesRequest
+	(*TagSet)(nil),                            // 20: vault.TagSet
+	(*ListEntriesResponse)(nil),               // 21: vault.ListEntriesResponse
+	(*DeleteEntryRequest)(nil),                // 22: vault.DeleteEntryRequest
+	(*DeleteEntryResponse)(nil),               // 23: vault.DeleteEntryResponse
+	(*RevealPasswordRequest)(nil),             // 24: vault.RevealPasswordRequest
+	(*RevealPasswordResponse)(nil),            // 25: vault.RevealPasswordResponse
+	(*AuditEvent)(nil),                        // 26: vault.AuditEvent
+	(*QueryAuditLogRequest)(nil),              // 27: vault.QueryAuditLogRequest
+	(*QueryAuditLogResponse)(nil),             // 28: vault.QueryAuditLogResponse
+	(*PasswordPolicy)(nil),                    // 29: vault.PasswordPolicy
+	(*GeneratePasswordRequest)(nil),           // 30: vault.GeneratePasswordRequest
+	(*GeneratePasswordResponse)(nil),          // 31: vault.GeneratePasswordResponse
+	(*GetPasswordPolicyRequest)(nil),          // 32: vault.GetPasswordPolicyRequest
+	(*GetPasswordPolicyResponse)(nil),         // 33: vault.GetPasswordPolicyResponse
+	(*SetPasswordPolicyRequest)(nil),          // 34: vault.SetPasswordPolicyRequest
+	(*SetPasswordPolicyResponse)(nil),         // 35: vault.SetPasswordPolicyResponse
+	(*GetVaultHealthReportRequest)(nil),       // 36: vault.GetVaultHealthReportRequest
+	(*EntryRef)(nil),                          // 37: vault.EntryRef
+	(*WeakPassword)(nil),                      // 38: vault.WeakPassword
+	(*ReusedPassword)(nil),                    // 39: vault.ReusedPassword
+	(*OldPassword)(nil),                       // 40: vault.OldPassword
+	(*GetVaultHealthReportResponse)(nil),      // 41: vault.GetVaultHealthReportResponse
+	(*BreachedPassword)(nil),                  // 42: vault.BreachedPassword
+	(*FindReusedPasswordsRequest)(nil),        // 43: vault.FindReusedPasswordsRequest
+	(*FindReusedPasswordsResponse)(nil),       // 44: vault.FindReusedPasswordsResponse
+	(*ListEntriesDueForRotationRequest)(nil),  // 45: vault.ListEntriesDueForRotationRequest
+	(*DueEntry)(nil),                          // 46: vault.DueEntry
+	(*ListEntriesDueForRotationResponse)(nil), // 47: vault.ListEntriesDueForRotationResponse
+	(*FolderRotation)(nil),                    // 48: vault.FolderRotation
+	(*SetFolderRotationRequest)(nil),          // 49: vault.SetFolderRotationRequest
+	(*SetFolderRotationResponse)(nil),         // 50: vault.SetFolderRotationResponse
+	(*ListFolderRotationsRequest)(nil),        // 51: vault.ListFolderRotationsRequest
+	(*ListFolderRotationsResponse)(nil),       // 52: vault.ListFolderRotationsResponse
+	(*CheckBreachedRequest)(nil),              // 53: vault.CheckBreachedRequest
+	(*CheckBreachedResponse)(nil),             // 54: vault.CheckBreachedResponse
+	(*Attachment)(nil),                        // 55: vault.Attachment
+	(*UploadAttachmentHeader)(nil),            // 56: vault.UploadAttachmentHeader
+	(*UploadAttachmentRequest)(nil),           // 57: vault.UploadAttachmentRequest
+	(*UploadAttachmentResponse)(nil),          // 58: vault.UploadAttachmentResponse
+	(*DownloadAttachmentRequest)(nil),         // 59: vault.DownloadAttachmentRequest
+	(*DownloadAttachmentResponse)(nil),        // 60: vault.DownloadAttachmentResponse
+	(*ListAttachmentsRequest)(nil),            // 61: vault.ListAttachmentsRequest
+	(*ListAttachmentsResponse)(nil),           // 62: vault.ListAttachmentsResponse
+	(*DeleteAttachmentRequest)(nil),           // 63: vault.DeleteAttachmentRequest
+	(*DeleteAttachmentResponse)(nil),          // 64: vault.DeleteAttachmentResponse
+	(*GetTOTPCodeRequest)(nil),                // 65: vault.GetTOTPCodeRequest
+	(*GetTOTPCodeResponse)(nil),               // 66: vault.GetTOTPCodeResponse
+	(*StreamEntriesRequest)(nil),              // 67: vault.StreamEntriesRequest
+	(*StreamEntriesResponse)(nil),             // 68: vault.StreamEntriesResponse
+	(*SearchEntriesRequest)(nil),              // 69: vault.SearchEntriesRequest
+	(*SearchResult)(nil),                      // 70: vault.SearchResult
+	(*SearchEntriesResponse)(nil),             // 71: vault.SearchEntriesResponse
+	(*Folder)(nil),                            // 72: vault.Folder
+	(*CreateFolderRequest)(nil),               // 73: vault.CreateFolderRequest
+	(*CreateFolderResponse)(nil),              // 74: vault.CreateFolderResponse
+	(*RenameFolderRequest)(nil),               // 75: vault.RenameFolderRequest
+	(*RenameFolderResponse)(nil),              // 76: vault.RenameFolderResponse
+	(*MoveFolderRequest)(nil),                 // 77: vault.MoveFolderRequest
+	(*MoveFolderResponse)(nil),                // 78: vault.MoveFolderResponse
+	(*DeleteFolderRequest)(nil),               // 79: vault.DeleteFolderRequest
+	(*DeleteFolderResponse)(nil),              // 80: vault.DeleteFolderResponse
+	(*ListFoldersRequest)(nil),                // 81: vault.ListFoldersRequest
+	(*ListFoldersResponse)(nil),               // 82: vault.ListFoldersResponse
+	(*Tag)(nil),                               // 83: vault.Tag
+	(*ListTagsRequest)(nil),                   // 84: vault.ListTagsRequest
+	(*ListTagsResponse)(nil),                  // 85: vault.ListTagsResponse
+	(*RenameTagRequest)(nil),                  // 86: vault.RenameTagRequest
+	(*RenameTagResponse)(nil),                 // 87: vault.RenameTagResponse
+	(*MergeTagsRequest)(nil),                  // 88: vault.MergeTagsRequest
+	(*MergeTagsResponse)(nil),                 // 89: vault.MergeTagsResponse
+	(*SuggestTagsRequest)(nil),                // 90: vault.SuggestTagsRequest
+	(*SuggestTagsResponse)(nil),               // 91: vault.SuggestTagsResponse
+	(*SetFavoriteRequest)(nil),                // 92: vault.SetFavoriteRequest
+	(*SetFavoriteResponse)(nil),               // 93: vault.SetFavoriteResponse
+	(*ListRecentRequest)(nil),                 // 94: vault.ListRecentRequest
+	(*ListRecentResponse)(nil),                // 95: vault.ListRecentResponse
+	(*FindEntriesForURLRequest)(nil),          // 96: vault.FindEntriesForURLRequest
+	(*FindEntriesForURLResponse)(nil),         // 97: vault.FindEntriesForURLResponse
 }
 var file_vault_proto_depIdxs = []int32{
-	0, // 0: vault.CreateEntryRequest.entry:type_name -> vault.VaultEntry
-	0, // 1: vault.GetEntryResponse.entry:type_name -> vault.VaultEntry
-	0, // 2: vault.ListEntriesResponse.entries:type_name -> vault.VaultEntry
-	1, // 3: vault.VaultService.CreateEntry:input_type -> vault.CreateEntryRequest
-	3, // 4: vault.VaultService.GetEntry:input_type -> vault.GetEntryRequest
-	5, // 5: vault.VaultService.ListEntries:input_type -> vault.ListEntriesRequest
-	7, // 6: vault.VaultService.DeleteEntry:input_type -> vault.DeleteEntryRequest
-	2, // 7: vault.VaultService.CreateEntry:output_type -> vault.CreateEntryResponse
-	4, // 8: vault.VaultService.GetEntry:output_type -> vault.GetEntryResponse
-	6, // 9: vault.VaultService.ListEntries:output_type -> vault.ListEntriesResponse
-	8, // 10: vault.VaultService.DeleteEntry:output_type -> vault.DeleteEntryResponse
-	7, // [7:11] is the sub-list for method output_type
-	3, // [3:7] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	2,  // 0: vault.EntryUri.match:type_name -> vault.UriMatch
+	1,  // 1: vault.CustomField.type:type_name -> vault.CustomFieldType
+	0,  // 2: vault.VaultEntry.type:type_name -> vault.EntryType
+	4,  // 3: vault.VaultEntry.card:type_name -> vault.Card
+	5,  // 4: vault.VaultEntry.identity:type_name -> vault.Identity
+	6,  // 5: vault.VaultEntry.ssh_key:type_name -> vault.SshKey
+	7,  // 6: vault.VaultEntry.api_key:type_name -> vault.ApiKey
+	9,  // 7: vault.VaultEntry.custom_fields:type_name -> vault.CustomField
+	8,  // 8: vault.VaultEntry.uris:type_name -> vault.EntryUri
+	10, // 9: vault.CreateEntryRequest.entry:type_name -> vault.VaultEntry
+	14, // 10: vault.CreateEntryResponse.strength:type_name -> vault.PasswordStrength
+	13, // 11: vault.CreateEntryResponse.breach:type_name -> vault.BreachCheck
+	10, // 12: vault.UpdateEntryRequest.entry:type_name -> vault.VaultEntry
+	14, // 13: vault.UpdateEntryResponse.strength:type_name -> vault.PasswordStrength
+	13, // 14: vault.UpdateEntryResponse.breach:type_name -> vault.BreachCheck
+	10, // 15: vault.GetEntryResponse.entry:type_name -> vault.VaultEntry
+	0,  // 16: vault.ListEntriesRequest.types:type_name -> vault.EntryType
+	20, // 17: vault.ListEntriesRequest.tag_sets:type_name -> vault.TagSet
+	10, // 18: vault.ListEntriesResponse.entries:type_name -> vault.VaultEntry
+	3,  // 19: vault.RevealPasswordRequest.field:type_name -> vault.SecretField
+	26, // 20: vault.QueryAuditLogResponse.events:type_name -> vault.AuditEvent
+	29, // 21: vault.GetPasswordPolicyResponse.policy:type_name -> vault.PasswordPolicy
+	29, // 22: vault.GetPasswordPolicyResponse.effective:type_name -> vault.PasswordPolicy
+	29, // 23: vault.SetPasswordPolicyRequest.policy:type_name -> vault.PasswordPolicy
+	29, // 24: vault.SetPasswordPolicyResponse.policy:type_name -> vault.PasswordPolicy
+	37, // 25: vault.WeakPassword.entry:type_name -> vault.EntryRef
+	14, // 26: vault.WeakPassword.strength:type_name -> vault.PasswordStrength
+	37, // 27: vault.ReusedPassword.entries:type_name -> vault.EntryRef
+	37, // 28: vault.OldPassword.entry:type_name -> vault.EntryRef
+	38, // 29: vault.GetVaultHealthReportResponse.weak:type_name -> vault.WeakPassword
+	39, // 30: vault.GetVaultHealthReportResponse.reused:type_name -> vault.ReusedPassword
+	40, // 31: vault.GetVaultHealthReportResponse.old:type_name -> vault.OldPassword
+	42, // 32: vault.GetVaultHealthReportResponse.breached:type_name -> vault.BreachedPassword
+	37, // 33: vault.BreachedPassword.entry:type_name -> vault.EntryRef
+	39, // 34: vault.FindReusedPasswordsResponse.groups:type_name -> vault.ReusedPassword
+	10, // 35: vault.DueEntry.entry:type_name -> vault.VaultEntry
+	46, // 36: vault.ListEntriesDueForRotationResponse.entries:type_name -> vault.DueEntry
+	48, // 37: vault.ListFolderRotationsResponse.rotations:type_name -> vault.FolderRotation
+	13, // 38: vault.CheckBreachedResponse.result:type_name -> vault.BreachCheck
+	56, // 39: vault.UploadAttachmentRequest.header:type_name -> vault.UploadAttachmentHeader
+	55, // 40: vault.UploadAttachmentResponse.attachment:type_name -> vault.Attachment
+	55, // 41: vault.DownloadAttachmentResponse.attachment:type_name -> vault.Attachment
+	55, // 42: vault.ListAttachmentsResponse.attachments:type_name -> vault.Attachment
+	0,  // 43: vault.StreamEntriesRequest.types:type_name -> vault.EntryType
+	10, // 44: vault.StreamEntriesResponse.entries:type_name -> vault.VaultEntry
+	10, // 45: vault.SearchResult.entry:type_name -> vault.VaultEntry
+	70, // 46: vault.SearchEntriesResponse.results:type_name -> vault.SearchResult
+	72, // 47: vault.CreateFolderResponse.folder:type_name -> vault.Folder
+	72, // 48: vault.RenameFolderResponse.folder:type_name -> vault.Folder
+	72, // 49: vault.MoveFolderResponse.folder:type_name -> vault.Folder
+	72, // 50: vault.ListFoldersResponse.folders:type_name -> vault.Folder
+	83, // 51: vault.ListTagsResponse.tags:type_name -> vault.Tag
+	83, // 52: vault.SuggestTagsResponse.tags:type_name -> vault.Tag
+	10, // 53: vault.ListRecentResponse.entries:type_name -> vault.VaultEntry
+	10, // 54: vault.FindEntriesForURLResponse.entries:type_name -> vault.VaultEntry
+	11, // 55: vault.VaultService.CreateEntry:input_type -> vault.CreateEntryRequest
+	15, // 56: vault.VaultService.UpdateEntry:input_type -> vault.UpdateEntryRequest
+	17, // 57: vault.VaultService.GetEntry:input_type -> vault.GetEntryRequest
+	19, // 58: vault.VaultService.ListEntries:input_type -> vault.ListEntriesRequest
+	22, // 59: vault.VaultService.DeleteEntry:input_type -> vault.DeleteEntryRequest
+	67, // 60: vault.VaultService.StreamEntries:input_type -> vault.StreamEntriesRequest
+	69, // 61: vault.VaultService.SearchEntries:input_type -> vault.SearchEntriesRequest
+	73, // 62: vault.VaultService.CreateFolder:input_type -> vault.CreateFolderRequest
+	75, // 63: vault.VaultService.RenameFolder:input_type -> vault.RenameFolderRequest
+	77, // 64: vault.VaultService.MoveFolder:input_type -> vault.MoveFolderRequest
+	79, // 65: vault.VaultService.DeleteFolder:input_type -> vault.DeleteFolderRequest
+	81, // 66: vault.VaultService.ListFolders:input_type -> vault.ListFoldersRequest
+	96, // 67: vault.VaultService.FindEntriesForURL:input_type -> vault.FindEntriesForURLRequest
+	92, // 68: vault.VaultService.SetFavorite:input_type -> vault.SetFavoriteRequest
+	94, // 69: vault.VaultService.ListRecent:input_type -> vault.ListRecentRequest
+	84, // 70: vault.VaultService.ListTags:input_type -> vault.ListTagsRequest
+	86, // 71: vault.VaultService.RenameTag:input_type -> vault.RenameTagRequest
+	88, // 72: vault.VaultService.MergeTags:input_type -> vault.MergeTagsRequest
+	90, // 73: vault.VaultService.SuggestTags:input_type -> vault.SuggestTagsRequest
+	27, // 74: vault.VaultService.QueryAuditLog:input_type -> vault.QueryAuditLogRequest
+	24, // 75: vault.VaultService.RevealPassword:input_type -> vault.RevealPasswordRequest
+	30, // 76: vault.VaultService.GeneratePassword:input_type -> vault.GeneratePasswordRequest
+	32, // 77: vault.VaultService.GetPasswordPolicy:input_type -> vault.GetPasswordPolicyRequest
+	34, // 78: vault.VaultService.SetPasswordPolicy:input_type -> vault.SetPasswordPolicyRequest
+	36, // 79: vault.VaultService.GetVaultHealthReport:input_type -> vault.GetVaultHealthReportRequest
+	53, // 80: vault.VaultService.CheckBreached:input_type -> vault.CheckBreachedRequest
+	43, // 81: vault.VaultService.FindReusedPasswords:input_type -> vault.FindReusedPasswordsRequest
+	45, // 82: vault.VaultService.ListEntriesDueForRotation:input_type -> vault.ListEntriesDueForRotationRequest
+	49, // 83: vault.VaultService.SetFolderRotation:input_type -> vault.SetFolderRotationRequest
+	51, // 84: vault.VaultService.ListFolderRotations:input_type -> vault.ListFolderRotationsRequest
+	65, // 85: vault.VaultService.GetTOTPCode:input_type -> vault.GetTOTPCodeRequest
+	57, // 86: vault.VaultService.UploadAttachment:input_type -> vault.UploadAttachmentRequest
+	59, // 87: vault.VaultService.DownloadAttachment:input_type -> vault.DownloadAttachmentRequest
+	61, // 88: vault.VaultService.ListAttachments:input_type -> vault.ListAttachmentsRequest
+	63, // 89: vault.VaultService.DeleteAttachment:input_type -> vault.DeleteAttachmentRequest
+	12, // 90: vault.VaultService.CreateEntry:output_type -> vault.CreateEntryResponse
+	16, // 91: vault.VaultService.UpdateEntry:output_type -> vault.UpdateEntryResponse
+	18, // 92: vault.VaultService.GetEntry:output_type -> vault.GetEntryResponse
+	21, // 93: vault.VaultService.ListEntries:output_type -> vault.ListEntriesResponse
+	23, // 94: vault.VaultService.DeleteEntry:output_type -> vault.DeleteEntryResponse
+	68, // 95: vault.VaultService.StreamEntries:output_type -> vault.StreamEntriesResponse
+	71, // 96: vault.VaultService.SearchEntries:output_type -> vault.SearchEntriesResponse
+	74, // 97: vault.VaultService.CreateFolder:output_type -> vault.CreateFolderResponse
+	76, // 98: vault.VaultService.RenameFolder:output_type -> vault.RenameFolderResponse
+	78, // 99: vault.VaultService.MoveFolder:output_type -> vault.MoveFolderResponse
+	80, // 100: vault.VaultService.DeleteFolder:output_type -> vault.DeleteFolderResponse
+	82, // 101: vault.VaultService.ListFolders:output_type -> vault.ListFoldersResponse
+	97, // 102: vault.VaultService.FindEntriesForURL:output_type -> vault.FindEntriesForURLResponse
+	93, // 103: vault.VaultService.SetFavorite:output_type -> vault.SetFavoriteResponse
+	95, // 104: vault.VaultService.ListRecent:output_type -> vault.ListRecentResponse
+	85, // 105: vault.VaultService.ListTags:output_type -> vault.ListTagsResponse
+	87, // 106: vault.VaultService.RenameTag:output_type -> vault.RenameTagResponse
+	89, // 107: vault.VaultService.MergeTags:output_type -> vault.MergeTagsResponse
+	91, // 108: vault.VaultService.SuggestTags:output_type -> vault.SuggestTagsResponse
+	28, // 109: vault.VaultService.QueryAuditLog:output_type -> vault.QueryAuditLogResponse
+	25, // 110: vault.VaultService.RevealPassword:output_type -> vault.RevealPasswordResponse
+	31, // 111: vault.VaultService.GeneratePassword:output_type -> vault.GeneratePasswordResponse
+	33, // 112: vault.VaultService.GetPasswordPolicy:output_type -> vault.GetPasswordPolicyResponse
+	35, // 113: vault.VaultService.SetPasswordPolicy:output_type -> vault.SetPasswordPolicyResponse
+	41, // 114: vault.VaultService.GetVaultHealthReport:output_type -> vault.GetVaultHealthReportResponse
+	54, // 115: vault.VaultService.CheckBreached:output_type -> vault.CheckBreachedResponse
+	44, // 116: vault.VaultService.FindReusedPasswords:output_type -> vault.FindReusedPasswordsResponse
+	47, // 117: vault.VaultService.ListEntriesDueForRotation:output_type -> vault.ListEntriesDueForRotationResponse
+	50, // 118: vault.VaultService.SetFolderRotation:output_type -> vault.SetFolderRotationResponse
+	52, // 119: vault.VaultService.ListFolderRotations:output_type -> vault.ListFolderRotationsResponse
+	66, // 120: vault.VaultService.GetTOTPCode:output_type -> vault.GetTOTPCodeResponse
+	58, // 121: vault.VaultService.UploadAttachment:output_type -> vault.UploadAttachmentResponse
+	60, // 122: vault.VaultService.DownloadAttachment:output_type -> vault.DownloadAttachmentResponse
+	62, // 123: vault.VaultService.ListAttachments:output_type -> vault.ListAttachmentsResponse
+	64, // 124: vault.VaultService.DeleteAttachment:output_type -> vault.DeleteAttachmentResponse
+	90, // [90:125] is the sub-list for method output_type
+	55, // [55:90] is the sub-list for method input_type
+	55, // [55:55] is the sub-list for extension type_name
+	55, // [55:55] is the sub-list for extension extendee
+	0,  // [0:55] is the sub-list for field type_name
 }
 
 func init() { file_vault_proto_init() }
@@ -578,18 +6688,35 @@ func file_vault_proto_init() {
 	if File_vault_proto != nil {
 		return
 	}
+	file_vault_proto_msgTypes[6].OneofWrappers = []any{
+		(*VaultEntry_Card)(nil),
+		(*VaultEntry_Identity)(nil),
+		(*VaultEntry_SshKey)(nil),
+		(*VaultEntry_ApiKey)(nil),
+	}
+	file_vault_proto_msgTypes[26].OneofWrappers = []any{}
+	file_vault_proto_msgTypes[53].OneofWrappers = []any{
+		(*UploadAttachmentRequest_Header)(nil),
+		(*UploadAttachmentRequest_Chunk)(nil),
+	}
+	file_vault_proto_msgTypes[56].OneofWrappers = []any{
+		(*DownloadAttachmentResponse_Attachment)(nil),
+		(*DownloadAttachmentResponse_Chunk)(nil),
+	}
+	file_vault_proto_msgTypes[68].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_vault_proto_rawDesc), len(file_vault_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   9,
+			NumEnums:      4,
+			NumMessages:   94,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_vault_proto_goTypes,
 		DependencyIndexes: file_vault_proto_depIdxs,
+		EnumInfos:         file_vault_proto_enumTypes,
 		MessageInfos:      file_vault_proto_msgTypes,
 	}.Build()
 	File_vault_proto = out.File
@@ -19,10 +19,41 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	VaultService_CreateEntry_FullMethodName = "/vault.VaultService/CreateEntry"
-	VaultService_GetEntry_FullMethodName    = "/vault.VaultService/GetEntry"
-	VaultService_ListEntries_FullMethodName = "/vault.VaultService/ListEntries"
-	VaultService_DeleteEntry_FullMethodName = "/vault.VaultService/DeleteEntry"
+	VaultService_CreateEntry_FullMethodName               = "/vault.VaultService/CreateEntry"
+	VaultService_UpdateEntry_FullMethodName               = "/vault.VaultService/UpdateEntry"
+	VaultService_GetEntry_FullMethodName                  = "/vault.VaultService/GetEntry"
+	VaultService_ListEntries_FullMethodName               = "/vault.VaultService/ListEntries"
+	VaultService_DeleteEntry_FullMethodName               = "/vault.VaultService/DeleteEntry"
+	VaultService_StreamEntries_FullMethodName             = "/vault.VaultService/StreamEntries"
+	VaultService_SearchEntries_FullMethodName             = "/vault.VaultService/SearchEntries"
+	VaultService_CreateFolder_FullMethodName              = "/vault.VaultService/CreateFolder"
+	VaultService_RenameFolder_FullMethodName              = "/vault.VaultService/RenameFolder"
+	VaultService_MoveFolder_FullMethodName                = "/vault.VaultService/MoveFolder"
+	VaultService_DeleteFolder_FullMethodName              = "/vault.VaultService/DeleteFolder"
+	VaultService_ListFolders_FullMethodName               = "/vault.VaultService/ListFolders"
+	VaultService_FindEntriesForURL_FullMethodName         = "/vault.VaultService/FindEntriesForURL"
+	VaultService_SetFavorite_FullMethodName               = "/vault.VaultService/SetFavorite"
+	VaultService_ListRecent_FullMethodName                = "/vault.VaultService/ListRecent"
+	VaultService_ListTags_FullMethodName                  = "/vault.VaultService/ListTags"
+	VaultService_RenameTag_FullMethodName                 = "/vault.VaultService/RenameTag"
+	VaultService_MergeTags_FullMethodName                 = "/vault.VaultService/MergeTags"
+	VaultService_SuggestTags_FullMethodName               = "/vault.VaultService/SuggestTags"
+	VaultService_QueryAuditLog_FullMethodName             = "/vault.VaultService/QueryAuditLog"
+	VaultService_RevealPassword_FullMethodName            = "/vault.VaultService/RevealPassword"
+	VaultService_GeneratePassword_FullMethodName          = "/vault.VaultService/GeneratePassword"
+	VaultService_GetPasswordPolicy_FullMethodName         = "/vault.VaultService/GetPasswordPolicy"
+	VaultService_SetPasswordPolicy_FullMethodName         = "/vault.VaultService/SetPasswordPolicy"
+	VaultService_GetVaultHealthReport_FullMethodName      = "/vault.VaultService/GetVaultHealthReport"
+	VaultService_CheckBreached_FullMethodName             = "/vault.VaultService/CheckBreached"
+	VaultService_FindReusedPasswords_FullMethodName       = "/vault.VaultService/FindReusedPasswords"
+	VaultService_ListEntriesDueForRotation_FullMethodName = "/vault.VaultService/ListEntriesDueForRotation"
+	VaultService_SetFolderRotation_FullMethodName         = "/vault.VaultService/SetFolderRotation"
+	VaultService_ListFolderRotations_FullMethodName       = "/vault.VaultService/ListFolderRotations"
+	VaultService_GetTOTPCode_FullMethodName               = "/vault.VaultService/GetTOTPCode"
+	VaultService_UploadAttachment_FullMethodName          = "/vault.VaultService/UploadAttachment"
+	VaultService_DownloadAttachment_FullMethodName        = "/vault.VaultService/DownloadAttachment"
+	VaultService_ListAttachments_FullMethodName           = "/vault.VaultService/ListAttachments"
+	VaultService_DeleteAttachment_FullMethodName          = "/vault.VaultService/DeleteAttachment"
 )
 
 // VaultServiceClient is the client API for VaultService service.
@@ -30,9 +61,54 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type VaultServiceClient interface {
 	CreateEntry(ctx context.Context, in *CreateEntryRequest, opts ...grpc.CallOption) (*CreateEntryResponse, error)
+	UpdateEntry(ctx context.Context, in *UpdateEntryRequest, opts ...grpc.CallOption) (*UpdateEntryResponse, error)
 	GetEntry(ctx context.Context, in *GetEntryRequest, opts ...grpc.CallOption) (*GetEntryResponse, error)
 	ListEntries(ctx context.Context, in *ListEntriesRequest, opts ...grpc.CallOption) (*ListEntriesResponse, error)
 	DeleteEntry(ctx context.Context, in *DeleteEntryRequest, opts ...grpc.CallOption) (*DeleteEntryResponse, error)
+	// StreamEntries sends the whole vault in batches, for sync clients
+	StreamEntries(ctx context.Context, in *StreamEntriesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StreamEntriesResponse], error)
+	// SearchEntries searches entry metadata with a small query language and returns ranked results
+	SearchEntries(ctx context.Context, in *SearchEntriesRequest, opts ...grpc.CallOption) (*SearchEntriesResponse, error)
+	CreateFolder(ctx context.Context, in *CreateFolderRequest, opts ...grpc.CallOption) (*CreateFolderResponse, error)
+	RenameFolder(ctx context.Context, in *RenameFolderRequest, opts ...grpc.CallOption) (*RenameFolderResponse, error)
+	MoveFolder(ctx context.Context, in *MoveFolderRequest, opts ...grpc.CallOption) (*MoveFolderResponse, error)
+	// DeleteFolder deletes a folder, its entries are kept and move to the parent folder
+	DeleteFolder(ctx context.Context, in *DeleteFolderRequest, opts ...grpc.CallOption) (*DeleteFolderResponse, error)
+	ListFolders(ctx context.Context, in *ListFoldersRequest, opts ...grpc.CallOption) (*ListFoldersResponse, error)
+	// FindEntriesForURL looks entries up by the URL of a page, for browser extensions and CLIs to autofill
+	FindEntriesForURL(ctx context.Context, in *FindEntriesForURLRequest, opts ...grpc.CallOption) (*FindEntriesForURLResponse, error)
+	// SetFavorite marks or unmarks one of the caller's entries as favorite
+	SetFavorite(ctx context.Context, in *SetFavoriteRequest, opts ...grpc.CallOption) (*SetFavoriteResponse, error)
+	// ListRecent lists the entries the caller used last, GetEntry and RevealPassword count as use
+	ListRecent(ctx context.Context, in *ListRecentRequest, opts ...grpc.CallOption) (*ListRecentResponse, error)
+	ListTags(ctx context.Context, in *ListTagsRequest, opts ...grpc.CallOption) (*ListTagsResponse, error)
+	// RenameTag and MergeTags change the tags of all of the caller's entries in one transaction
+	RenameTag(ctx context.Context, in *RenameTagRequest, opts ...grpc.CallOption) (*RenameTagResponse, error)
+	MergeTags(ctx context.Context, in *MergeTagsRequest, opts ...grpc.CallOption) (*MergeTagsResponse, error)
+	// SuggestTags completes a tag prefix for clients
+	SuggestTags(ctx context.Context, in *SuggestTagsRequest, opts ...grpc.CallOption) (*SuggestTagsResponse, error)
+	QueryAuditLog(ctx context.Context, in *QueryAuditLogRequest, opts ...grpc.CallOption) (*QueryAuditLogResponse, error)
+	// RevealPassword decrypts a single secret field, GetEntry and ListEntries only return metadata
+	RevealPassword(ctx context.Context, in *RevealPasswordRequest, opts ...grpc.CallOption) (*RevealPasswordResponse, error)
+	// GeneratePassword generates a password or passphrase that satisfies the caller's effective policy
+	GeneratePassword(ctx context.Context, in *GeneratePasswordRequest, opts ...grpc.CallOption) (*GeneratePasswordResponse, error)
+	GetPasswordPolicy(ctx context.Context, in *GetPasswordPolicyRequest, opts ...grpc.CallOption) (*GetPasswordPolicyResponse, error)
+	SetPasswordPolicy(ctx context.Context, in *SetPasswordPolicyRequest, opts ...grpc.CallOption) (*SetPasswordPolicyResponse, error)
+	// GetVaultHealthReport lists weak, reused and old passwords of the caller
+	GetVaultHealthReport(ctx context.Context, in *GetVaultHealthReportRequest, opts ...grpc.CallOption) (*GetVaultHealthReportResponse, error)
+	// CheckBreached looks a password up in the local breach dataset, the server never calls the internet
+	CheckBreached(ctx context.Context, in *CheckBreachedRequest, opts ...grpc.CallOption) (*CheckBreachedResponse, error)
+	// FindReusedPasswords groups the caller's entries that share a password using stored fingerprints
+	FindReusedPasswords(ctx context.Context, in *FindReusedPasswordsRequest, opts ...grpc.CallOption) (*FindReusedPasswordsResponse, error)
+	ListEntriesDueForRotation(ctx context.Context, in *ListEntriesDueForRotationRequest, opts ...grpc.CallOption) (*ListEntriesDueForRotationResponse, error)
+	SetFolderRotation(ctx context.Context, in *SetFolderRotationRequest, opts ...grpc.CallOption) (*SetFolderRotationResponse, error)
+	ListFolderRotations(ctx context.Context, in *ListFolderRotationsRequest, opts ...grpc.CallOption) (*ListFolderRotationsResponse, error)
+	// GetTOTPCode returns the current one-time code of an entry's TOTP secret
+	GetTOTPCode(ctx context.Context, in *GetTOTPCodeRequest, opts ...grpc.CallOption) (*GetTOTPCodeResponse, error)
+	UploadAttachment(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadAttachmentRequest, UploadAttachmentResponse], error)
+	DownloadAttachment(ctx context.Context, in *DownloadAttachmentRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DownloadAttachmentResponse], error)
+	ListAttachments(ctx context.Context, in *ListAttachmentsRequest, opts ...grpc.CallOption) (*ListAttachmentsResponse, error)
+	DeleteAttachment(ctx context.Context, in *DeleteAttachmentRequest, opts ...grpc.CallOption) (*DeleteAttachmentResponse, error)
 }
 
 type vaultServiceClient struct {
@@ -53,6 +129,16 @@ func (c *vaultServiceClient) CreateEntry(ctx context.Context, in *CreateEntryReq
 	return out, nil
 }
 
+func (c *vaultServiceClient) UpdateEntry(ctx context.Context, in *UpdateEntryRequest, opts ...grpc.CallOption) (*UpdateEntryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateEntryResponse)
+	err := c.cc.Invoke(ctx, VaultService_UpdateEntry_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *vaultServiceClient) GetEntry(ctx context.Context, in *GetEntryRequest, opts ...grpc.CallOption) (*GetEntryResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetEntryResponse)
@@ -83,14 +169,380 @@ func (c *vaultServiceClient) DeleteEntry(ctx context.Context, in *DeleteEntryReq
 	return out, nil
 }
 
+func (c *vaultServiceClient) StreamEntries(ctx context.Context, in *StreamEntriesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StreamEntriesResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &VaultService_ServiceDesc.Streams[0], VaultService_StreamEntries_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamEntriesRequest, StreamEntriesResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type VaultService_StreamEntriesClient = grpc.ServerStreamingClient[StreamEntriesResponse]
+
+func (c *vaultServiceClient) SearchEntries(ctx context.Context, in *SearchEntriesRequest, opts ...grpc.CallOption) (*SearchEntriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchEntriesResponse)
+	err := c.cc.Invoke(ctx, VaultService_SearchEntries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vaultServiceClient) CreateFolder(ctx context.Context, in *CreateFolderRequest, opts ...grpc.CallOption) (*CreateFolderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateFolderResponse)
+	err := c.cc.Invoke(ctx, VaultService_CreateFolder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vaultServiceClient) RenameFolder(ctx context.Context, in *RenameFolderRequest, opts ...grpc.CallOption) (*RenameFolderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RenameFolderResponse)
+	err := c.cc.Invoke(ctx, VaultService_RenameFolder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vaultServiceClient) MoveFolder(ctx context.Context, in *MoveFolderRequest, opts ...grpc.CallOption) (*MoveFolderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MoveFolderResponse)
+	err := c.cc.Invoke(ctx, VaultService_MoveFolder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vaultServiceClient) DeleteFolder(ctx context.Context, in *DeleteFolderRequest, opts ...grpc.CallOption) (*DeleteFolderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteFolderResponse)
+	err := c.cc.Invoke(ctx, VaultService_DeleteFolder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vaultServiceClient) ListFolders(ctx context.Context, in *ListFoldersRequest, opts ...grpc.CallOption) (*ListFoldersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListFoldersResponse)
+	err := c.cc.Invoke(ctx, VaultService_ListFolders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vaultServiceClient) FindEntriesForURL(ctx context.Context, in *FindEntriesForURLRequest, opts ...grpc.CallOption) (*FindEntriesForURLResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FindEntriesForURLResponse)
+	err := c.cc.Invoke(ctx, VaultService_FindEntriesForURL_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vaultServiceClient) SetFavorite(ctx context.Context, in *SetFavoriteRequest, opts ...grpc.CallOption) (*SetFavoriteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetFavoriteResponse)
+	err := c.cc.Invoke(ctx, VaultService_SetFavorite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vaultServiceClient) ListRecent(ctx context.Context, in *ListRecentRequest, opts ...grpc.CallOption) (*ListRecentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRecentResponse)
+	err := c.cc.Invoke(ctx, VaultService_ListRecent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vaultServiceClient) ListTags(ctx context.Context, in *ListTagsRequest, opts ...grpc.CallOption) (*ListTagsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTagsResponse)
+	err := c.cc.Invoke(ctx, VaultService_ListTags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vaultServiceClient) RenameTag(ctx context.Context, in *RenameTagRequest, opts ...grpc.CallOption) (*RenameTagResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RenameTagResponse)
+	err := c.cc.Invoke(ctx, VaultService_RenameTag_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vaultServiceClient) MergeTags(ctx context.Context, in *MergeTagsRequest, opts ...grpc.CallOption) (*MergeTagsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MergeTagsResponse)
+	err := c.cc.Invoke(ctx, VaultService_MergeTags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vaultServiceClient) SuggestTags(ctx context.Context, in *SuggestTagsRequest, opts ...grpc.CallOption) (*SuggestTagsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SuggestTagsResponse)
+	err := c.cc.Invoke(ctx, VaultService_SuggestTags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vaultServiceClient) QueryAuditLog(ctx context.Context, in *QueryAuditLogRequest, opts ...grpc.CallOption) (*QueryAuditLogResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryAuditLogResponse)
+	err := c.cc.Invoke(ctx, VaultService_QueryAuditLog_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vaultServiceClient) RevealPassword(ctx context.Context, in *RevealPasswordRequest, opts ...grpc.CallOption) (*RevealPasswordResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevealPasswordResponse)
+	err := c.cc.Invoke(ctx, VaultService_RevealPassword_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vaultServiceClient) GeneratePassword(ctx context.Context, in *GeneratePasswordRequest, opts ...grpc.CallOption) (*GeneratePasswordResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GeneratePasswordResponse)
+	err := c.cc.Invoke(ctx, VaultService_GeneratePassword_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vaultServiceClient) GetPasswordPolicy(ctx context.Context, in *GetPasswordPolicyRequest, opts ...grpc.CallOption) (*GetPasswordPolicyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPasswordPolicyResponse)
+	err := c.cc.Invoke(ctx, VaultService_GetPasswordPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vaultServiceClient) SetPasswordPolicy(ctx context.Context, in *SetPasswordPolicyRequest, opts ...grpc.CallOption) (*SetPasswordPolicyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetPasswordPolicyResponse)
+	err := c.cc.Invoke(ctx, VaultService_SetPasswordPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vaultServiceClient) GetVaultHealthReport(ctx context.Context, in *GetVaultHealthReportRequest, opts ...grpc.CallOption) (*GetVaultHealthReportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetVaultHealthReportResponse)
+	err := c.cc.Invoke(ctx, VaultService_GetVaultHealthReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vaultServiceClient) CheckBreached(ctx context.Context, in *CheckBreachedRequest, opts ...grpc.CallOption) (*CheckBreachedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckBreachedResponse)
+	err := c.cc.Invoke(ctx, VaultService_CheckBreached_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vaultServiceClient) FindReusedPasswords(ctx context.Context, in *FindReusedPasswordsRequest, opts ...grpc.CallOption) (*FindReusedPasswordsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FindReusedPasswordsResponse)
+	err := c.cc.Invoke(ctx, VaultService_FindReusedPasswords_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vaultServiceClient) ListEntriesDueForRotation(ctx context.Context, in *ListEntriesDueForRotationRequest, opts ...grpc.CallOption) (*ListEntriesDueForRotationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListEntriesDueForRotationResponse)
+	err := c.cc.Invoke(ctx, VaultService_ListEntriesDueForRotation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vaultServiceClient) SetFolderRotation(ctx context.Context, in *SetFolderRotationRequest, opts ...grpc.CallOption) (*SetFolderRotationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetFolderRotationResponse)
+	err := c.cc.Invoke(ctx, VaultService_SetFolderRotation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vaultServiceClient) ListFolderRotations(ctx context.Context, in *ListFolderRotationsRequest, opts ...grpc.CallOption) (*ListFolderRotationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListFolderRotationsResponse)
+	err := c.cc.Invoke(ctx, VaultService_ListFolderRotations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vaultServiceClient) GetTOTPCode(ctx context.Context, in *GetTOTPCodeRequest, opts ...grpc.CallOption) (*GetTOTPCodeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTOTPCodeResponse)
+	err := c.cc.Invoke(ctx, VaultService_GetTOTPCode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vaultServiceClient) UploadAttachment(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadAttachmentRequest, UploadAttachmentResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &VaultService_ServiceDesc.Streams[1], VaultService_UploadAttachment_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[UploadAttachmentRequest, UploadAttachmentResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type VaultService_UploadAttachmentClient = grpc.ClientStreamingClient[UploadAttachmentRequest, UploadAttachmentResponse]
+
+func (c *vaultServiceClient) DownloadAttachment(ctx context.Context, in *DownloadAttachmentRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DownloadAttachmentResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &VaultService_ServiceDesc.Streams[2], VaultService_DownloadAttachment_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[DownloadAttachmentRequest, DownloadAttachmentResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type VaultService_DownloadAttachmentClient = grpc.ServerStreamingClient[DownloadAttachmentResponse]
+
+func (c *vaultServiceClient) ListAttachments(ctx context.Context, in *ListAttachmentsRequest, opts ...grpc.CallOption) (*ListAttachmentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAttachmentsResponse)
+	err := c.cc.Invoke(ctx, VaultService_ListAttachments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vaultServiceClient) DeleteAttachment(ctx context.Context, in *DeleteAttachmentRequest, opts ...grpc.CallOption) (*DeleteAttachmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteAttachmentResponse)
+	err := c.cc.Invoke(ctx, VaultService_DeleteAttachment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // VaultServiceServer is the server API for VaultService service.
 // All implementations must embed UnimplementedVaultServiceServer
 // for forward compatibility.
 type VaultServiceServer interface {
 	CreateEntry(context.Context, *CreateEntryRequest) (*CreateEntryResponse, error)
+	UpdateEntry(context.Context, *UpdateEntryRequest) (*UpdateEntryResponse, error)
 	GetEntry(context.Context, *GetEntryRequest) (*GetEntryResponse, error)
 	ListEntries(context.Context, *ListEntriesRequest) (*ListEntriesResponse, error)
 	DeleteEntry(context.Context, *DeleteEntryRequest) (*DeleteEntryResponse, error)
+	// StreamEntries sends the whole vault in batches, for sync clients
+	StreamEntries(*StreamEntriesRequest, grpc.ServerStreamingServer[StreamEntriesResponse]) error
+	// SearchEntries searches entry metadata with a small query language and returns ranked results
+	SearchEntries(context.Context, *SearchEntriesRequest) (*SearchEntriesResponse, error)
+	CreateFolder(context.Context, *CreateFolderRequest) (*CreateFolderResponse, error)
+	RenameFolder(context.Context, *RenameFolderRequest) (*RenameFolderResponse, error)
+	MoveFolder(context.Context, *MoveFolderRequest) (*MoveFolderResponse, error)
+	// DeleteFolder deletes a folder, its entries are kept and move to the parent folder
+	DeleteFolder(context.Context, *DeleteFolderRequest) (*DeleteFolderResponse, error)
+	ListFolders(context.Context, *ListFoldersRequest) (*ListFoldersResponse, error)
+	// FindEntriesForURL looks entries up by the URL of a page, for browser extensions and CLIs to autofill
+	FindEntriesForURL(context.Context, *FindEntriesForURLRequest) (*FindEntriesForURLResponse, error)
+	// SetFavorite marks or unmarks one of the caller's entries as favorite
+	SetFavorite(context.Context, *SetFavoriteRequest) (*SetFavoriteResponse, error)
+	// ListRecent lists the entries the caller used last, GetEntry and RevealPassword count as use
+	ListRecent(context.Context, *ListRecentRequest) (*ListRecentResponse, error)
+	ListTags(context.Context, *ListTagsRequest) (*ListTagsResponse, error)
+	// RenameTag and MergeTags change the tags of all of the caller's entries in one transaction
+	RenameTag(context.Context, *RenameTagRequest) (*RenameTagResponse, error)
+	MergeTags(context.Context, *MergeTagsRequest) (*MergeTagsResponse, error)
+	// SuggestTags completes a tag prefix for clients
+	SuggestTags(context.Context, *SuggestTagsRequest) (*SuggestTagsResponse, error)
+	QueryAuditLog(context.Context, *QueryAuditLogRequest) (*QueryAuditLogResponse, error)
+	// RevealPassword decrypts a single secret field, GetEntry and ListEntries only return metadata
+	RevealPassword(context.Context, *RevealPasswordRequest) (*RevealPasswordResponse, error)
+	// GeneratePassword generates a password or passphrase that satisfies the caller's effective policy
+	GeneratePassword(context.Context, *GeneratePasswordRequest) (*GeneratePasswordResponse, error)
+	GetPasswordPolicy(context.Context, *GetPasswordPolicyRequest) (*GetPasswordPolicyResponse, error)
+	SetPasswordPolicy(context.Context, *SetPasswordPolicyRequest) (*SetPasswordPolicyResponse, error)
+	// GetVaultHealthReport lists weak, reused and old passwords of the caller
+	GetVaultHealthReport(context.Context, *GetVaultHealthReportRequest) (*GetVaultHealthReportResponse, error)
+	// CheckBreached looks a password up in the local breach dataset, the server never calls the internet
+	CheckBreached(context.Context, *CheckBreachedRequest) (*CheckBreachedResponse, error)
+	// FindReusedPasswords groups the caller's entries that share a password using stored fingerprints
+	FindReusedPasswords(context.Context, *FindReusedPasswordsRequest) (*FindReusedPasswordsResponse, error)
+	ListEntriesDueForRotation(context.Context, *ListEntriesDueForRotationRequest) (*ListEntriesDueForRotationResponse, error)
+	SetFolderRotation(context.Context, *SetFolderRotationRequest) (*SetFolderRotationResponse, error)
+	ListFolderRotations(context.Context, *ListFolderRotationsRequest) (*ListFolderRotationsResponse, error)
+	// GetTOTPCode returns the current one-time code of an entry's TOTP secret
+	GetTOTPCode(context.Context, *GetTOTPCodeRequest) (*GetTOTPCodeResponse, error)
+	UploadAttachment(grpc.ClientStreamingServer[UploadAttachmentRequest, UploadAttachmentResponse]) error
+	DownloadAttachment(*DownloadAttachmentRequest, grpc.ServerStreamingServer[DownloadAttachmentResponse]) error
+	ListAttachments(context.Context, *ListAttachmentsRequest) (*ListAttachmentsResponse, error)
+	DeleteAttachment(context.Context, *DeleteAttachmentRequest) (*DeleteAttachmentResponse, error)
 	mustEmbedUnimplementedVaultServiceServer()
 }
 
@@ -104,6 +556,9 @@ type UnimplementedVaultServiceServer struct{}
 func (UnimplementedVaultServiceServer) CreateEntry(context.Context, *CreateEntryRequest) (*CreateEntryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateEntry not implemented")
 }
+func (UnimplementedVaultServiceServer) UpdateEntry(context.Context, *UpdateEntryRequest) (*UpdateEntryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateEntry not implemented")
+}
 func (UnimplementedVaultServiceServer) GetEntry(context.Context, *GetEntryRequest) (*GetEntryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetEntry not implemented")
 }
@@ -113,6 +568,96 @@ func (UnimplementedVaultServiceServer) ListEntries(context.Context, *ListEntries
 func (UnimplementedVaultServiceServer) DeleteEntry(context.Context, *DeleteEntryRequest) (*DeleteEntryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteEntry not implemented")
 }
+func (UnimplementedVaultServiceServer) StreamEntries(*StreamEntriesRequest, grpc.ServerStreamingServer[StreamEntriesResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamEntries not implemented")
+}
+func (UnimplementedVaultServiceServer) SearchEntries(context.Context, *SearchEntriesRequest) (*SearchEntriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchEntries not implemented")
+}
+func (UnimplementedVaultServiceServer) CreateFolder(context.Context, *CreateFolderRequest) (*CreateFolderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateFolder not implemented")
+}
+func (UnimplementedVaultServiceServer) RenameFolder(context.Context, *RenameFolderRequest) (*RenameFolderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenameFolder not implemented")
+}
+func (UnimplementedVaultServiceServer) MoveFolder(context.Context, *MoveFolderRequest) (*MoveFolderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveFolder not implemented")
+}
+func (UnimplementedVaultServiceServer) DeleteFolder(context.Context, *DeleteFolderRequest) (*DeleteFolderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteFolder not implemented")
+}
+func (UnimplementedVaultServiceServer) ListFolders(context.Context, *ListFoldersRequest) (*ListFoldersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFolders not implemented")
+}
+func (UnimplementedVaultServiceServer) FindEntriesForURL(context.Context, *FindEntriesForURLRequest) (*FindEntriesForURLResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindEntriesForURL not implemented")
+}
+func (UnimplementedVaultServiceServer) SetFavorite(context.Context, *SetFavoriteRequest) (*SetFavoriteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFavorite not implemented")
+}
+func (UnimplementedVaultServiceServer) ListRecent(context.Context, *ListRecentRequest) (*ListRecentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRecent not implemented")
+}
+func (UnimplementedVaultServiceServer) ListTags(context.Context, *ListTagsRequest) (*ListTagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTags not implemented")
+}
+func (UnimplementedVaultServiceServer) RenameTag(context.Context, *RenameTagRequest) (*RenameTagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenameTag not implemented")
+}
+func (UnimplementedVaultServiceServer) MergeTags(context.Context, *MergeTagsRequest) (*MergeTagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeTags not implemented")
+}
+func (UnimplementedVaultServiceServer) SuggestTags(context.Context, *SuggestTagsRequest) (*SuggestTagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuggestTags not implemented")
+}
+func (UnimplementedVaultServiceServer) QueryAuditLog(context.Context, *QueryAuditLogRequest) (*QueryAuditLogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryAuditLog not implemented")
+}
+func (UnimplementedVaultServiceServer) RevealPassword(context.Context, *RevealPasswordRequest) (*RevealPasswordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevealPassword not implemented")
+}
+func (UnimplementedVaultServiceServer) GeneratePassword(context.Context, *GeneratePasswordRequest) (*GeneratePasswordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GeneratePassword not implemented")
+}
+func (UnimplementedVaultServiceServer) GetPasswordPolicy(context.Context, *GetPasswordPolicyRequest) (*GetPasswordPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPasswordPolicy not implemented")
+}
+func (UnimplementedVaultServiceServer) SetPasswordPolicy(context.Context, *SetPasswordPolicyRequest) (*SetPasswordPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPasswordPolicy not implemented")
+}
+func (UnimplementedVaultServiceServer) GetVaultHealthReport(context.Context, *GetVaultHealthReportRequest) (*GetVaultHealthReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVaultHealthReport not implemented")
+}
+func (UnimplementedVaultServiceServer) CheckBreached(context.Context, *CheckBreachedRequest) (*CheckBreachedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckBreached not implemented")
+}
+func (UnimplementedVaultServiceServer) FindReusedPasswords(context.Context, *FindReusedPasswordsRequest) (*FindReusedPasswordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindReusedPasswords not implemented")
+}
+func (UnimplementedVaultServiceServer) ListEntriesDueForRotation(context.Context, *ListEntriesDueForRotationRequest) (*ListEntriesDueForRotationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEntriesDueForRotation not implemented")
+}
+func (UnimplementedVaultServiceServer) SetFolderRotation(context.Context, *SetFolderRotationRequest) (*SetFolderRotationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFolderRotation not implemented")
+}
+func (UnimplementedVaultServiceServer) ListFolderRotations(context.Context, *ListFolderRotationsRequest) (*ListFolderRotationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFolderRotations not implemented")
+}
+func (UnimplementedVaultServiceServer) GetTOTPCode(context.Context, *GetTOTPCodeRequest) (*GetTOTPCodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTOTPCode not implemented")
+}
+func (UnimplementedVaultServiceServer) UploadAttachment(grpc.ClientStreamingServer[UploadAttachmentRequest, UploadAttachmentResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method UploadAttachment not implemented")
+}
+func (UnimplementedVaultServiceServer) DownloadAttachment(*DownloadAttachmentRequest, grpc.ServerStreamingServer[DownloadAttachmentResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method DownloadAttachment not implemented")
+}
+func (UnimplementedVaultServiceServer) ListAttachments(context.Context, *ListAttachmentsRequest) (*ListAttachmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAttachments not implemented")
+}
+func (UnimplementedVaultServiceServer) DeleteAttachment(context.Context, *DeleteAttachmentRequest) (*DeleteAttachmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAttachment not implemented")
+}
 func (UnimplementedVaultServiceServer) mustEmbedUnimplementedVaultServiceServer() {}
 func (UnimplementedVaultServiceServer) testEmbeddedByValue()                      {}
 
@@ -152,6 +697,24 @@ func _VaultService_CreateEntry_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _VaultService_UpdateEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VaultServiceServer).UpdateEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VaultService_UpdateEntry_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VaultServiceServer).UpdateEntry(ctx, req.(*UpdateEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _VaultService_GetEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetEntryRequest)
 	if err := dec(in); err != nil {
@@ -206,6 +769,521 @@ func _VaultService_DeleteEntry_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _VaultService_StreamEntries_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamEntriesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(VaultServiceServer).StreamEntries(m, &grpc.GenericServerStream[StreamEntriesRequest, StreamEntriesResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type VaultService_StreamEntriesServer = grpc.ServerStreamingServer[StreamEntriesResponse]
+
+func _VaultService_SearchEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchEntriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VaultServiceServer).SearchEntries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VaultService_SearchEntries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VaultServiceServer).SearchEntries(ctx, req.(*SearchEntriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VaultService_CreateFolder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateFolderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VaultServiceServer).CreateFolder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VaultService_CreateFolder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VaultServiceServer).CreateFolder(ctx, req.(*CreateFolderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VaultService_RenameFolder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameFolderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VaultServiceServer).RenameFolder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VaultService_RenameFolder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VaultServiceServer).RenameFolder(ctx, req.(*RenameFolderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VaultService_MoveFolder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveFolderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VaultServiceServer).MoveFolder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VaultService_MoveFolder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VaultServiceServer).MoveFolder(ctx, req.(*MoveFolderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VaultService_DeleteFolder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteFolderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VaultServiceServer).DeleteFolder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VaultService_DeleteFolder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VaultServiceServer).DeleteFolder(ctx, req.(*DeleteFolderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VaultService_ListFolders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFoldersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VaultServiceServer).ListFolders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VaultService_ListFolders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VaultServiceServer).ListFolders(ctx, req.(*ListFoldersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VaultService_FindEntriesForURL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindEntriesForURLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VaultServiceServer).FindEntriesForURL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VaultService_FindEntriesForURL_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VaultServiceServer).FindEntriesForURL(ctx, req.(*FindEntriesForURLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VaultService_SetFavorite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetFavoriteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VaultServiceServer).SetFavorite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VaultService_SetFavorite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VaultServiceServer).SetFavorite(ctx, req.(*SetFavoriteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VaultService_ListRecent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRecentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VaultServiceServer).ListRecent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VaultService_ListRecent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VaultServiceServer).ListRecent(ctx, req.(*ListRecentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VaultService_ListTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VaultServiceServer).ListTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VaultService_ListTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VaultServiceServer).ListTags(ctx, req.(*ListTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VaultService_RenameTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VaultServiceServer).RenameTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VaultService_RenameTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VaultServiceServer).RenameTag(ctx, req.(*RenameTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VaultService_MergeTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VaultServiceServer).MergeTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VaultService_MergeTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VaultServiceServer).MergeTags(ctx, req.(*MergeTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VaultService_SuggestTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuggestTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VaultServiceServer).SuggestTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VaultService_SuggestTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VaultServiceServer).SuggestTags(ctx, req.(*SuggestTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VaultService_QueryAuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAuditLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VaultServiceServer).QueryAuditLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VaultService_QueryAuditLog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VaultServiceServer).QueryAuditLog(ctx, req.(*QueryAuditLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VaultService_RevealPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevealPasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VaultServiceServer).RevealPassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VaultService_RevealPassword_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VaultServiceServer).RevealPassword(ctx, req.(*RevealPasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VaultService_GeneratePassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GeneratePasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VaultServiceServer).GeneratePassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VaultService_GeneratePassword_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VaultServiceServer).GeneratePassword(ctx, req.(*GeneratePasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VaultService_GetPasswordPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPasswordPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VaultServiceServer).GetPasswordPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VaultService_GetPasswordPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VaultServiceServer).GetPasswordPolicy(ctx, req.(*GetPasswordPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VaultService_SetPasswordPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPasswordPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VaultServiceServer).SetPasswordPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VaultService_SetPasswordPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VaultServiceServer).SetPasswordPolicy(ctx, req.(*SetPasswordPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VaultService_GetVaultHealthReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVaultHealthReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VaultServiceServer).GetVaultHealthReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VaultService_GetVaultHealthReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VaultServiceServer).GetVaultHealthReport(ctx, req.(*GetVaultHealthReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VaultService_CheckBreached_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckBreachedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VaultServiceServer).CheckBreached(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VaultService_CheckBreached_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VaultServiceServer).CheckBreached(ctx, req.(*CheckBreachedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VaultService_FindReusedPasswords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindReusedPasswordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VaultServiceServer).FindReusedPasswords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VaultService_FindReusedPasswords_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VaultServiceServer).FindReusedPasswords(ctx, req.(*FindReusedPasswordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VaultService_ListEntriesDueForRotation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEntriesDueForRotationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VaultServiceServer).ListEntriesDueForRotation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VaultService_ListEntriesDueForRotation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VaultServiceServer).ListEntriesDueForRotation(ctx, req.(*ListEntriesDueForRotationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VaultService_SetFolderRotation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetFolderRotationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VaultServiceServer).SetFolderRotation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VaultService_SetFolderRotation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VaultServiceServer).SetFolderRotation(ctx, req.(*SetFolderRotationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VaultService_ListFolderRotations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFolderRotationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VaultServiceServer).ListFolderRotations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VaultService_ListFolderRotations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VaultServiceServer).ListFolderRotations(ctx, req.(*ListFolderRotationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VaultService_GetTOTPCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTOTPCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VaultServiceServer).GetTOTPCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VaultService_GetTOTPCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VaultServiceServer).GetTOTPCode(ctx, req.(*GetTOTPCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VaultService_UploadAttachment_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(VaultServiceServer).UploadAttachment(&grpc.GenericServerStream[UploadAttachmentRequest, UploadAttachmentResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type VaultService_UploadAttachmentServer = grpc.ClientStreamingServer[UploadAttachmentRequest, UploadAttachmentResponse]
+
+func _VaultService_DownloadAttachment_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DownloadAttachmentRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(VaultServiceServer).DownloadAttachment(m, &grpc.GenericServerStream[DownloadAttachmentRequest, DownloadAttachmentResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type VaultService_DownloadAttachmentServer = grpc.ServerStreamingServer[DownloadAttachmentResponse]
+
+func _VaultService_ListAttachments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAttachmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VaultServiceServer).ListAttachments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VaultService_ListAttachments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VaultServiceServer).ListAttachments(ctx, req.(*ListAttachmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VaultService_DeleteAttachment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAttachmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VaultServiceServer).DeleteAttachment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VaultService_DeleteAttachment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VaultServiceServer).DeleteAttachment(ctx, req.(*DeleteAttachmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // VaultService_ServiceDesc is the grpc.ServiceDesc for VaultService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -217,6 +1295,10 @@ var VaultService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateEntry",
 			Handler:    _VaultService_CreateEntry_Handler,
 		},
+		{
+			MethodName: "UpdateEntry",
+			Handler:    _VaultService_UpdateEntry_Handler,
+		},
 		{
 			MethodName: "GetEntry",
 			Handler:    _VaultService_GetEntry_Handler,
@@ -229,7 +1311,131 @@ var VaultService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteEntry",
 			Handler:    _VaultService_DeleteEntry_Handler,
 		},
+		{
+			MethodName: "SearchEntries",
+			Handler:    _VaultService_SearchEntries_Handler,
+		},
+		{
+			MethodName: "CreateFolder",
+			Handler:    _VaultService_CreateFolder_Handler,
+		},
+		{
+			MethodName: "RenameFolder",
+			Handler:    _VaultService_RenameFolder_Handler,
+		},
+		{
+			MethodName: "MoveFolder",
+			Handler:    _VaultService_MoveFolder_Handler,
+		},
+		{
+			MethodName: "DeleteFolder",
+			Handler:    _VaultService_DeleteFolder_Handler,
+		},
+		{
+			MethodName: "ListFolders",
+			Handler:    _VaultService_ListFolders_Handler,
+		},
+		{
+			MethodName: "FindEntriesForURL",
+			Handler:    _VaultService_FindEntriesForURL_Handler,
+		},
+		{
+			MethodName: "SetFavorite",
+			Handler:    _VaultService_SetFavorite_Handler,
+		},
+		{
+			MethodName: "ListRecent",
+			Handler:    _VaultService_ListRecent_Handler,
+		},
+		{
+			MethodName: "ListTags",
+			Handler:    _VaultService_ListTags_Handler,
+		},
+		{
+			MethodName: "RenameTag",
+			Handler:    _VaultService_RenameTag_Handler,
+		},
+		{
+			MethodName: "MergeTags",
+			Handler:    _VaultService_MergeTags_Handler,
+		},
+		{
+			MethodName: "SuggestTags",
+			Handler:    _VaultService_SuggestTags_Handler,
+		},
+		{
+			MethodName: "QueryAuditLog",
+			Handler:    _VaultService_QueryAuditLog_Handler,
+		},
+		{
+			MethodName: "RevealPassword",
+			Handler:    _VaultService_RevealPassword_Handler,
+		},
+		{
+			MethodName: "GeneratePassword",
+			Handler:    _VaultService_GeneratePassword_Handler,
+		},
+		{
+			MethodName: "GetPasswordPolicy",
+			Handler:    _VaultService_GetPasswordPolicy_Handler,
+		},
+		{
+			MethodName: "SetPasswordPolicy",
+			Handler:    _VaultService_SetPasswordPolicy_Handler,
+		},
+		{
+			MethodName: "GetVaultHealthReport",
+			Handler:    _VaultService_GetVaultHealthReport_Handler,
+		},
+		{
+			MethodName: "CheckBreached",
+			Handler:    _VaultService_CheckBreached_Handler,
+		},
+		{
+			MethodName: "FindReusedPasswords",
+			Handler:    _VaultService_FindReusedPasswords_Handler,
+		},
+		{
+			MethodName: "ListEntriesDueForRotation",
+			Handler:    _VaultService_ListEntriesDueForRotation_Handler,
+		},
+		{
+			MethodName: "SetFolderRotation",
+			Handler:    _VaultService_SetFolderRotation_Handler,
+		},
+		{
+			MethodName: "ListFolderRotations",
+			Handler:    _VaultService_ListFolderRotations_Handler,
+		},
+		{
+			MethodName: "GetTOTPCode",
+			Handler:    _VaultService_GetTOTPCode_Handler,
+		},
+		{
+			MethodName: "ListAttachments",
+			Handler:    _VaultService_ListAttachments_Handler,
+		},
+		{
+			MethodName: "DeleteAttachment",
+			Handler:    _VaultService_DeleteAttachment_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamEntries",
+			Handler:       _VaultService_StreamEntries_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "UploadAttachment",
+			Handler:       _VaultService_UploadAttachment_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "DownloadAttachment",
+			Handler:       _VaultService_DownloadAttachment_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "vault.proto",
 }
@@ -21,6 +21,104 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type EmergencyAccessLevel int32
+
+const (
+	EmergencyAccessLevel_EMERGENCY_ACCESS_VIEW     EmergencyAccessLevel = 0
+	EmergencyAccessLevel_EMERGENCY_ACCESS_TAKEOVER EmergencyAccessLevel = 1
+)
+
+// Enum value maps for EmergencyAccessLevel.
+var (
+	EmergencyAccessLevel_name = map[int32]string{
+		0: "EMERGENCY_ACCESS_VIEW",
+		1: "EMERGENCY_ACCESS_TAKEOVER",
+	}
+	EmergencyAccessLevel_value = map[string]int32{
+		"EMERGENCY_ACCESS_VIEW":     0,
+		"EMERGENCY_ACCESS_TAKEOVER": 1,
+	}
+)
+
+func (x EmergencyAccessLevel) Enum() *EmergencyAccessLevel {
+	p := new(EmergencyAccessLevel)
+	*p = x
+	return p
+}
+
+func (x EmergencyAccessLevel) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EmergencyAccessLevel) Descriptor() protoreflect.EnumDescriptor {
+	return file_users_proto_enumTypes[0].Descriptor()
+}
+
+func (EmergencyAccessLevel) Type() protoreflect.EnumType {
+	return &file_users_proto_enumTypes[0]
+}
+
+func (x EmergencyAccessLevel) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EmergencyAccessLevel.Descriptor instead.
+func (EmergencyAccessLevel) EnumDescriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{0}
+}
+
+type EmergencyAccessStatus int32
+
+const (
+	EmergencyAccessStatus_EMERGENCY_STATUS_IDLE      EmergencyAccessStatus = 0
+	EmergencyAccessStatus_EMERGENCY_STATUS_REQUESTED EmergencyAccessStatus = 1
+	EmergencyAccessStatus_EMERGENCY_STATUS_APPROVED  EmergencyAccessStatus = 2
+	EmergencyAccessStatus_EMERGENCY_STATUS_REJECTED  EmergencyAccessStatus = 3
+)
+
+// Enum value maps for EmergencyAccessStatus.
+var (
+	EmergencyAccessStatus_name = map[int32]string{
+		0: "EMERGENCY_STATUS_IDLE",
+		1: "EMERGENCY_STATUS_REQUESTED",
+		2: "EMERGENCY_STATUS_APPROVED",
+		3: "EMERGENCY_STATUS_REJECTED",
+	}
+	EmergencyAccessStatus_value = map[string]int32{
+		"EMERGENCY_STATUS_IDLE":      0,
+		"EMERGENCY_STATUS_REQUESTED": 1,
+		"EMERGENCY_STATUS_APPROVED":  2,
+		"EMERGENCY_STATUS_REJECTED":  3,
+	}
+)
+
+func (x EmergencyAccessStatus) Enum() *EmergencyAccessStatus {
+	p := new(EmergencyAccessStatus)
+	*p = x
+	return p
+}
+
+func (x EmergencyAccessStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EmergencyAccessStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_users_proto_enumTypes[1].Descriptor()
+}
+
+func (EmergencyAccessStatus) Type() protoreflect.EnumType {
+	return &file_users_proto_enumTypes[1]
+}
+
+func (x EmergencyAccessStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EmergencyAccessStatus.Descriptor instead.
+func (EmergencyAccessStatus) EnumDescriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{1}
+}
+
 type VaultUser struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -90,8 +188,13 @@ func (x *VaultUser) GetPassword() string {
 }
 
 type CreateUserRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	User          *VaultUser             `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	User  *VaultUser             `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	// srp_salt and srp_verifier register the user for BeginLogin/FinishLogin,
+	// user.password must be empty then so the server never sees it. srp_salt must be exactly 16 bytes
+	// and srp_verifier a big-endian integer in (0, N) of the RFC 5054 2048-bit group
+	SrpSalt       []byte `protobuf:"bytes,2,opt,name=srp_salt,json=srpSalt,proto3" json:"srp_salt,omitempty"`
+	SrpVerifier   []byte `protobuf:"bytes,3,opt,name=srp_verifier,json=srpVerifier,proto3" json:"srp_verifier,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -133,6 +236,20 @@ func (x *CreateUserRequest) GetUser() *VaultUser {
 	return nil
 }
 
+func (x *CreateUserRequest) GetSrpSalt() []byte {
+	if x != nil {
+		return x.SrpSalt
+	}
+	return nil
+}
+
+func (x *CreateUserRequest) GetSrpVerifier() []byte {
+	if x != nil {
+		return x.SrpVerifier
+	}
+	return nil
+}
+
 type CreateUserResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -449,39 +566,924 @@ func (x *LoginResponse) GetToken() string {
 	return ""
 }
 
-var File_users_proto protoreflect.FileDescriptor
+type BeginLoginRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Username string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	// a is the client's SRP-6a public value A
+	A             []byte `protobuf:"bytes,2,opt,name=a,proto3" json:"a,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_users_proto_rawDesc = "" +
-	"\n" +
-	"\vusers.proto\x12\x05vault\"i\n" +
-	"\tVaultUser\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
-	"\x05email\x18\x02 \x01(\tR\x05email\x12\x1a\n" +
-	"\busername\x18\x03 \x01(\tR\busername\x12\x1a\n" +
-	"\bpassword\x18\x04 \x01(\tR\bpassword\"9\n" +
-	"\x11CreateUserRequest\x12$\n" +
-	"\x04user\x18\x01 \x01(\v2\x10.vault.VaultUserR\x04user\"$\n" +
-	"\x12CreateUserResponse\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\",\n" +
-	"\x0eGetUserRequest\x12\x1a\n" +
-	"\busername\x18\x01 \x01(\tR\busername\"7\n" +
-	"\x0fGetUserResponse\x12$\n" +
-	"\x04user\x18\x01 \x01(\v2\x10.vault.VaultUserR\x04user\"#\n" +
-	"\x11DeleteUserRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\".\n" +
-	"\x12DeleteUserResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"F\n" +
-	"\fLoginRequest\x12\x1a\n" +
-	"\busername\x18\x01 \x01(\tR\busername\x12\x1a\n" +
-	"\bpassword\x18\x02 \x01(\tR\bpassword\"%\n" +
-	"\rLoginResponse\x12\x14\n" +
-	"\x05token\x18\x01 \x01(\tR\x05token2\x84\x02\n" +
-	"\x10VaultUserService\x12?\n" +
-	"\bRegister\x12\x18.vault.CreateUserRequest\x1a\x19.vault.CreateUserResponse\x128\n" +
-	"\aGetUser\x12\x15.vault.GetUserRequest\x1a\x16.vault.GetUserResponse\x12A\n" +
-	"\n" +
-	"DeleteUser\x12\x18.vault.DeleteUserRequest\x1a\x19.vault.DeleteUserResponse\x122\n" +
-	"\x05Login\x12\x13.vault.LoginRequest\x1a\x14.vault.LoginResponseB;Z9github.com/AleksZelenchuk/vault-server/gen/go/vaultuserpbb\x06proto3"
+func (x *BeginLoginRequest) Reset() {
+	*x = BeginLoginRequest{}
+	mi := &file_users_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BeginLoginRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BeginLoginRequest) ProtoMessage() {}
+
+func (x *BeginLoginRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BeginLoginRequest.ProtoReflect.Descriptor instead.
+func (*BeginLoginRequest) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *BeginLoginRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *BeginLoginRequest) GetA() []byte {
+	if x != nil {
+		return x.A
+	}
+	return nil
+}
+
+type BeginLoginResponse struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	SessionId string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Salt      []byte                 `protobuf:"bytes,2,opt,name=salt,proto3" json:"salt,omitempty"`
+	// b is the server's SRP-6a public value B
+	B             []byte `protobuf:"bytes,3,opt,name=b,proto3" json:"b,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BeginLoginResponse) Reset() {
+	*x = BeginLoginResponse{}
+	mi := &file_users_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BeginLoginResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BeginLoginResponse) ProtoMessage() {}
+
+func (x *BeginLoginResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BeginLoginResponse.ProtoReflect.Descriptor instead.
+func (*BeginLoginResponse) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *BeginLoginResponse) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *BeginLoginResponse) GetSalt() []byte {
+	if x != nil {
+		return x.Salt
+	}
+	return nil
+}
+
+func (x *BeginLoginResponse) GetB() []byte {
+	if x != nil {
+		return x.B
+	}
+	return nil
+}
+
+type FinishLoginRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	SessionId string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	// m1 is the client's proof of the shared key
+	M1            []byte `protobuf:"bytes,2,opt,name=m1,proto3" json:"m1,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FinishLoginRequest) Reset() {
+	*x = FinishLoginRequest{}
+	mi := &file_users_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FinishLoginRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FinishLoginRequest) ProtoMessage() {}
+
+func (x *FinishLoginRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FinishLoginRequest.ProtoReflect.Descriptor instead.
+func (*FinishLoginRequest) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *FinishLoginRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *FinishLoginRequest) GetM1() []byte {
+	if x != nil {
+		return x.M1
+	}
+	return nil
+}
+
+type FinishLoginResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Token string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// m2 is the server's proof of the shared key, clients must verify it before trusting the token
+	M2            []byte `protobuf:"bytes,2,opt,name=m2,proto3" json:"m2,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FinishLoginResponse) Reset() {
+	*x = FinishLoginResponse{}
+	mi := &file_users_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FinishLoginResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FinishLoginResponse) ProtoMessage() {}
+
+func (x *FinishLoginResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FinishLoginResponse.ProtoReflect.Descriptor instead.
+func (*FinishLoginResponse) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *FinishLoginResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *FinishLoginResponse) GetM2() []byte {
+	if x != nil {
+		return x.M2
+	}
+	return nil
+}
+
+type GetKdfParamsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetKdfParamsRequest) Reset() {
+	*x = GetKdfParamsRequest{}
+	mi := &file_users_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetKdfParamsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetKdfParamsRequest) ProtoMessage() {}
+
+func (x *GetKdfParamsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetKdfParamsRequest.ProtoReflect.Descriptor instead.
+func (*GetKdfParamsRequest) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{13}
+}
+
+// GetKdfParamsResponse describes how clients derive their vault key from the master password
+// in zero-knowledge mode. Parameters are generated once per user and never change
+type GetKdfParamsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Algorithm     string                 `protobuf:"bytes,1,opt,name=algorithm,proto3" json:"algorithm,omitempty"`
+	Salt          []byte                 `protobuf:"bytes,2,opt,name=salt,proto3" json:"salt,omitempty"`
+	Time          uint32                 `protobuf:"varint,3,opt,name=time,proto3" json:"time,omitempty"`
+	MemoryKib     uint32                 `protobuf:"varint,4,opt,name=memory_kib,json=memoryKib,proto3" json:"memory_kib,omitempty"`
+	Threads       uint32                 `protobuf:"varint,5,opt,name=threads,proto3" json:"threads,omitempty"`
+	KeyLength     uint32                 `protobuf:"varint,6,opt,name=key_length,json=keyLength,proto3" json:"key_length,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetKdfParamsResponse) Reset() {
+	*x = GetKdfParamsResponse{}
+	mi := &file_users_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetKdfParamsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetKdfParamsResponse) ProtoMessage() {}
+
+func (x *GetKdfParamsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetKdfParamsResponse.ProtoReflect.Descriptor instead.
+func (*GetKdfParamsResponse) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetKdfParamsResponse) GetAlgorithm() string {
+	if x != nil {
+		return x.Algorithm
+	}
+	return ""
+}
+
+func (x *GetKdfParamsResponse) GetSalt() []byte {
+	if x != nil {
+		return x.Salt
+	}
+	return nil
+}
+
+func (x *GetKdfParamsResponse) GetTime() uint32 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+func (x *GetKdfParamsResponse) GetMemoryKib() uint32 {
+	if x != nil {
+		return x.MemoryKib
+	}
+	return 0
+}
+
+func (x *GetKdfParamsResponse) GetThreads() uint32 {
+	if x != nil {
+		return x.Threads
+	}
+	return 0
+}
+
+func (x *GetKdfParamsResponse) GetKeyLength() uint32 {
+	if x != nil {
+		return x.KeyLength
+	}
+	return 0
+}
+
+type EmergencyContact struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	GrantorId         string                 `protobuf:"bytes,2,opt,name=grantor_id,json=grantorId,proto3" json:"grantor_id,omitempty"`
+	GranteeId         string                 `protobuf:"bytes,3,opt,name=grantee_id,json=granteeId,proto3" json:"grantee_id,omitempty"`
+	AccessLevel       EmergencyAccessLevel   `protobuf:"varint,4,opt,name=access_level,json=accessLevel,proto3,enum=vault.EmergencyAccessLevel" json:"access_level,omitempty"`
+	WaitPeriodSeconds int64                  `protobuf:"varint,5,opt,name=wait_period_seconds,json=waitPeriodSeconds,proto3" json:"wait_period_seconds,omitempty"`
+	Status            EmergencyAccessStatus  `protobuf:"varint,6,opt,name=status,proto3,enum=vault.EmergencyAccessStatus" json:"status,omitempty"`
+	RequestedAt       int64                  `protobuf:"varint,7,opt,name=requested_at,json=requestedAt,proto3" json:"requested_at,omitempty"`
+	// access_available_at is the unix time after which a pending request is granted automatically
+	AccessAvailableAt int64 `protobuf:"varint,8,opt,name=access_available_at,json=accessAvailableAt,proto3" json:"access_available_at,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *EmergencyContact) Reset() {
+	*x = EmergencyContact{}
+	mi := &file_users_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EmergencyContact) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmergencyContact) ProtoMessage() {}
+
+func (x *EmergencyContact) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmergencyContact.ProtoReflect.Descriptor instead.
+func (*EmergencyContact) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *EmergencyContact) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *EmergencyContact) GetGrantorId() string {
+	if x != nil {
+		return x.GrantorId
+	}
+	return ""
+}
+
+func (x *EmergencyContact) GetGranteeId() string {
+	if x != nil {
+		return x.GranteeId
+	}
+	return ""
+}
+
+func (x *EmergencyContact) GetAccessLevel() EmergencyAccessLevel {
+	if x != nil {
+		return x.AccessLevel
+	}
+	return EmergencyAccessLevel_EMERGENCY_ACCESS_VIEW
+}
+
+func (x *EmergencyContact) GetWaitPeriodSeconds() int64 {
+	if x != nil {
+		return x.WaitPeriodSeconds
+	}
+	return 0
+}
+
+func (x *EmergencyContact) GetStatus() EmergencyAccessStatus {
+	if x != nil {
+		return x.Status
+	}
+	return EmergencyAccessStatus_EMERGENCY_STATUS_IDLE
+}
+
+func (x *EmergencyContact) GetRequestedAt() int64 {
+	if x != nil {
+		return x.RequestedAt
+	}
+	return 0
+}
+
+func (x *EmergencyContact) GetAccessAvailableAt() int64 {
+	if x != nil {
+		return x.AccessAvailableAt
+	}
+	return 0
+}
+
+type AddEmergencyContactRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	GranteeUsername   string                 `protobuf:"bytes,1,opt,name=grantee_username,json=granteeUsername,proto3" json:"grantee_username,omitempty"`
+	AccessLevel       EmergencyAccessLevel   `protobuf:"varint,2,opt,name=access_level,json=accessLevel,proto3,enum=vault.EmergencyAccessLevel" json:"access_level,omitempty"`
+	WaitPeriodSeconds int64                  `protobuf:"varint,3,opt,name=wait_period_seconds,json=waitPeriodSeconds,proto3" json:"wait_period_seconds,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *AddEmergencyContactRequest) Reset() {
+	*x = AddEmergencyContactRequest{}
+	mi := &file_users_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddEmergencyContactRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddEmergencyContactRequest) ProtoMessage() {}
+
+func (x *AddEmergencyContactRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddEmergencyContactRequest.ProtoReflect.Descriptor instead.
+func (*AddEmergencyContactRequest) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *AddEmergencyContactRequest) GetGranteeUsername() string {
+	if x != nil {
+		return x.GranteeUsername
+	}
+	return ""
+}
+
+func (x *AddEmergencyContactRequest) GetAccessLevel() EmergencyAccessLevel {
+	if x != nil {
+		return x.AccessLevel
+	}
+	return EmergencyAccessLevel_EMERGENCY_ACCESS_VIEW
+}
+
+func (x *AddEmergencyContactRequest) GetWaitPeriodSeconds() int64 {
+	if x != nil {
+		return x.WaitPeriodSeconds
+	}
+	return 0
+}
+
+type AddEmergencyContactResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Contact       *EmergencyContact      `protobuf:"bytes,1,opt,name=contact,proto3" json:"contact,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddEmergencyContactResponse) Reset() {
+	*x = AddEmergencyContactResponse{}
+	mi := &file_users_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddEmergencyContactResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddEmergencyContactResponse) ProtoMessage() {}
+
+func (x *AddEmergencyContactResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddEmergencyContactResponse.ProtoReflect.Descriptor instead.
+func (*AddEmergencyContactResponse) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *AddEmergencyContactResponse) GetContact() *EmergencyContact {
+	if x != nil {
+		return x.Contact
+	}
+	return nil
+}
+
+type ListEmergencyContactsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEmergencyContactsRequest) Reset() {
+	*x = ListEmergencyContactsRequest{}
+	mi := &file_users_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEmergencyContactsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEmergencyContactsRequest) ProtoMessage() {}
+
+func (x *ListEmergencyContactsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEmergencyContactsRequest.ProtoReflect.Descriptor instead.
+func (*ListEmergencyContactsRequest) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{18}
+}
+
+type ListEmergencyContactsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GrantedByMe   []*EmergencyContact    `protobuf:"bytes,1,rep,name=granted_by_me,json=grantedByMe,proto3" json:"granted_by_me,omitempty"`
+	GrantedToMe   []*EmergencyContact    `protobuf:"bytes,2,rep,name=granted_to_me,json=grantedToMe,proto3" json:"granted_to_me,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEmergencyContactsResponse) Reset() {
+	*x = ListEmergencyContactsResponse{}
+	mi := &file_users_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEmergencyContactsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEmergencyContactsResponse) ProtoMessage() {}
+
+func (x *ListEmergencyContactsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEmergencyContactsResponse.ProtoReflect.Descriptor instead.
+func (*ListEmergencyContactsResponse) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ListEmergencyContactsResponse) GetGrantedByMe() []*EmergencyContact {
+	if x != nil {
+		return x.GrantedByMe
+	}
+	return nil
+}
+
+func (x *ListEmergencyContactsResponse) GetGrantedToMe() []*EmergencyContact {
+	if x != nil {
+		return x.GrantedToMe
+	}
+	return nil
+}
+
+type RemoveEmergencyContactRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveEmergencyContactRequest) Reset() {
+	*x = RemoveEmergencyContactRequest{}
+	mi := &file_users_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveEmergencyContactRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveEmergencyContactRequest) ProtoMessage() {}
+
+func (x *RemoveEmergencyContactRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveEmergencyContactRequest.ProtoReflect.Descriptor instead.
+func (*RemoveEmergencyContactRequest) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *RemoveEmergencyContactRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type RemoveEmergencyContactResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveEmergencyContactResponse) Reset() {
+	*x = RemoveEmergencyContactResponse{}
+	mi := &file_users_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveEmergencyContactResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveEmergencyContactResponse) ProtoMessage() {}
+
+func (x *RemoveEmergencyContactResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveEmergencyContactResponse.ProtoReflect.Descriptor instead.
+func (*RemoveEmergencyContactResponse) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *RemoveEmergencyContactResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type EmergencyAccessRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EmergencyAccessRequest) Reset() {
+	*x = EmergencyAccessRequest{}
+	mi := &file_users_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EmergencyAccessRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmergencyAccessRequest) ProtoMessage() {}
+
+func (x *EmergencyAccessRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmergencyAccessRequest.ProtoReflect.Descriptor instead.
+func (*EmergencyAccessRequest) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *EmergencyAccessRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type EmergencyAccessResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Contact       *EmergencyContact      `protobuf:"bytes,1,opt,name=contact,proto3" json:"contact,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EmergencyAccessResponse) Reset() {
+	*x = EmergencyAccessResponse{}
+	mi := &file_users_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EmergencyAccessResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmergencyAccessResponse) ProtoMessage() {}
+
+func (x *EmergencyAccessResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmergencyAccessResponse.ProtoReflect.Descriptor instead.
+func (*EmergencyAccessResponse) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *EmergencyAccessResponse) GetContact() *EmergencyContact {
+	if x != nil {
+		return x.Contact
+	}
+	return nil
+}
+
+var File_users_proto protoreflect.FileDescriptor
+
+const file_users_proto_rawDesc = "" +
+	"\n" +
+	"\vusers.proto\x12\x05vault\"i\n" +
+	"\tVaultUser\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12\x1a\n" +
+	"\busername\x18\x03 \x01(\tR\busername\x12\x1a\n" +
+	"\bpassword\x18\x04 \x01(\tR\bpassword\"w\n" +
+	"\x11CreateUserRequest\x12$\n" +
+	"\x04user\x18\x01 \x01(\v2\x10.vault.VaultUserR\x04user\x12\x19\n" +
+	"\bsrp_salt\x18\x02 \x01(\fR\asrpSalt\x12!\n" +
+	"\fsrp_verifier\x18\x03 \x01(\fR\vsrpVerifier\"$\n" +
+	"\x12CreateUserResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\",\n" +
+	"\x0eGetUserRequest\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\"7\n" +
+	"\x0fGetUserResponse\x12$\n" +
+	"\x04user\x18\x01 \x01(\v2\x10.vault.VaultUserR\x04user\"#\n" +
+	"\x11DeleteUserRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\".\n" +
+	"\x12DeleteUserResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"F\n" +
+	"\fLoginRequest\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\"%\n" +
+	"\rLoginResponse\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"=\n" +
+	"\x11BeginLoginRequest\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\x12\f\n" +
+	"\x01a\x18\x02 \x01(\fR\x01a\"U\n" +
+	"\x12BeginLoginResponse\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x12\n" +
+	"\x04salt\x18\x02 \x01(\fR\x04salt\x12\f\n" +
+	"\x01b\x18\x03 \x01(\fR\x01b\"C\n" +
+	"\x12FinishLoginRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x0e\n" +
+	"\x02m1\x18\x02 \x01(\fR\x02m1\";\n" +
+	"\x13FinishLoginResponse\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x0e\n" +
+	"\x02m2\x18\x02 \x01(\fR\x02m2\"\x15\n" +
+	"\x13GetKdfParamsRequest\"\xb4\x01\n" +
+	"\x14GetKdfParamsResponse\x12\x1c\n" +
+	"\talgorithm\x18\x01 \x01(\tR\talgorithm\x12\x12\n" +
+	"\x04salt\x18\x02 \x01(\fR\x04salt\x12\x12\n" +
+	"\x04time\x18\x03 \x01(\rR\x04time\x12\x1d\n" +
+	"\n" +
+	"memory_kib\x18\x04 \x01(\rR\tmemoryKib\x12\x18\n" +
+	"\athreads\x18\x05 \x01(\rR\athreads\x12\x1d\n" +
+	"\n" +
+	"key_length\x18\x06 \x01(\rR\tkeyLength\"\xd9\x02\n" +
+	"\x10EmergencyContact\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"grantor_id\x18\x02 \x01(\tR\tgrantorId\x12\x1d\n" +
+	"\n" +
+	"grantee_id\x18\x03 \x01(\tR\tgranteeId\x12>\n" +
+	"\faccess_level\x18\x04 \x01(\x0e2\x1b.vault.EmergencyAccessLevelR\vaccessLevel\x12.\n" +
+	"\x13wait_period_seconds\x18\x05 \x01(\x03R\x11waitPeriodSeconds\x124\n" +
+	"\x06status\x18\x06 \x01(\x0e2\x1c.vault.EmergencyAccessStatusR\x06status\x12!\n" +
+	"\frequested_at\x18\a \x01(\x03R\vrequestedAt\x12.\n" +
+	"\x13access_available_at\x18\b \x01(\x03R\x11accessAvailableAt\"\xb7\x01\n" +
+	"\x1aAddEmergencyContactRequest\x12)\n" +
+	"\x10grantee_username\x18\x01 \x01(\tR\x0fgranteeUsername\x12>\n" +
+	"\faccess_level\x18\x02 \x01(\x0e2\x1b.vault.EmergencyAccessLevelR\vaccessLevel\x12.\n" +
+	"\x13wait_period_seconds\x18\x03 \x01(\x03R\x11waitPeriodSeconds\"P\n" +
+	"\x1bAddEmergencyContactResponse\x121\n" +
+	"\acontact\x18\x01 \x01(\v2\x17.vault.EmergencyContactR\acontact\"\x1e\n" +
+	"\x1cListEmergencyContactsRequest\"\x99\x01\n" +
+	"\x1dListEmergencyContactsResponse\x12;\n" +
+	"\rgranted_by_me\x18\x01 \x03(\v2\x17.vault.EmergencyContactR\vgrantedByMe\x12;\n" +
+	"\rgranted_to_me\x18\x02 \x03(\v2\x17.vault.EmergencyContactR\vgrantedToMe\"/\n" +
+	"\x1dRemoveEmergencyContactRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\":\n" +
+	"\x1eRemoveEmergencyContactResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"(\n" +
+	"\x16EmergencyAccessRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"L\n" +
+	"\x17EmergencyAccessResponse\x121\n" +
+	"\acontact\x18\x01 \x01(\v2\x17.vault.EmergencyContactR\acontact*P\n" +
+	"\x14EmergencyAccessLevel\x12\x19\n" +
+	"\x15EMERGENCY_ACCESS_VIEW\x10\x00\x12\x1d\n" +
+	"\x19EMERGENCY_ACCESS_TAKEOVER\x10\x01*\x90\x01\n" +
+	"\x15EmergencyAccessStatus\x12\x19\n" +
+	"\x15EMERGENCY_STATUS_IDLE\x10\x00\x12\x1e\n" +
+	"\x1aEMERGENCY_STATUS_REQUESTED\x10\x01\x12\x1d\n" +
+	"\x19EMERGENCY_STATUS_APPROVED\x10\x02\x12\x1d\n" +
+	"\x19EMERGENCY_STATUS_REJECTED\x10\x032\x89\b\n" +
+	"\x10VaultUserService\x12?\n" +
+	"\bRegister\x12\x18.vault.CreateUserRequest\x1a\x19.vault.CreateUserResponse\x128\n" +
+	"\aGetUser\x12\x15.vault.GetUserRequest\x1a\x16.vault.GetUserResponse\x12A\n" +
+	"\n" +
+	"DeleteUser\x12\x18.vault.DeleteUserRequest\x1a\x19.vault.DeleteUserResponse\x122\n" +
+	"\x05Login\x12\x13.vault.LoginRequest\x1a\x14.vault.LoginResponse\x12A\n" +
+	"\n" +
+	"BeginLogin\x12\x18.vault.BeginLoginRequest\x1a\x19.vault.BeginLoginResponse\x12D\n" +
+	"\vFinishLogin\x12\x19.vault.FinishLoginRequest\x1a\x1a.vault.FinishLoginResponse\x12G\n" +
+	"\fGetKdfParams\x12\x1a.vault.GetKdfParamsRequest\x1a\x1b.vault.GetKdfParamsResponse\x12\\\n" +
+	"\x13AddEmergencyContact\x12!.vault.AddEmergencyContactRequest\x1a\".vault.AddEmergencyContactResponse\x12b\n" +
+	"\x15ListEmergencyContacts\x12#.vault.ListEmergencyContactsRequest\x1a$.vault.ListEmergencyContactsResponse\x12e\n" +
+	"\x16RemoveEmergencyContact\x12$.vault.RemoveEmergencyContactRequest\x1a%.vault.RemoveEmergencyContactResponse\x12W\n" +
+	"\x16RequestEmergencyAccess\x12\x1d.vault.EmergencyAccessRequest\x1a\x1e.vault.EmergencyAccessResponse\x12W\n" +
+	"\x16ApproveEmergencyAccess\x12\x1d.vault.EmergencyAccessRequest\x1a\x1e.vault.EmergencyAccessResponse\x12V\n" +
+	"\x15RejectEmergencyAccess\x12\x1d.vault.EmergencyAccessRequest\x1a\x1e.vault.EmergencyAccessResponseB;Z9github.com/AleksZelenchuk/vault-server/gen/go/vaultuserpbb\x06proto3"
 
 var (
 	file_users_proto_rawDescOnce sync.Once
@@ -495,34 +1497,77 @@ func file_users_proto_rawDescGZIP() []byte {
 	return file_users_proto_rawDescData
 }
 
-var file_users_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_users_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_users_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
 var file_users_proto_goTypes = []any{
-	(*VaultUser)(nil),          // 0: vault.VaultUser
-	(*CreateUserRequest)(nil),  // 1: vault.CreateUserRequest
-	(*CreateUserResponse)(nil), // 2: vault.CreateUserResponse
-	(*GetUserRequest)(nil),     // 3: vault.GetUserRequest
-	(*GetUserResponse)(nil),    // 4: vault.GetUserResponse
-	(*DeleteUserRequest)(nil),  // 5: vault.DeleteUserRequest
-	(*DeleteUserResponse)(nil), // 6: vault.DeleteUserResponse
-	(*LoginRequest)(nil),       // 7: vault.LoginRequest
-	(*LoginResponse)(nil),      // 8: vault.LoginResponse
+	(EmergencyAccessLevel)(0),              // 0: vault.EmergencyAccessLevel
+	(EmergencyAccessStatus)(0),             // 1: vault.EmergencyAccessStatus
+	(*VaultUser)(nil),                      // 2: vault.VaultUser
+	(*CreateUserRequest)(nil),              // 3: vault.CreateUserRequest
+	(*CreateUserResponse)(nil),             // 4: vault.CreateUserResponse
+	(*GetUserRequest)(nil),                 // 5: vault.GetUserRequest
+	(*GetUserResponse)(nil),                // 6: vault.GetUserResponse
+	(*DeleteUserRequest)(nil),              // 7: vault.DeleteUserRequest
+	(*DeleteUserResponse)(nil),             // 8: vault.DeleteUserResponse
+	(*LoginRequest)(nil),                   // 9: vault.LoginRequest
+	(*LoginResponse)(nil),                  // 10: vault.LoginResponse
+	(*BeginLoginRequest)(nil),              // 11: vault.BeginLoginRequest
+	(*BeginLoginResponse)(nil),             // 12: vault.BeginLoginResponse
+	(*FinishLoginRequest)(nil),             // 13: vault.FinishLoginRequest
+	(*FinishLoginResponse)(nil),            // 14: vault.FinishLoginResponse
+	(*GetKdfParamsRequest)(nil),            // 15: vault.GetKdfParamsRequest
+	(*GetKdfParamsResponse)(nil),           // 16: vault.GetKdfParamsResponse
+	(*EmergencyContact)(nil),               // 17: vault.EmergencyContact
+	(*AddEmergencyContactRequest)(nil),     // 18: vault.AddEmergencyContactRequest
+	(*AddEmergencyContactResponse)(nil),    // 19: vault.AddEmergencyContactResponse
+	(*ListEmergencyContactsRequest)(nil),   // 20: vault.ListEmergencyContactsRequest
+	(*ListEmergencyContactsResponse)(nil),  // 21: vault.ListEmergencyContactsResponse
+	(*RemoveEmergencyContactRequest)(nil),  // 22: vault.RemoveEmergencyContactRequest
+	(*RemoveEmergencyContactResponse)(nil), // 23: vault.RemoveEmergencyContactResponse
+	(*EmergencyAccessRequest)(nil),         // 24: vault.EmergencyAccessRequest
+	(*EmergencyAccessResponse)(nil),        // 25: vault.EmergencyAccessResponse
 }
 var file_users_proto_depIdxs = []int32{
-	0, // 0: vault.CreateUserRequest.user:type_name -> vault.VaultUser
-	0, // 1: vault.GetUserResponse.user:type_name -> vault.VaultUser
-	1, // 2: vault.VaultUserService.Register:input_type -> vault.CreateUserRequest
-	3, // 3: vault.VaultUserService.GetUser:input_type -> vault.GetUserRequest
-	5, // 4: vault.VaultUserService.DeleteUser:input_type -> vault.DeleteUserRequest
-	7, // 5: vault.VaultUserService.Login:input_type -> vault.LoginRequest
-	2, // 6: vault.VaultUserService.Register:output_type -> vault.CreateUserResponse
-	4, // 7: vault.VaultUserService.GetUser:output_type -> vault.GetUserResponse
-	6, // 8: vault.VaultUserService.DeleteUser:output_type -> vault.DeleteUserResponse
-	8, // 9: vault.VaultUserService.Login:output_type -> vault.LoginResponse
-	6, // [6:10] is the sub-list for method output_type
-	2, // [2:6] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	2,  // 0: vault.CreateUserRequest.user:type_name -> vault.VaultUser
+	2,  // 1: vault.GetUserResponse.user:type_name -> vault.VaultUser
+	0,  // 2: vault.EmergencyContact.access_level:type_name -> vault.EmergencyAccessLevel
+	1,  // 3: vault.EmergencyContact.status:type_name -> vault.EmergencyAccessStatus
+	0,  // 4: vault.AddEmergencyContactRequest.access_level:type_name -> vault.EmergencyAccessLevel
+	17, // 5: vault.AddEmergencyContactResponse.contact:type_name -> vault.EmergencyContact
+	17, // 6: vault.ListEmergencyContactsResponse.granted_by_me:type_name -> vault.EmergencyContact
+	17, // 7: vault.ListEmergencyContactsResponse.granted_to_me:type_name -> vault.EmergencyContact
+	17, // 8: vault.EmergencyAccessResponse.contact:type_name -> vault.EmergencyContact
+	3,  // 9: vault.VaultUserService.Register:input_type -> vault.CreateUserRequest
+	5,  // 10: vault.VaultUserService.GetUser:input_type -> vault.GetUserRequest
+	7,  // 11: vault.VaultUserService.DeleteUser:input_type -> vault.DeleteUserRequest
+	9,  // 12: vault.VaultUserService.Login:input_type -> vault.LoginRequest
+	11, // 13: vault.VaultUserService.BeginLogin:input_type -> vault.BeginLoginRequest
+	13, // 14: vault.VaultUserService.FinishLogin:input_type -> vault.FinishLoginRequest
+	15, // 15: vault.VaultUserService.GetKdfParams:input_type -> vault.GetKdfParamsRequest
+	18, // 16: vault.VaultUserService.AddEmergencyContact:input_type -> vault.AddEmergencyContactRequest
+	20, // 17: vault.VaultUserService.ListEmergencyContacts:input_type -> vault.ListEmergencyContactsRequest
+	22, // 18: vault.VaultUserService.RemoveEmergencyContact:input_type -> vault.RemoveEmergencyContactRequest
+	24, // 19: vault.VaultUserService.RequestEmergencyAccess:input_type -> vault.EmergencyAccessRequest
+	24, // 20: vault.VaultUserService.ApproveEmergencyAccess:input_type -> vault.EmergencyAccessRequest
+	24, // 21: vault.VaultUserService.RejectEmergencyAccess:input_type -> vault.EmergencyAccessRequest
+	4,  // 22: vault.VaultUserService.Register:output_type -> vault.CreateUserResponse
+	6,  // 23: vault.VaultUserService.GetUser:output_type -> vault.GetUserResponse
+	8,  // 24: vault.VaultUserService.DeleteUser:output_type -> vault.DeleteUserResponse
+	10, // 25: vault.VaultUserService.Login:output_type -> vault.LoginResponse
+	12, // 26: vault.VaultUserService.BeginLogin:output_type -> vault.BeginLoginResponse
+	14, // 27: vault.VaultUserService.FinishLogin:output_type -> vault.FinishLoginResponse
+	16, // 28: vault.VaultUserService.GetKdfParams:output_type -> vault.GetKdfParamsResponse
+	19, // 29: vault.VaultUserService.AddEmergencyContact:output_type -> vault.AddEmergencyContactResponse
+	21, // 30: vault.VaultUserService.ListEmergencyContacts:output_type -> vault.ListEmergencyContactsResponse
+	23, // 31: vault.VaultUserService.RemoveEmergencyContact:output_type -> vault.RemoveEmergencyContactResponse
+	25, // 32: vault.VaultUserService.RequestEmergencyAccess:output_type -> vault.EmergencyAccessResponse
+	25, // 33: vault.VaultUserService.ApproveEmergencyAccess:output_type -> vault.EmergencyAccessResponse
+	25, // 34: vault.VaultUserService.RejectEmergencyAccess:output_type -> vault.EmergencyAccessResponse
+	22, // [22:35] is the sub-list for method output_type
+	9,  // [9:22] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_users_proto_init() }
@@ -535,13 +1580,14 @@ func file_users_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_users_proto_rawDesc), len(file_users_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   9,
+			NumEnums:      2,
+			NumMessages:   24,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_users_proto_goTypes,
 		DependencyIndexes: file_users_proto_depIdxs,
+		EnumInfos:         file_users_proto_enumTypes,
 		MessageInfos:      file_users_proto_msgTypes,
 	}.Build()
 	File_users_proto = out.File
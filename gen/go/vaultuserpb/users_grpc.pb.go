@@ -19,10 +19,19 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	VaultUserService_Register_FullMethodName   = "/vault.VaultUserService/Register"
-	VaultUserService_GetUser_FullMethodName    = "/vault.VaultUserService/GetUser"
-	VaultUserService_DeleteUser_FullMethodName = "/vault.VaultUserService/DeleteUser"
-	VaultUserService_Login_FullMethodName      = "/vault.VaultUserService/Login"
+	VaultUserService_Register_FullMethodName               = "/vault.VaultUserService/Register"
+	VaultUserService_GetUser_FullMethodName                = "/vault.VaultUserService/GetUser"
+	VaultUserService_DeleteUser_FullMethodName             = "/vault.VaultUserService/DeleteUser"
+	VaultUserService_Login_FullMethodName                  = "/vault.VaultUserService/Login"
+	VaultUserService_BeginLogin_FullMethodName             = "/vault.VaultUserService/BeginLogin"
+	VaultUserService_FinishLogin_FullMethodName            = "/vault.VaultUserService/FinishLogin"
+	VaultUserService_GetKdfParams_FullMethodName           = "/vault.VaultUserService/GetKdfParams"
+	VaultUserService_AddEmergencyContact_FullMethodName    = "/vault.VaultUserService/AddEmergencyContact"
+	VaultUserService_ListEmergencyContacts_FullMethodName  = "/vault.VaultUserService/ListEmergencyContacts"
+	VaultUserService_RemoveEmergencyContact_FullMethodName = "/vault.VaultUserService/RemoveEmergencyContact"
+	VaultUserService_RequestEmergencyAccess_FullMethodName = "/vault.VaultUserService/RequestEmergencyAccess"
+	VaultUserService_ApproveEmergencyAccess_FullMethodName = "/vault.VaultUserService/ApproveEmergencyAccess"
+	VaultUserService_RejectEmergencyAccess_FullMethodName  = "/vault.VaultUserService/RejectEmergencyAccess"
 )
 
 // VaultUserServiceClient is the client API for VaultUserService service.
@@ -33,6 +42,18 @@ type VaultUserServiceClient interface {
 	GetUser(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*GetUserResponse, error)
 	DeleteUser(ctx context.Context, in *DeleteUserRequest, opts ...grpc.CallOption) (*DeleteUserResponse, error)
 	Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginResponse, error)
+	// SRP-6a login in two rounds, the password never leaves the client
+	BeginLogin(ctx context.Context, in *BeginLoginRequest, opts ...grpc.CallOption) (*BeginLoginResponse, error)
+	FinishLogin(ctx context.Context, in *FinishLoginRequest, opts ...grpc.CallOption) (*FinishLoginResponse, error)
+	GetKdfParams(ctx context.Context, in *GetKdfParamsRequest, opts ...grpc.CallOption) (*GetKdfParamsResponse, error)
+	// Emergency access: grantor manages contacts, grantee requests access,
+	// grantor may approve or reject before the wait period expires
+	AddEmergencyContact(ctx context.Context, in *AddEmergencyContactRequest, opts ...grpc.CallOption) (*AddEmergencyContactResponse, error)
+	ListEmergencyContacts(ctx context.Context, in *ListEmergencyContactsRequest, opts ...grpc.CallOption) (*ListEmergencyContactsResponse, error)
+	RemoveEmergencyContact(ctx context.Context, in *RemoveEmergencyContactRequest, opts ...grpc.CallOption) (*RemoveEmergencyContactResponse, error)
+	RequestEmergencyAccess(ctx context.Context, in *EmergencyAccessRequest, opts ...grpc.CallOption) (*EmergencyAccessResponse, error)
+	ApproveEmergencyAccess(ctx context.Context, in *EmergencyAccessRequest, opts ...grpc.CallOption) (*EmergencyAccessResponse, error)
+	RejectEmergencyAccess(ctx context.Context, in *EmergencyAccessRequest, opts ...grpc.CallOption) (*EmergencyAccessResponse, error)
 }
 
 type vaultUserServiceClient struct {
@@ -83,6 +104,96 @@ func (c *vaultUserServiceClient) Login(ctx context.Context, in *LoginRequest, op
 	return out, nil
 }
 
+func (c *vaultUserServiceClient) BeginLogin(ctx context.Context, in *BeginLoginRequest, opts ...grpc.CallOption) (*BeginLoginResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BeginLoginResponse)
+	err := c.cc.Invoke(ctx, VaultUserService_BeginLogin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vaultUserServiceClient) FinishLogin(ctx context.Context, in *FinishLoginRequest, opts ...grpc.CallOption) (*FinishLoginResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FinishLoginResponse)
+	err := c.cc.Invoke(ctx, VaultUserService_FinishLogin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vaultUserServiceClient) GetKdfParams(ctx context.Context, in *GetKdfParamsRequest, opts ...grpc.CallOption) (*GetKdfParamsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetKdfParamsResponse)
+	err := c.cc.Invoke(ctx, VaultUserService_GetKdfParams_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vaultUserServiceClient) AddEmergencyContact(ctx context.Context, in *AddEmergencyContactRequest, opts ...grpc.CallOption) (*AddEmergencyContactResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddEmergencyContactResponse)
+	err := c.cc.Invoke(ctx, VaultUserService_AddEmergencyContact_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vaultUserServiceClient) ListEmergencyContacts(ctx context.Context, in *ListEmergencyContactsRequest, opts ...grpc.CallOption) (*ListEmergencyContactsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListEmergencyContactsResponse)
+	err := c.cc.Invoke(ctx, VaultUserService_ListEmergencyContacts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vaultUserServiceClient) RemoveEmergencyContact(ctx context.Context, in *RemoveEmergencyContactRequest, opts ...grpc.CallOption) (*RemoveEmergencyContactResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveEmergencyContactResponse)
+	err := c.cc.Invoke(ctx, VaultUserService_RemoveEmergencyContact_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vaultUserServiceClient) RequestEmergencyAccess(ctx context.Context, in *EmergencyAccessRequest, opts ...grpc.CallOption) (*EmergencyAccessResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EmergencyAccessResponse)
+	err := c.cc.Invoke(ctx, VaultUserService_RequestEmergencyAccess_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vaultUserServiceClient) ApproveEmergencyAccess(ctx context.Context, in *EmergencyAccessRequest, opts ...grpc.CallOption) (*EmergencyAccessResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EmergencyAccessResponse)
+	err := c.cc.Invoke(ctx, VaultUserService_ApproveEmergencyAccess_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vaultUserServiceClient) RejectEmergencyAccess(ctx context.Context, in *EmergencyAccessRequest, opts ...grpc.CallOption) (*EmergencyAccessResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EmergencyAccessResponse)
+	err := c.cc.Invoke(ctx, VaultUserService_RejectEmergencyAccess_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // VaultUserServiceServer is the server API for VaultUserService service.
 // All implementations must embed UnimplementedVaultUserServiceServer
 // for forward compatibility.
@@ -91,6 +202,18 @@ type VaultUserServiceServer interface {
 	GetUser(context.Context, *GetUserRequest) (*GetUserResponse, error)
 	DeleteUser(context.Context, *DeleteUserRequest) (*DeleteUserResponse, error)
 	Login(context.Context, *LoginRequest) (*LoginResponse, error)
+	// SRP-6a login in two rounds, the password never leaves the client
+	BeginLogin(context.Context, *BeginLoginRequest) (*BeginLoginResponse, error)
+	FinishLogin(context.Context, *FinishLoginRequest) (*FinishLoginResponse, error)
+	GetKdfParams(context.Context, *GetKdfParamsRequest) (*GetKdfParamsResponse, error)
+	// Emergency access: grantor manages contacts, grantee requests access,
+	// grantor may approve or reject before the wait period expires
+	AddEmergencyContact(context.Context, *AddEmergencyContactRequest) (*AddEmergencyContactResponse, error)
+	ListEmergencyContacts(context.Context, *ListEmergencyContactsRequest) (*ListEmergencyContactsResponse, error)
+	RemoveEmergencyContact(context.Context, *RemoveEmergencyContactRequest) (*RemoveEmergencyContactResponse, error)
+	RequestEmergencyAccess(context.Context, *EmergencyAccessRequest) (*EmergencyAccessResponse, error)
+	ApproveEmergencyAccess(context.Context, *EmergencyAccessRequest) (*EmergencyAccessResponse, error)
+	RejectEmergencyAccess(context.Context, *EmergencyAccessRequest) (*EmergencyAccessResponse, error)
 	mustEmbedUnimplementedVaultUserServiceServer()
 }
 
@@ -113,6 +236,33 @@ func (UnimplementedVaultUserServiceServer) DeleteUser(context.Context, *DeleteUs
 func (UnimplementedVaultUserServiceServer) Login(context.Context, *LoginRequest) (*LoginResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Login not implemented")
 }
+func (UnimplementedVaultUserServiceServer) BeginLogin(context.Context, *BeginLoginRequest) (*BeginLoginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BeginLogin not implemented")
+}
+func (UnimplementedVaultUserServiceServer) FinishLogin(context.Context, *FinishLoginRequest) (*FinishLoginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinishLogin not implemented")
+}
+func (UnimplementedVaultUserServiceServer) GetKdfParams(context.Context, *GetKdfParamsRequest) (*GetKdfParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetKdfParams not implemented")
+}
+func (UnimplementedVaultUserServiceServer) AddEmergencyContact(context.Context, *AddEmergencyContactRequest) (*AddEmergencyContactResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddEmergencyContact not implemented")
+}
+func (UnimplementedVaultUserServiceServer) ListEmergencyContacts(context.Context, *ListEmergencyContactsRequest) (*ListEmergencyContactsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEmergencyContacts not implemented")
+}
+func (UnimplementedVaultUserServiceServer) RemoveEmergencyContact(context.Context, *RemoveEmergencyContactRequest) (*RemoveEmergencyContactResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveEmergencyContact not implemented")
+}
+func (UnimplementedVaultUserServiceServer) RequestEmergencyAccess(context.Context, *EmergencyAccessRequest) (*EmergencyAccessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestEmergencyAccess not implemented")
+}
+func (UnimplementedVaultUserServiceServer) ApproveEmergencyAccess(context.Context, *EmergencyAccessRequest) (*EmergencyAccessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveEmergencyAccess not implemented")
+}
+func (UnimplementedVaultUserServiceServer) RejectEmergencyAccess(context.Context, *EmergencyAccessRequest) (*EmergencyAccessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectEmergencyAccess not implemented")
+}
 func (UnimplementedVaultUserServiceServer) mustEmbedUnimplementedVaultUserServiceServer() {}
 func (UnimplementedVaultUserServiceServer) testEmbeddedByValue()                          {}
 
@@ -206,6 +356,168 @@ func _VaultUserService_Login_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _VaultUserService_BeginLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BeginLoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VaultUserServiceServer).BeginLogin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VaultUserService_BeginLogin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VaultUserServiceServer).BeginLogin(ctx, req.(*BeginLoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VaultUserService_FinishLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FinishLoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VaultUserServiceServer).FinishLogin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VaultUserService_FinishLogin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VaultUserServiceServer).FinishLogin(ctx, req.(*FinishLoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VaultUserService_GetKdfParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetKdfParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VaultUserServiceServer).GetKdfParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VaultUserService_GetKdfParams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VaultUserServiceServer).GetKdfParams(ctx, req.(*GetKdfParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VaultUserService_AddEmergencyContact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddEmergencyContactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VaultUserServiceServer).AddEmergencyContact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VaultUserService_AddEmergencyContact_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VaultUserServiceServer).AddEmergencyContact(ctx, req.(*AddEmergencyContactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VaultUserService_ListEmergencyContacts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEmergencyContactsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VaultUserServiceServer).ListEmergencyContacts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VaultUserService_ListEmergencyContacts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VaultUserServiceServer).ListEmergencyContacts(ctx, req.(*ListEmergencyContactsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VaultUserService_RemoveEmergencyContact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveEmergencyContactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VaultUserServiceServer).RemoveEmergencyContact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VaultUserService_RemoveEmergencyContact_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VaultUserServiceServer).RemoveEmergencyContact(ctx, req.(*RemoveEmergencyContactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VaultUserService_RequestEmergencyAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmergencyAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VaultUserServiceServer).RequestEmergencyAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VaultUserService_RequestEmergencyAccess_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VaultUserServiceServer).RequestEmergencyAccess(ctx, req.(*EmergencyAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VaultUserService_ApproveEmergencyAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmergencyAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VaultUserServiceServer).ApproveEmergencyAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VaultUserService_ApproveEmergencyAccess_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VaultUserServiceServer).ApproveEmergencyAccess(ctx, req.(*EmergencyAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VaultUserService_RejectEmergencyAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmergencyAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VaultUserServiceServer).RejectEmergencyAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VaultUserService_RejectEmergencyAccess_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VaultUserServiceServer).RejectEmergencyAccess(ctx, req.(*EmergencyAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // VaultUserService_ServiceDesc is the grpc.ServiceDesc for VaultUserService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -229,6 +541,42 @@ var VaultUserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Login",
 			Handler:    _VaultUserService_Login_Handler,
 		},
+		{
+			MethodName: "BeginLogin",
+			Handler:    _VaultUserService_BeginLogin_Handler,
+		},
+		{
+			MethodName: "FinishLogin",
+			Handler:    _VaultUserService_FinishLogin_Handler,
+		},
+		{
+			MethodName: "GetKdfParams",
+			Handler:    _VaultUserService_GetKdfParams_Handler,
+		},
+		{
+			MethodName: "AddEmergencyContact",
+			Handler:    _VaultUserService_AddEmergencyContact_Handler,
+		},
+		{
+			MethodName: "ListEmergencyContacts",
+			Handler:    _VaultUserService_ListEmergencyContacts_Handler,
+		},
+		{
+			MethodName: "RemoveEmergencyContact",
+			Handler:    _VaultUserService_RemoveEmergencyContact_Handler,
+		},
+		{
+			MethodName: "RequestEmergencyAccess",
+			Handler:    _VaultUserService_RequestEmergencyAccess_Handler,
+		},
+		{
+			MethodName: "ApproveEmergencyAccess",
+			Handler:    _VaultUserService_ApproveEmergencyAccess_Handler,
+		},
+		{
+			MethodName: "RejectEmergencyAccess",
+			Handler:    _VaultUserService_RejectEmergencyAccess_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "users.proto",
@@ -1,21 +1,33 @@
 package main
 
 import (
-	_ "context"
+	"context"
+	"database/sql"
 	"fmt"
 	"github.com/AleksZelenchuk/vault-server/gen/go/vaultpb"
 	"github.com/AleksZelenchuk/vault-server/gen/go/vaultuserpb"
+	"github.com/AleksZelenchuk/vault-server/pkg/attachment"
+	"github.com/AleksZelenchuk/vault-server/pkg/audit"
+	"github.com/AleksZelenchuk/vault-server/pkg/auth"
+	"github.com/AleksZelenchuk/vault-server/pkg/breach"
 	"github.com/AleksZelenchuk/vault-server/pkg/config"
 	"github.com/AleksZelenchuk/vault-server/pkg/interceptors"
+	"github.com/AleksZelenchuk/vault-server/pkg/notify"
+	"github.com/AleksZelenchuk/vault-server/pkg/ratelimit"
+	"github.com/AleksZelenchuk/vault-server/pkg/rotation"
 	"github.com/AleksZelenchuk/vault-server/pkg/service"
 	"github.com/AleksZelenchuk/vault-server/pkg/storage"
 	"google.golang.org/grpc/reflection"
 	"log"
+	"math"
 	"net"
 	"os"
+	"os/signal"
+	"strconv"
+	"syscall"
+	"time"
 
 	_ "github.com/AleksZelenchuk/vault-server/gen/go/vaultpb"
-	_ "github.com/AleksZelenchuk/vault-server/pkg/auth"
 	"github.com/jmoiron/sqlx"
 	_ "github.com/lib/pq"
 	"google.golang.org/grpc"
@@ -33,6 +45,24 @@ func main() {
 	if masterKey == "" {
 		log.Fatal("VAULT_MASTER_KEY is required")
 	}
+	emergencyWait := envDuration("EMERGENCY_ACCESS_WAIT", 48*time.Hour)
+	auditCheckpointInterval := envDuration("AUDIT_CHECKPOINT_INTERVAL", time.Hour)
+	rotationCheckInterval := envDuration("ROTATION_CHECK_INTERVAL", time.Hour)
+	legacyReveal := os.Getenv("VAULT_LEGACY_REVEAL") == "true"
+	revealPerMinute := envInt("REVEAL_RATE_LIMIT", 30)
+	revealBurst := envInt("REVEAL_RATE_BURST", 10)
+	loginPerMinute := envInt("LOGIN_RATE_LIMIT", 10)
+	loginBurst := envInt("LOGIN_RATE_BURST", 5)
+	attachmentQuota := int64(envInt("ATTACHMENT_QUOTA_MB", 100)) << 20
+	hashParams := auth.DefaultHashParams
+	hashParams.Time = uint32(envIntMax("PASSWORD_HASH_TIME", int(hashParams.Time), math.MaxUint32))
+	hashParams.MemoryKiB = uint32(envIntMax("PASSWORD_HASH_MEMORY_KIB", int(hashParams.MemoryKiB), math.MaxUint32))
+	hashParams.Threads = uint8(envIntMax("PASSWORD_HASH_THREADS", int(hashParams.Threads), math.MaxUint8))
+	kdfDefaults := storage.KdfParams{
+		Time:      sql.NullInt32{Int32: int32(envIntMax("KDF_TIME", 3, math.MaxInt32)), Valid: true},
+		MemoryKiB: sql.NullInt32{Int32: int32(envIntMax("KDF_MEMORY_KIB", 64*1024, math.MaxInt32)), Valid: true},
+		Threads:   sql.NullInt32{Int32: int32(envIntMax("KDF_THREADS", 4, math.MaxInt32)), Valid: true},
+	}
 
 	// === Connect to Database ===
 	db, err := sqlx.Connect("postgres", dbURL)
@@ -49,15 +79,73 @@ func main() {
 	// === Initialize Dependencies ===
 	store := storage.NewStore(db)
 	userStorage := storage.NewUserStore(db)
+	emergencyStorage := storage.NewEmergencyStore(db)
+	policyStorage := storage.NewPolicyStore(db)
+	var breaches *breach.Index
+	if path := os.Getenv("BREACH_INDEX_PATH"); path != "" {
+		breaches, err = breach.Open(path)
+		if err != nil {
+			log.Fatalf("Opening breach index failed: %v", err)
+		}
+		defer func() { _ = breaches.Close() }()
+		log.Printf("Breach index loaded with %d hashes", breaches.Records())
+	}
+	var blobs attachment.BlobStore
+	switch backend := os.Getenv("ATTACHMENT_BACKEND"); backend {
+	case "", "postgres":
+		blobs = attachment.NewLargeObjectStore(db)
+	case "filesystem":
+		dir := os.Getenv("ATTACHMENT_DIR")
+		if dir == "" {
+			dir = "./attachments"
+		}
+		if blobs, err = attachment.NewFileStore(dir); err != nil {
+			log.Fatalf("Opening attachment directory failed: %v", err)
+		}
+	case "none":
+	default:
+		log.Fatalf("invalid ATTACHMENT_BACKEND: %q", backend)
+	}
+	notifier := notify.NewLogNotifier()
+	auditSinks, err := audit.SinksFromEnv()
+	if err != nil {
+		log.Fatalf("Audit sink configuration failed: %v", err)
+	}
+	// ctx is cancelled on SIGINT or SIGTERM, which stops the background jobs and the server
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+	auditor := audit.NewAuditor(storage.NewAuditStore(db), auditSinks...)
+	go auditor.RunCheckpoints(ctx, auditCheckpointInterval)
+	go rotation.NewScheduler(store, notifier).Run(ctx, rotationCheckInterval)
+	// entries created before password fingerprints existed are fingerprinted once in the background
+	go func() {
+		n, err := store.BackfillFingerprints(ctx)
+		if err != nil {
+			log.Printf("Password fingerprint backfill failed: %v", err)
+		} else if n > 0 {
+			log.Printf("Fingerprinted %d existing entries", n)
+		}
+	}()
 
 	// === Initialize Vault Service ===
-	vaultService := service.NewVaultService(store)
-	userService := service.NewUserVaultService(userStorage)
+	vaultService := service.NewVaultService(store, emergencyStorage, auditor, policyStorage, breaches, storage.NewAttachmentStore(db), blobs, attachmentQuota, legacyReveal)
+	userService := service.NewUserVaultService(userStorage, emergencyStorage, notifier, emergencyWait, kdfDefaults, hashParams)
 
 	// === Set up gRPC Server with Auth Middleware ===
+	rateLimits := map[string]*ratelimit.Limiter{
+		vaultpb.VaultService_RevealPassword_FullMethodName: ratelimit.NewLimiter(revealPerMinute, revealBurst),
+		vaultpb.VaultService_GetTOTPCode_FullMethodName:    ratelimit.NewLimiter(revealPerMinute, revealBurst),
+		// SRP logins are unauthenticated, so they are limited per peer address
+		vaultuserpb.VaultUserService_BeginLogin_FullMethodName:  ratelimit.NewLimiter(loginPerMinute, loginBurst),
+		vaultuserpb.VaultUserService_FinishLogin_FullMethodName: ratelimit.NewLimiter(loginPerMinute, loginBurst),
+	}
 	server := grpc.NewServer(
-		grpc.ChainUnaryInterceptor(interceptors.UnaryAuthInterceptor),
-		grpc.ChainStreamInterceptor(interceptors.StreamAuthInterceptor),
+		grpc.ChainUnaryInterceptor(
+			interceptors.UnaryAuthInterceptor,
+			interceptors.UnaryAuditInterceptor(auditor),
+			interceptors.UnaryRateLimitInterceptor(rateLimits),
+		),
+		grpc.ChainStreamInterceptor(interceptors.StreamAuthInterceptor, interceptors.StreamAuditInterceptor(auditor)),
 	)
 	reflection.Register(server)
 	vaultuserpb.RegisterVaultUserServiceServer(server, userService)
@@ -71,7 +159,50 @@ func main() {
 	log.Printf("Vault gRPC server listening on port %s", grpcPort)
 
 	// === Serve ===
+	go func() {
+		<-ctx.Done()
+		log.Printf("Shutting down, waiting for running calls to finish")
+		server.GracefulStop()
+	}()
 	if err := server.Serve(lis); err != nil {
 		log.Fatalf("Failed to serve gRPC: %v", err)
 	}
+	// all calls have finished, so every audit event is queued and can be written before the sinks close
+	auditor.Close()
+	log.Printf("Vault gRPC server stopped")
+}
+
+// envDuration reads a positive Go duration from the environment, def is used when the variable is unset
+func envDuration(name string, def time.Duration) time.Duration {
+	v := os.Getenv(name)
+	if v == "" {
+		return def
+	}
+	d, err := time.ParseDuration(v)
+	if err != nil || d <= 0 {
+		log.Fatalf("invalid %s: %q", name, v)
+	}
+	return d
+}
+
+// envInt reads a positive integer from the environment, def is used when the variable is unset
+func envInt(name string, def int) int {
+	v := os.Getenv(name)
+	if v == "" {
+		return def
+	}
+	n, err := strconv.Atoi(v)
+	if err != nil || n <= 0 {
+		log.Fatalf("invalid %s: %q", name, v)
+	}
+	return n
+}
+
+// envIntMax reads an integer between 1 and max from the environment, so it cannot wrap when narrowed
+func envIntMax(name string, def int, max int) int {
+	n := envInt(name, def)
+	if n > max {
+		log.Fatalf("invalid %s: %d, must be between 1 and %d", name, n, max)
+	}
+	return n
 }
@@ -0,0 +1,29 @@
+// Package attachment encrypts attachment content in fixed size frames and stores it in a pluggable blob
+// store, Postgres large objects or files on the local filesystem.
+package attachment
+
+import (
+	"context"
+	"errors"
+	"io"
+)
+
+var ErrBlobNotFound = errors.New("attachment blob not found")
+
+// BlobStore keeps the encrypted content of attachments, it never sees plaintext
+type BlobStore interface {
+	// Name identifies the backend, it is stored with every attachment
+	Name() string
+	Create(ctx context.Context) (BlobWriter, error)
+	Open(ctx context.Context, ref string) (io.ReadCloser, error)
+	Delete(ctx context.Context, ref string) error
+}
+
+// BlobWriter receives the content of a new blob, it must be either committed or aborted
+type BlobWriter interface {
+	io.Writer
+	// Commit makes the blob durable and returns the reference to open it with
+	Commit() (string, error)
+	// Abort discards the blob
+	Abort()
+}
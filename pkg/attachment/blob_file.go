@@ -0,0 +1,95 @@
+package attachment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+// FileStore keeps blobs as files in a single directory, files are written to a temporary name and only
+// renamed into place on commit
+type FileStore struct {
+	dir string
+}
+
+func NewFileStore(dir string) (*FileStore, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, err
+	}
+	return &FileStore{dir: dir}, nil
+}
+
+func (s *FileStore) Name() string { return "filesystem" }
+
+func (s *FileStore) Create(_ context.Context) (BlobWriter, error) {
+	f, err := os.CreateTemp(s.dir, ".upload-*")
+	if err != nil {
+		return nil, err
+	}
+	return &fileWriter{f: f, path: filepath.Join(s.dir, uuid.NewString())}, nil
+}
+
+func (s *FileStore) Open(_ context.Context, ref string) (io.ReadCloser, error) {
+	path, err := s.path(ref)
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, ErrBlobNotFound
+	}
+	return f, err
+}
+
+func (s *FileStore) Delete(_ context.Context, ref string) error {
+	path, err := s.path(ref)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+// path maps a reference to its file, references are UUIDs so they cannot point outside the directory
+func (s *FileStore) path(ref string) (string, error) {
+	if _, err := uuid.Parse(ref); err != nil {
+		return "", fmt.Errorf("invalid blob reference %q", ref)
+	}
+	return filepath.Join(s.dir, ref), nil
+}
+
+type fileWriter struct {
+	f    *os.File
+	path string
+}
+
+func (w *fileWriter) Write(p []byte) (int, error) {
+	return w.f.Write(p)
+}
+
+func (w *fileWriter) Commit() (string, error) {
+	if err := w.f.Sync(); err != nil {
+		w.Abort()
+		return "", err
+	}
+	if err := w.f.Close(); err != nil {
+		_ = os.Remove(w.f.Name())
+		return "", err
+	}
+	if err := os.Rename(w.f.Name(), w.path); err != nil {
+		_ = os.Remove(w.f.Name())
+		return "", err
+	}
+	return filepath.Base(w.path), nil
+}
+
+func (w *fileWriter) Abort() {
+	_ = w.f.Close()
+	_ = os.Remove(w.f.Name())
+}
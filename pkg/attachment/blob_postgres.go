@@ -0,0 +1,111 @@
+package attachment
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/jmoiron/sqlx"
+	"io"
+	"strconv"
+)
+
+// LargeObjectStore keeps blobs as Postgres large objects, accessed through the server side lo_*
+// functions so no transaction has to stay open for the duration of a transfer
+type LargeObjectStore struct {
+	db *sqlx.DB
+}
+
+func NewLargeObjectStore(db *sqlx.DB) *LargeObjectStore {
+	return &LargeObjectStore{db: db}
+}
+
+func (s *LargeObjectStore) Name() string { return "postgres" }
+
+func (s *LargeObjectStore) Create(ctx context.Context) (BlobWriter, error) {
+	var oid uint32
+	if err := s.db.GetContext(ctx, &oid, `SELECT lo_create(0)`); err != nil {
+		return nil, err
+	}
+	return &largeObjectWriter{ctx: ctx, db: s.db, oid: oid}, nil
+}
+
+func (s *LargeObjectStore) Open(ctx context.Context, ref string) (io.ReadCloser, error) {
+	oid, err := strconv.ParseUint(ref, 10, 32)
+	if err != nil {
+		return nil, ErrBlobNotFound
+	}
+	var exists bool
+	err = s.db.GetContext(ctx, &exists, `SELECT EXISTS (SELECT 1 FROM pg_largeobject_metadata WHERE oid=$1)`, oid)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, ErrBlobNotFound
+	}
+	return &largeObjectReader{ctx: ctx, db: s.db, oid: uint32(oid)}, nil
+}
+
+func (s *LargeObjectStore) Delete(ctx context.Context, ref string) error {
+	oid, err := strconv.ParseUint(ref, 10, 32)
+	if err != nil {
+		return ErrBlobNotFound
+	}
+	_, err = s.db.ExecContext(ctx,
+		`SELECT lo_unlink(oid) FROM pg_largeobject_metadata WHERE oid=$1`, oid)
+	return err
+}
+
+type largeObjectWriter struct {
+	ctx    context.Context
+	db     *sqlx.DB
+	oid    uint32
+	offset int64
+}
+
+func (w *largeObjectWriter) Write(p []byte) (int, error) {
+	if _, err := w.db.ExecContext(w.ctx, `SELECT lo_put($1, $2, $3)`, w.oid, w.offset, p); err != nil {
+		return 0, err
+	}
+	w.offset += int64(len(p))
+	return len(p), nil
+}
+
+func (w *largeObjectWriter) Commit() (string, error) {
+	return strconv.FormatUint(uint64(w.oid), 10), nil
+}
+
+// Abort unlinks the object with a fresh context, the upload's context is usually cancelled by then
+func (w *largeObjectWriter) Abort() {
+	_, _ = w.db.ExecContext(context.Background(), `SELECT lo_unlink($1)`, w.oid)
+}
+
+// largeObjectReader reads an object in pieces of the size requested by the caller
+type largeObjectReader struct {
+	ctx    context.Context
+	db     *sqlx.DB
+	oid    uint32
+	offset int64
+}
+
+func (r *largeObjectReader) Read(p []byte) (int, error) {
+	if len(p) == 0 {
+		return 0, nil
+	}
+	var data []byte
+	err := r.db.GetContext(r.ctx, &data, `SELECT lo_get($1, $2, $3)`, r.oid, r.offset, len(p))
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, ErrBlobNotFound
+	}
+	if err != nil {
+		return 0, err
+	}
+	if len(data) == 0 {
+		return 0, io.EOF
+	}
+	r.offset += int64(len(data))
+	return copy(p, data), nil
+}
+
+func (r *largeObjectReader) Close() error {
+	return nil
+}
@@ -0,0 +1,169 @@
+package attachment
+
+import (
+	"bufio"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"github.com/google/uuid"
+	"io"
+)
+
+const (
+	// ChunkSize is the plaintext size of every frame but the last
+	ChunkSize = 64 << 10
+	KeySize   = 32
+)
+
+var ErrCorrupted = errors.New("attachment content is corrupted or truncated")
+
+// NewKey returns a random content key for a new attachment
+func NewKey() ([]byte, error) {
+	key := make([]byte, KeySize)
+	if _, err := io.ReadFull(rand.Reader, key); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+// Frames are sealed with AES-256-GCM under the attachment's own key. The nonce is the frame index, the
+// additional data binds every frame to its attachment and marks the final frame, so frames cannot be
+// reordered, moved between attachments or cut off at the end without failing authentication
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+func frameNonce(index uint64) []byte {
+	nonce := make([]byte, 12)
+	binary.BigEndian.PutUint64(nonce[4:], index)
+	return nonce
+}
+
+func frameAAD(id uuid.UUID, last bool) []byte {
+	aad := append([]byte{}, id[:]...)
+	if last {
+		return append(aad, 1)
+	}
+	return append(aad, 0)
+}
+
+// Encrypter seals everything written to it into frames written to w, Close must be called to write the
+// final frame
+type Encrypter struct {
+	w     io.Writer
+	aead  cipher.AEAD
+	id    uuid.UUID
+	buf   []byte
+	index uint64
+}
+
+func NewEncrypter(w io.Writer, key []byte, id uuid.UUID) (*Encrypter, error) {
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+	return &Encrypter{w: w, aead: aead, id: id, buf: make([]byte, 0, ChunkSize)}, nil
+}
+
+func (e *Encrypter) Write(p []byte) (int, error) {
+	n := len(p)
+	for len(p) > 0 {
+		// a full buffer is only sealed once more data arrives, until then it may be the last frame
+		if len(e.buf) == ChunkSize {
+			if err := e.seal(false); err != nil {
+				return n - len(p), err
+			}
+		}
+		take := min(ChunkSize-len(e.buf), len(p))
+		e.buf = append(e.buf, p[:take]...)
+		p = p[take:]
+	}
+	return n, nil
+}
+
+// Close writes the final frame, it does not close w
+func (e *Encrypter) Close() error {
+	return e.seal(true)
+}
+
+func (e *Encrypter) seal(last bool) error {
+	frame := e.aead.Seal(nil, frameNonce(e.index), e.buf, frameAAD(e.id, last))
+	clear(e.buf)
+	e.buf = e.buf[:0]
+	e.index++
+	_, err := e.w.Write(frame)
+	return err
+}
+
+// Decrypter reads and authenticates the frames written by an Encrypter
+type Decrypter struct {
+	r     *bufio.Reader
+	aead  cipher.AEAD
+	id    uuid.UUID
+	frame []byte
+	plain []byte
+	index uint64
+	done  bool
+}
+
+func NewDecrypter(r io.Reader, key []byte, id uuid.UUID) (*Decrypter, error) {
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+	return &Decrypter{
+		r:     bufio.NewReaderSize(r, ChunkSize+aead.Overhead()),
+		aead:  aead,
+		id:    id,
+		frame: make([]byte, ChunkSize+aead.Overhead()),
+	}, nil
+}
+
+func (d *Decrypter) Read(p []byte) (int, error) {
+	for len(d.plain) == 0 {
+		if d.done {
+			return 0, io.EOF
+		}
+		if err := d.open(); err != nil {
+			return 0, err
+		}
+	}
+	n := copy(p, d.plain)
+	d.plain = d.plain[n:]
+	return n, nil
+}
+
+func (d *Decrypter) open() error {
+	n, err := io.ReadFull(d.r, d.frame)
+	if errors.Is(err, io.EOF) {
+		// the stream ended without a final frame
+		return ErrCorrupted
+	}
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return err
+	}
+	last := n < len(d.frame)
+	if !last {
+		if _, err := d.r.Peek(1); errors.Is(err, io.EOF) {
+			last = true
+		} else if err != nil {
+			return err
+		}
+	}
+
+	plain, err := d.aead.Open(d.frame[:0], frameNonce(d.index), d.frame[:n], frameAAD(d.id, last))
+	if err != nil {
+		return ErrCorrupted
+	}
+	d.plain = plain
+	d.index++
+	d.done = last
+	return nil
+}
@@ -0,0 +1,131 @@
+package attachment
+
+import (
+	"bytes"
+	"crypto/rand"
+	"errors"
+	"github.com/google/uuid"
+	"io"
+	"testing"
+)
+
+// encrypt seals content in frames written through an Encrypter in uneven writes
+func encrypt(t *testing.T, content []byte, key []byte, id uuid.UUID) []byte {
+	t.Helper()
+	var sealed bytes.Buffer
+	e, err := NewEncrypter(&sealed, key, id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for rest := content; len(rest) > 0; {
+		n := min(len(rest), 1000)
+		if _, err := e.Write(rest[:n]); err != nil {
+			t.Fatal(err)
+		}
+		rest = rest[n:]
+	}
+	if err := e.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return sealed.Bytes()
+}
+
+func decrypt(sealed []byte, key []byte, id uuid.UUID) ([]byte, error) {
+	d, err := NewDecrypter(bytes.NewReader(sealed), key, id)
+	if err != nil {
+		return nil, err
+	}
+	return io.ReadAll(d)
+}
+
+func random(t *testing.T, n int) []byte {
+	t.Helper()
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+func TestRoundTrip(t *testing.T) {
+	key, err := NewKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	id := uuid.New()
+	for _, size := range []int{0, 1, ChunkSize - 1, ChunkSize, ChunkSize + 1, 3*ChunkSize + 7} {
+		content := random(t, size)
+		sealed := encrypt(t, content, key, id)
+		frames := size/ChunkSize + 1
+		if size > 0 && size%ChunkSize == 0 {
+			// a full last frame is not followed by an empty one
+			frames--
+		}
+		if want := size + frames*16; len(sealed) != want {
+			t.Errorf("size %d: sealed %d bytes, want %d", size, len(sealed), want)
+		}
+		got, err := decrypt(sealed, key, id)
+		if err != nil {
+			t.Fatalf("size %d: %v", size, err)
+		}
+		if !bytes.Equal(got, content) {
+			t.Fatalf("size %d: content differs after the round trip", size)
+		}
+	}
+}
+
+func TestTampering(t *testing.T) {
+	key, err := NewKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	id := uuid.New()
+	frameSize := ChunkSize + 16
+	// three full frames and a short last one
+	sealed := encrypt(t, random(t, 3*ChunkSize+100), key, id)
+	otherKey, err := NewKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	swapped := bytes.Clone(sealed)
+	copy(swapped[:frameSize], sealed[frameSize:2*frameSize])
+	copy(swapped[frameSize:2*frameSize], sealed[:frameSize])
+	flipped := bytes.Clone(sealed)
+	flipped[frameSize+10] ^= 1
+
+	cases := []struct {
+		name   string
+		sealed []byte
+		key    []byte
+		id     uuid.UUID
+	}{
+		{"last frame dropped", sealed[:3*frameSize], key, id},
+		{"last frames dropped", sealed[:frameSize], key, id},
+		{"cut inside a frame", sealed[:len(sealed)-5], key, id},
+		{"frames swapped", swapped, key, id},
+		{"bit flipped", flipped, key, id},
+		{"shorter than a tag", sealed[:10], key, id},
+		{"empty", nil, key, id},
+		{"other attachment id", sealed, key, uuid.New()},
+		{"other key", sealed, otherKey, id},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if _, err := decrypt(c.sealed, c.key, c.id); !errors.Is(err, ErrCorrupted) {
+				t.Fatalf("decrypt = %v, want ErrCorrupted", err)
+			}
+		})
+	}
+}
+
+func TestEmptyAttachmentIsBoundToId(t *testing.T) {
+	key, err := NewKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sealed := encrypt(t, nil, key, uuid.New())
+	if _, err := decrypt(sealed, key, uuid.New()); !errors.Is(err, ErrCorrupted) {
+		t.Fatalf("decrypt with another id = %v, want ErrCorrupted", err)
+	}
+}
@@ -0,0 +1,263 @@
+package audit
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/AleksZelenchuk/vault-server/pkg/auth"
+	"github.com/AleksZelenchuk/vault-server/pkg/storage"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"log"
+	"sync"
+	"time"
+)
+
+// Actions recorded by explicit hooks in services, RPC level events use the full gRPC method name
+const (
+	ActionEntryCreate = "entry.create"
+	ActionEntryUpdate = "entry.update"
+	ActionEntryRead   = "entry.read"
+	ActionEntryReveal = "entry.reveal"
+	ActionEntryDelete = "entry.delete"
+	ActionEntryTotp   = "entry.totp"
+
+	ActionAttachmentUpload   = "attachment.upload"
+	ActionAttachmentDownload = "attachment.download"
+	ActionAttachmentDelete   = "attachment.delete"
+)
+
+// Auditor records who did what to which entry, from where and with which result.
+// Events are stored in the database first and then exported to every configured sink.
+//
+// All events are chained by a single writer goroutine that inserts whatever is queued in one transaction
+// of up to maxAuditBatch events, so the global chain lock is taken once per batch instead of once per
+// event. Throughput is bounded by one such transaction at a time per server, i.e. roughly
+// maxAuditBatch events per database round trip
+type Auditor struct {
+	store *storage.AuditStore
+	sinks []Sink
+
+	mu      sync.RWMutex
+	closed  bool
+	queue   chan auditBatch
+	stopped chan struct{}
+}
+
+// auditBatch is a group of events queued by one call, done receives the result of the insert unless the
+// caller does not wait for it
+type auditBatch struct {
+	events []*storage.AuditEvent
+	done   chan error
+}
+
+const (
+	// auditQueueSize bounds the calls waiting for the writer, callers block once it is full
+	auditQueueSize = 4096
+	// maxAuditBatch bounds the events inserted in one transaction
+	maxAuditBatch = 256
+)
+
+var errAuditorClosed = errors.New("auditor is closed")
+
+func NewAuditor(store *storage.AuditStore, sinks ...Sink) *Auditor {
+	a := &Auditor{
+		store:   store,
+		sinks:   sinks,
+		queue:   make(chan auditBatch, auditQueueSize),
+		stopped: make(chan struct{}),
+	}
+	go a.run()
+	return a
+}
+
+// Record writes a single event and waits until it is stored, opErr is the result of the audited operation
+func (a *Auditor) Record(ctx context.Context, action string, entryId string, opErr error) error {
+	return a.insert(ctx, newEvent(ctx, action, entryId, opErr))
+}
+
+// RecordAsync queues a single event without waiting for it to be stored, failures are logged by the
+// writer. It only blocks while the queue is full
+func (a *Auditor) RecordAsync(ctx context.Context, action string, entryId string, opErr error) error {
+	return a.enqueue(ctx, auditBatch{events: []*storage.AuditEvent{newEvent(ctx, action, entryId, opErr)}})
+}
+
+// RecordEntries writes one event per entry, used when a single call touches many entries
+func (a *Auditor) RecordEntries(ctx context.Context, action string, entryIds []string, opErr error) error {
+	events := make([]*storage.AuditEvent, 0, len(entryIds))
+	for _, id := range entryIds {
+		events = append(events, newEvent(ctx, action, id, opErr))
+	}
+	return a.insert(ctx, events...)
+}
+
+// Close stops accepting events, waits until the queued ones are stored and then flushes and closes all sinks
+func (a *Auditor) Close() {
+	a.mu.Lock()
+	if !a.closed {
+		a.closed = true
+		close(a.queue)
+	}
+	a.mu.Unlock()
+	<-a.stopped
+
+	for _, s := range a.sinks {
+		if err := s.Close(); err != nil {
+			log.Printf("audit sink %s: close failed: %v", s.Name(), err)
+		}
+	}
+}
+
+// insert queues events and waits for the writer to store them
+func (a *Auditor) insert(ctx context.Context, events ...*storage.AuditEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+	b := auditBatch{events: events, done: make(chan error, 1)}
+	if err := a.enqueue(ctx, b); err != nil {
+		return err
+	}
+	select {
+	case err := <-b.done:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (a *Auditor) enqueue(ctx context.Context, b auditBatch) error {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	if a.closed {
+		return errAuditorClosed
+	}
+	select {
+	case a.queue <- b:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// run is the single writer, it takes whatever is queued up to maxAuditBatch events and stores it at once
+func (a *Auditor) run() {
+	defer close(a.stopped)
+	for b := range a.queue {
+		batches := []auditBatch{b}
+		n := len(b.events)
+	collect:
+		for n < maxAuditBatch {
+			select {
+			case next, ok := <-a.queue:
+				if !ok {
+					break collect
+				}
+				batches = append(batches, next)
+				n += len(next.events)
+			default:
+				break collect
+			}
+		}
+		a.write(batches, n)
+	}
+}
+
+// write stores batches in one transaction. When that fails every batch is retried on its own so one bad
+// event does not fail the events of other calls
+func (a *Auditor) write(batches []auditBatch, n int) {
+	events := make([]*storage.AuditEvent, 0, n)
+	for _, b := range batches {
+		events = append(events, b.events...)
+	}
+	if err := a.store.Insert(context.Background(), events...); err == nil || len(batches) == 1 {
+		for _, b := range batches {
+			a.done(b, err)
+		}
+		return
+	}
+	for _, b := range batches {
+		a.done(b, a.store.Insert(context.Background(), b.events...))
+	}
+}
+
+// done publishes stored events and reports the result to the caller, or logs failures nobody waits for
+func (a *Auditor) done(b auditBatch, err error) {
+	if err == nil {
+		for _, e := range b.events {
+			a.publish(recordFromEvent(e))
+		}
+	}
+	if b.done != nil {
+		b.done <- err
+		return
+	}
+	if err != nil {
+		for _, e := range b.events {
+			log.Printf("audit: failed to record %s: %v", e.Action, err)
+		}
+	}
+}
+
+// publish hands r to every sink, sinks are buffered so this never waits on delivery
+func (a *Auditor) publish(r Record) {
+	for _, s := range a.sinks {
+		if err := s.Write(r); err != nil {
+			log.Printf("audit sink %s: %v", s.Name(), err)
+		}
+	}
+}
+
+// Query returns recorded events visible to the active user
+func (a *Auditor) Query(ctx context.Context, q storage.AuditQuery) ([]storage.AuditEvent, error) {
+	return a.store.QueryEvents(ctx, q)
+}
+
+// RunCheckpoints periodically checkpoints the audit chain head until ctx is done. Checkpoints are also
+// written to the server log and the sinks so a copy of the chain head survives outside the database
+func (a *Auditor) RunCheckpoints(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			c, err := a.store.Checkpoint(ctx)
+			if err != nil {
+				log.Printf("audit: checkpoint failed: %v", err)
+				continue
+			}
+			if c != nil {
+				log.Printf("audit: checkpoint seq=%d hash=%x", c.Seq, c.Hash)
+				a.publish(recordFromCheckpoint(c))
+			}
+		}
+	}
+}
+
+func newEvent(ctx context.Context, action string, entryId string, opErr error) *storage.AuditEvent {
+	actorId, _ := auth.ActorIDFromContext(ctx)
+	ownerId, _ := auth.UserIDFromContext(ctx)
+
+	e := &storage.AuditEvent{
+		ID:        uuid.New(),
+		ActorId:   nullString(actorId),
+		OwnerId:   nullString(ownerId),
+		Action:    action,
+		EntryId:   nullString(entryId),
+		Outcome:   status.Code(opErr).String(),
+		CreatedAt: time.Now().UTC().Truncate(time.Microsecond),
+	}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		e.Peer = nullString(p.Addr.String())
+	}
+	return e
+}
+
+func nullString(s string) sql.NullString {
+	if s == "" {
+		return sql.NullString{}
+	}
+	return sql.NullString{String: s, Valid: true}
+}
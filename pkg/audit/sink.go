@@ -0,0 +1,124 @@
+package audit
+
+import (
+	"encoding/hex"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/AleksZelenchuk/vault-server/pkg/storage"
+)
+
+// ActionCheckpoint is published to sinks whenever the chain head is checkpointed
+const ActionCheckpoint = "audit.checkpoint"
+
+// Record is the exported form of an audit event, shared by all sinks
+type Record struct {
+	ID        string    `json:"id,omitempty"`
+	Seq       int64     `json:"seq"`
+	ActorID   string    `json:"actor_id,omitempty"`
+	OwnerID   string    `json:"owner_id,omitempty"`
+	Action    string    `json:"action"`
+	EntryID   string    `json:"entry_id,omitempty"`
+	Peer      string    `json:"peer,omitempty"`
+	Outcome   string    `json:"outcome,omitempty"`
+	Hash      string    `json:"hash,omitempty"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+func recordFromEvent(e *storage.AuditEvent) Record {
+	return Record{
+		ID:        e.ID.String(),
+		Seq:       e.Seq,
+		ActorID:   e.ActorId.String,
+		OwnerID:   e.OwnerId.String,
+		Action:    e.Action,
+		EntryID:   e.EntryId.String,
+		Peer:      e.Peer.String,
+		Outcome:   e.Outcome,
+		Hash:      hex.EncodeToString(e.Hash),
+		Timestamp: e.CreatedAt,
+	}
+}
+
+func recordFromCheckpoint(c *storage.AuditCheckpoint) Record {
+	return Record{
+		Seq:       c.Seq,
+		Action:    ActionCheckpoint,
+		Hash:      hex.EncodeToString(c.Hash),
+		Timestamp: c.CreatedAt,
+	}
+}
+
+// Sink exports audit records to an external system
+type Sink interface {
+	Name() string
+	Write(r Record) error
+	Close() error
+}
+
+// BufferedSink decouples a sink from the request path: Write only enqueues and a single goroutine
+// feeds the wrapped sink. When the buffer is full records are dropped and counted instead of blocking
+type BufferedSink struct {
+	sink    Sink
+	queue   chan Record
+	done    chan struct{}
+	mu      sync.Mutex
+	dropped int64
+	closed  bool
+}
+
+func NewBufferedSink(sink Sink, size int) *BufferedSink {
+	b := &BufferedSink{
+		sink:  sink,
+		queue: make(chan Record, size),
+		done:  make(chan struct{}),
+	}
+	go b.run()
+	return b
+}
+
+func (b *BufferedSink) Name() string {
+	return b.sink.Name()
+}
+
+func (b *BufferedSink) Write(r Record) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.closed {
+		return nil
+	}
+	select {
+	case b.queue <- r:
+	default:
+		b.dropped++
+		if b.dropped == 1 || b.dropped%1000 == 0 {
+			log.Printf("audit sink %s: buffer full, %d records dropped so far", b.sink.Name(), b.dropped)
+		}
+	}
+	return nil
+}
+
+// Close flushes queued records and closes the wrapped sink
+func (b *BufferedSink) Close() error {
+	b.mu.Lock()
+	if b.closed {
+		b.mu.Unlock()
+		return nil
+	}
+	b.closed = true
+	close(b.queue)
+	b.mu.Unlock()
+
+	<-b.done
+	return b.sink.Close()
+}
+
+func (b *BufferedSink) run() {
+	defer close(b.done)
+	for r := range b.queue {
+		if err := b.sink.Write(r); err != nil {
+			log.Printf("audit sink %s: failed to export event seq=%d: %v", b.sink.Name(), r.Seq, err)
+		}
+	}
+}
@@ -0,0 +1,81 @@
+package audit
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+)
+
+const defaultSinkBuffer = 1024
+
+// SinksFromEnv builds the audit sinks enabled in the environment, each sink gets its own buffer:
+//
+//	AUDIT_FILE_PATH, AUDIT_FILE_MAX_SIZE_MB (100), AUDIT_FILE_MAX_BACKUPS (5)
+//	AUDIT_SYSLOG_ADDR, AUDIT_SYSLOG_NETWORK (udp or tcp, default udp)
+//	AUDIT_WEBHOOK_URL, AUDIT_WEBHOOK_SECRET, AUDIT_WEBHOOK_MAX_RETRIES (5)
+//	AUDIT_SINK_BUFFER (1024) - records queued per sink before new ones are dropped
+func SinksFromEnv() ([]Sink, error) {
+	bufferSize, err := envInt("AUDIT_SINK_BUFFER", defaultSinkBuffer)
+	if err != nil {
+		return nil, err
+	}
+	if bufferSize < 1 {
+		return nil, errors.New("AUDIT_SINK_BUFFER must be at least 1")
+	}
+
+	var sinks []Sink
+	if path := os.Getenv("AUDIT_FILE_PATH"); path != "" {
+		maxSizeMB, err := envInt("AUDIT_FILE_MAX_SIZE_MB", 100)
+		if err != nil {
+			return nil, err
+		}
+		maxBackups, err := envInt("AUDIT_FILE_MAX_BACKUPS", 5)
+		if err != nil {
+			return nil, err
+		}
+		sink, err := NewFileSink(path, int64(maxSizeMB)<<20, maxBackups)
+		if err != nil {
+			return nil, fmt.Errorf("audit file sink: %w", err)
+		}
+		sinks = append(sinks, NewBufferedSink(sink, bufferSize))
+	}
+
+	if addr := os.Getenv("AUDIT_SYSLOG_ADDR"); addr != "" {
+		network := os.Getenv("AUDIT_SYSLOG_NETWORK")
+		if network == "" {
+			network = "udp"
+		}
+		sink, err := NewSyslogSink(network, addr)
+		if err != nil {
+			return nil, fmt.Errorf("audit syslog sink: %w", err)
+		}
+		sinks = append(sinks, NewBufferedSink(sink, bufferSize))
+	}
+
+	if url := os.Getenv("AUDIT_WEBHOOK_URL"); url != "" {
+		secret := os.Getenv("AUDIT_WEBHOOK_SECRET")
+		if secret == "" {
+			return nil, errors.New("AUDIT_WEBHOOK_SECRET is required when AUDIT_WEBHOOK_URL is set")
+		}
+		maxRetries, err := envInt("AUDIT_WEBHOOK_MAX_RETRIES", 5)
+		if err != nil {
+			return nil, err
+		}
+		sinks = append(sinks, NewBufferedSink(NewWebhookSink(url, secret, maxRetries), bufferSize))
+	}
+
+	return sinks, nil
+}
+
+func envInt(name string, def int) (int, error) {
+	v := os.Getenv(name)
+	if v == "" {
+		return def, nil
+	}
+	n, err := strconv.Atoi(v)
+	if err != nil || n < 0 {
+		return 0, fmt.Errorf("invalid %s: %q", name, v)
+	}
+	return n, nil
+}
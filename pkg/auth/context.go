@@ -8,6 +8,7 @@ type contextKey string
 
 const userKey = contextKey("user_id")
 const userIdKey = contextKey("id")
+const actorIdKey = contextKey("actor_id")
 
 func WithUserID(ctx context.Context, userID string) context.Context {
 	return context.WithValue(ctx, userIdKey, userID)
@@ -17,3 +18,18 @@ func UserIDFromContext(ctx context.Context) (string, bool) {
 	uid, ok := ctx.Value(userIdKey).(string)
 	return uid, ok
 }
+
+// WithActorID keeps the authenticated user in context when the active user is switched,
+// e.g. when an emergency contact works with the grantor's vault
+func WithActorID(ctx context.Context, actorID string) context.Context {
+	return context.WithValue(ctx, actorIdKey, actorID)
+}
+
+// ActorIDFromContext returns the authenticated user behind the request, which is the active user
+// unless the request acts on someone else's vault
+func ActorIDFromContext(ctx context.Context) (string, bool) {
+	if aid, ok := ctx.Value(actorIdKey).(string); ok {
+		return aid, ok
+	}
+	return UserIDFromContext(ctx)
+}
@@ -0,0 +1,101 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// HashParams are the Argon2id cost parameters for new password hashes
+type HashParams struct {
+	Time       uint32
+	MemoryKiB  uint32
+	Threads    uint8
+	SaltLength uint32
+	KeyLength  uint32
+}
+
+var DefaultHashParams = HashParams{
+	Time:       3,
+	MemoryKiB:  64 * 1024,
+	Threads:    2,
+	SaltLength: 16,
+	KeyLength:  32,
+}
+
+var ErrUnsupportedHash = errors.New("unsupported password hash format")
+
+var b64 = base64.RawStdEncoding
+
+// HashPassword returns an Argon2id hash in PHC string format:
+// $argon2id$v=19$m=<memory KiB>,t=<time>,p=<threads>$<salt>$<hash>
+func HashPassword(password string, p HashParams) (string, error) {
+	salt := make([]byte, p.SaltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return "", err
+	}
+	key := argon2.IDKey([]byte(password), salt, p.Time, p.MemoryKiB, p.Threads, p.KeyLength)
+	return fmt.Sprintf("$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s",
+		argon2.Version, p.MemoryKiB, p.Time, p.Threads, b64.EncodeToString(salt), b64.EncodeToString(key)), nil
+}
+
+// VerifyPassword checks password against a PHC Argon2id hash or a legacy bcrypt hash. needsRehash is
+// set on success when the hash is bcrypt or was created with parameters other than p
+func VerifyPassword(encoded string, password string, p HashParams) (ok bool, needsRehash bool, err error) {
+	if strings.HasPrefix(encoded, "$2a$") || strings.HasPrefix(encoded, "$2b$") || strings.HasPrefix(encoded, "$2y$") {
+		err := bcrypt.CompareHashAndPassword([]byte(encoded), []byte(password))
+		if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+			return false, false, nil
+		}
+		return err == nil, true, err
+	}
+
+	stored, salt, key, err := parseArgon2id(encoded)
+	if err != nil {
+		return false, false, err
+	}
+	computed := argon2.IDKey([]byte(password), salt, stored.Time, stored.MemoryKiB, stored.Threads, uint32(len(key)))
+	if subtle.ConstantTimeCompare(computed, key) != 1 {
+		return false, false, nil
+	}
+
+	needsRehash = stored.Time != p.Time || stored.MemoryKiB != p.MemoryKiB || stored.Threads != p.Threads ||
+		uint32(len(key)) != p.KeyLength || uint32(len(salt)) != p.SaltLength
+	return true, needsRehash, nil
+}
+
+func parseArgon2id(encoded string) (HashParams, []byte, []byte, error) {
+	var p HashParams
+	// "", "argon2id", "v=19", "m=..,t=..,p=..", salt, hash
+	parts := strings.Split(encoded, "$")
+	if len(parts) != 6 || parts[0] != "" || parts[1] != "argon2id" {
+		return p, nil, nil, ErrUnsupportedHash
+	}
+
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil || version != argon2.Version {
+		return p, nil, nil, ErrUnsupportedHash
+	}
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &p.MemoryKiB, &p.Time, &p.Threads); err != nil {
+		return p, nil, nil, ErrUnsupportedHash
+	}
+	if p.Time == 0 || p.Threads == 0 {
+		return p, nil, nil, ErrUnsupportedHash
+	}
+
+	salt, err := b64.DecodeString(parts[4])
+	if err != nil || len(salt) == 0 {
+		return p, nil, nil, ErrUnsupportedHash
+	}
+	key, err := b64.DecodeString(parts[5])
+	if err != nil || len(key) == 0 {
+		return p, nil, nil, ErrUnsupportedHash
+	}
+	return p, salt, key, nil
+}
@@ -0,0 +1,94 @@
+package auth
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+// small costs keep the tests fast, they are never used for stored hashes
+var testParams = HashParams{Time: 1, MemoryKiB: 64, Threads: 1, SaltLength: 8, KeyLength: 16}
+
+const (
+	// Argon2id of "hunter2" with testParams
+	fixedArgon2id = "$argon2id$v=19$m=64,t=1,p=1$RlE0royuvdA$CLJRKFvfyxv1H3Rp0NuliQ"
+	// bcrypt of "hunter2" at cost 4
+	fixedBcrypt = "$2a$04$/QxEqLiwKpImQ3xnXXW/1uXLxrpmSRkUjA9a.Twzdb5fgJEi9xDo6"
+)
+
+func TestHashRoundTrip(t *testing.T) {
+	encoded, err := HashPassword("hunter2", testParams)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(encoded, "$argon2id$v=19$m=64,t=1,p=1$") {
+		t.Fatalf("unexpected PHC string %s", encoded)
+	}
+
+	p, salt, key, err := parseArgon2id(encoded)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.Time != 1 || p.MemoryKiB != 64 || p.Threads != 1 || len(salt) != 8 || len(key) != 16 {
+		t.Fatalf("parsed %+v with %d byte salt and %d byte key", p, len(salt), len(key))
+	}
+
+	ok, rehash, err := VerifyPassword(encoded, "hunter2", testParams)
+	if err != nil || !ok || rehash {
+		t.Fatalf("VerifyPassword = %t, %t, %v, want true, false, nil", ok, rehash, err)
+	}
+	if ok, _, err := VerifyPassword(encoded, "hunter3", testParams); err != nil || ok {
+		t.Fatalf("VerifyPassword of a wrong password = %t, %v", ok, err)
+	}
+	if again, _ := HashPassword("hunter2", testParams); again == encoded {
+		t.Fatal("two hashes of the same password share a salt")
+	}
+}
+
+func TestVerifyFixedHashes(t *testing.T) {
+	cases := []struct {
+		name     string
+		encoded  string
+		password string
+		params   HashParams
+		ok       bool
+		rehash   bool
+	}{
+		{"argon2id", fixedArgon2id, "hunter2", testParams, true, false},
+		{"argon2id wrong password", fixedArgon2id, "Hunter2", testParams, false, false},
+		{"argon2id outdated time", fixedArgon2id, "hunter2", HashParams{Time: 2, MemoryKiB: 64, Threads: 1, SaltLength: 8, KeyLength: 16}, true, true},
+		{"argon2id outdated memory", fixedArgon2id, "hunter2", HashParams{Time: 1, MemoryKiB: 128, Threads: 1, SaltLength: 8, KeyLength: 16}, true, true},
+		{"argon2id outdated key length", fixedArgon2id, "hunter2", DefaultHashParams, true, true},
+		{"bcrypt", fixedBcrypt, "hunter2", testParams, true, true},
+		{"bcrypt wrong password", fixedBcrypt, "hunter3", testParams, false, false},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			ok, rehash, err := VerifyPassword(c.encoded, c.password, c.params)
+			if err != nil || ok != c.ok || rehash != c.rehash {
+				t.Fatalf("VerifyPassword = %t, %t, %v, want %t, %t, nil", ok, rehash, err, c.ok, c.rehash)
+			}
+		})
+	}
+}
+
+func TestParseInvalid(t *testing.T) {
+	invalid := []string{
+		"",
+		"hunter2",
+		"$argon2i$v=19$m=64,t=1,p=1$RlE0royuvdA$CLJRKFvfyxv1H3Rp0NuliQ",
+		"$argon2id$v=16$m=64,t=1,p=1$RlE0royuvdA$CLJRKFvfyxv1H3Rp0NuliQ",
+		"$argon2id$v=19$m=64,t=0,p=1$RlE0royuvdA$CLJRKFvfyxv1H3Rp0NuliQ",
+		"$argon2id$v=19$m=64,t=1,p=0$RlE0royuvdA$CLJRKFvfyxv1H3Rp0NuliQ",
+		"$argon2id$v=19$m=64,t=1$RlE0royuvdA$CLJRKFvfyxv1H3Rp0NuliQ",
+		"$argon2id$v=19$m=64,t=1,p=1$$CLJRKFvfyxv1H3Rp0NuliQ",
+		"$argon2id$v=19$m=64,t=1,p=1$RlE0royuvdA$",
+		"$argon2id$v=19$m=64,t=1,p=1$not*base64$CLJRKFvfyxv1H3Rp0NuliQ",
+		"$argon2id$v=19$m=64,t=1,p=1$RlE0royuvdA$CLJRKFvfyxv1H3Rp0NuliQ$",
+	}
+	for _, encoded := range invalid {
+		if ok, _, err := VerifyPassword(encoded, "hunter2", testParams); ok || !errors.Is(err, ErrUnsupportedHash) {
+			t.Errorf("VerifyPassword(%q) = %t, %v, want ErrUnsupportedHash", encoded, ok, err)
+		}
+	}
+}
@@ -0,0 +1,115 @@
+// Package breach checks passwords against a local copy of the Have I Been Pwned "Pwned Passwords"
+// SHA-1 dataset without any network access.
+//
+// The dataset is imported once into an index file:
+//
+//	magic "VBHIBP01" | record count (uint64) | fanout table | records
+//
+// The fanout table holds 2^20+1 uint64 record offsets, one per 5 hex digit hash prefix, so the
+// records of a prefix are records[fanout[p]:fanout[p+1]]. Records are sorted by hash and hold bytes
+// 2..19 of the SHA-1 hash followed by the breach count (uint32). All integers are big endian.
+package breach
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+)
+
+const (
+	magic       = "VBHIBP01"
+	prefixBits  = 20
+	prefixes    = 1 << prefixBits
+	hashOffset  = 2
+	hashPart    = sha1.Size - hashOffset
+	recordSize  = hashPart + 4
+	fanoutStart = int64(len(magic) + 8)
+	headerSize  = fanoutStart + (prefixes+1)*8
+)
+
+var ErrInvalidIndex = errors.New("breach: invalid index file")
+
+// Index is an opened breach index, it is safe for concurrent use
+type Index struct {
+	f       *os.File
+	records uint64
+}
+
+// Open opens an index file created by Import
+func Open(path string) (*Index, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+
+	header := make([]byte, fanoutStart)
+	if _, err := io.ReadFull(f, header); err != nil || string(header[:len(magic)]) != magic {
+		_ = f.Close()
+		return nil, ErrInvalidIndex
+	}
+	records := binary.BigEndian.Uint64(header[len(magic):])
+
+	info, err := f.Stat()
+	if err != nil {
+		_ = f.Close()
+		return nil, err
+	}
+	if info.Size() != headerSize+int64(records)*recordSize {
+		_ = f.Close()
+		return nil, fmt.Errorf("%w: size does not match %d records", ErrInvalidIndex, records)
+	}
+
+	return &Index{f: f, records: records}, nil
+}
+
+func (x *Index) Close() error {
+	return x.f.Close()
+}
+
+// Records is the number of hashes in the index
+func (x *Index) Records() uint64 {
+	return x.records
+}
+
+// Check returns how often password appears in the dataset, 0 when it was never seen in a breach
+func (x *Index) Check(password string) (uint32, error) {
+	return x.CheckHash(sha1.Sum([]byte(password)))
+}
+
+// CheckHash is Check for a password that is already hashed with SHA-1
+func (x *Index) CheckHash(hash [sha1.Size]byte) (uint32, error) {
+	prefix := uint32(hash[0])<<12 | uint32(hash[1])<<4 | uint32(hash[2])>>4
+
+	var bounds [16]byte
+	if _, err := x.f.ReadAt(bounds[:], fanoutStart+int64(prefix)*8); err != nil {
+		return 0, err
+	}
+	start := binary.BigEndian.Uint64(bounds[:8])
+	end := binary.BigEndian.Uint64(bounds[8:])
+	if end < start || end > x.records {
+		return 0, ErrInvalidIndex
+	}
+	if start == end {
+		return 0, nil
+	}
+
+	// a prefix holds around a thousand records, read them at once and search in memory
+	bucket := make([]byte, (end-start)*recordSize)
+	if _, err := x.f.ReadAt(bucket, headerSize+int64(start)*recordSize); err != nil {
+		return 0, err
+	}
+	n := int(end - start)
+	want := hash[hashOffset:]
+	i := sort.Search(n, func(i int) bool {
+		return bytes.Compare(bucket[i*recordSize:i*recordSize+hashPart], want) >= 0
+	})
+	if i < n && bytes.Equal(bucket[i*recordSize:i*recordSize+hashPart], want) {
+		return binary.BigEndian.Uint32(bucket[i*recordSize+hashPart:]), nil
+	}
+	return 0, nil
+}
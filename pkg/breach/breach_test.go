@@ -0,0 +1,149 @@
+package breach
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// SHA-1 of "password" is 5BAA61E4C9B93F3F0682250B6CF8331B7EE68FD8
+var ranges = map[string]string{
+	"5BAA6.txt": strings.Join([]string{
+		"1E4C9B93F3F0682250B6CF8331B7EE68FD8:3861493",
+		"011053FD0102E94D6AE2F8B83D76FAF94F6:1",
+		"FFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFF:0",
+		"not a record",
+		"",
+	}, "\r\n"),
+	// the downloader writes upper case names, lower case ones are accepted too
+	"00000.txt": "0005AD76BD555C1D6D771DE417A4B87E4B4:10\n",
+	"fffff.txt": "FFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFF:7\n",
+}
+
+var testIndex struct {
+	once sync.Once
+	path string
+	err  error
+}
+
+// testIndexPath imports ranges once per test run, Import probes every one of the 2^20 range files
+func testIndexPath(t *testing.T) string {
+	t.Helper()
+	testIndex.once.Do(func() {
+		testIndex.path, testIndex.err = importRanges()
+	})
+	if testIndex.err != nil {
+		t.Fatal(testIndex.err)
+	}
+	return testIndex.path
+}
+
+func importRanges() (string, error) {
+	dir, err := os.MkdirTemp("", "breach")
+	if err != nil {
+		return "", err
+	}
+	src := filepath.Join(dir, "ranges")
+	if err := os.Mkdir(src, 0o700); err != nil {
+		return "", err
+	}
+	for name, content := range ranges {
+		if err := os.WriteFile(filepath.Join(src, name), []byte(content), 0o600); err != nil {
+			return "", err
+		}
+	}
+
+	out := filepath.Join(dir, "breach.idx")
+	stats, err := Import(src, out, nil)
+	if err != nil {
+		return "", err
+	}
+	if stats.Records != 4 || stats.Skipped != 2 || stats.MissingRanges != prefixes-3 {
+		return "", fmt.Errorf("unexpected import stats %+v", stats)
+	}
+	return out, nil
+}
+
+func TestMain(m *testing.M) {
+	code := m.Run()
+	if testIndex.path != "" {
+		_ = os.RemoveAll(filepath.Dir(testIndex.path))
+	}
+	os.Exit(code)
+}
+
+func hashOf(t *testing.T, s string) [sha1.Size]byte {
+	t.Helper()
+	var h [sha1.Size]byte
+	b, err := hex.DecodeString(s)
+	if err != nil || len(b) != sha1.Size {
+		t.Fatalf("invalid hash %q", s)
+	}
+	copy(h[:], b)
+	return h
+}
+
+func TestCheck(t *testing.T) {
+	x, err := Open(testIndexPath(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = x.Close() }()
+	if x.Records() != 4 {
+		t.Fatalf("Records = %d, want 4", x.Records())
+	}
+
+	if n, err := x.Check("password"); err != nil || n != 3861493 {
+		t.Errorf(`Check("password") = %d, %v, want 3861493`, n, err)
+	}
+	if n, err := x.Check("correct horse battery staple"); err != nil || n != 0 {
+		t.Errorf("Check of an unknown password = %d, %v, want 0", n, err)
+	}
+
+	cases := []struct {
+		name string
+		hash string
+		want uint32
+	}{
+		{"other record of the range", "5BAA6011053FD0102E94D6AE2F8B83D76FAF94F6", 1},
+		{"first prefix", "000000005AD76BD555C1D6D771DE417A4B87E4B4", 10},
+		{"last prefix", "FFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFF", 7},
+		{"miss in a known range", "5BAA61E4C9B93F3F0682250B6CF8331B7EE68FD9", 0},
+		{"zero count is skipped", "5BAA6FFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFF", 0},
+		{"same suffix in another range", "5BAA71E4C9B93F3F0682250B6CF8331B7EE68FD8", 0},
+	}
+	for _, c := range cases {
+		if n, err := x.CheckHash(hashOf(t, c.hash)); err != nil || n != c.want {
+			t.Errorf("%s: CheckHash = %d, %v, want %d", c.name, n, err, c.want)
+		}
+	}
+}
+
+func TestOpenInvalid(t *testing.T) {
+	valid, err := os.ReadFile(testIndexPath(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dir := t.TempDir()
+	invalid := map[string][]byte{
+		"empty":     nil,
+		"bad magic": append([]byte("VBHIBP00"), valid[len(magic):]...),
+		"truncated": valid[:len(valid)-1],
+	}
+	for name, content := range invalid {
+		path := filepath.Join(dir, strings.ReplaceAll(name, " ", "_"))
+		if err := os.WriteFile(path, content, 0o600); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := Open(path); !errors.Is(err, ErrInvalidIndex) {
+			t.Errorf("%s: Open = %v, want ErrInvalidIndex", name, err)
+		}
+	}
+}
@@ -0,0 +1,140 @@
+package breach
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha1"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ImportStats summarises an import
+type ImportStats struct {
+	Records int64
+	// MissingRanges are prefixes without a range file, their passwords are unknown to the index
+	MissingRanges int
+	// Skipped are lines that could not be parsed and padding entries with a zero count
+	Skipped int64
+}
+
+// Import reads Pwned Passwords range files from srcDir, one "<PREFIX>.txt" file per 5 hex digit
+// prefix holding "<SUFFIX>:<COUNT>" lines as written by the official downloader, and writes an index
+// to outPath. The index is written to a temporary file first, an existing index stays usable until
+// the import succeeds
+func Import(srcDir, outPath string, progress func(prefix int)) (*ImportStats, error) {
+	tmp, err := os.CreateTemp(filepath.Dir(outPath), filepath.Base(outPath)+".tmp*")
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = os.Remove(tmp.Name()) }()
+	defer func() { _ = tmp.Close() }()
+
+	// the header is written last, once the fanout table is known
+	if _, err := tmp.Seek(headerSize, 0); err != nil {
+		return nil, err
+	}
+	w := bufio.NewWriterSize(tmp, 1<<20)
+
+	stats := &ImportStats{}
+	fanout := make([]uint64, prefixes+1)
+	for p := 0; p < prefixes; p++ {
+		fanout[p] = uint64(stats.Records)
+		records, skipped, err := readRange(srcDir, p)
+		if errors.Is(err, os.ErrNotExist) {
+			stats.MissingRanges++
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		stats.Skipped += skipped
+		for _, r := range records {
+			if _, err := w.Write(r); err != nil {
+				return nil, err
+			}
+		}
+		stats.Records += int64(len(records))
+		if progress != nil {
+			progress(p)
+		}
+	}
+	fanout[prefixes] = uint64(stats.Records)
+	if err := w.Flush(); err != nil {
+		return nil, err
+	}
+
+	header := make([]byte, headerSize)
+	copy(header, magic)
+	binary.BigEndian.PutUint64(header[len(magic):], uint64(stats.Records))
+	for p, offset := range fanout {
+		binary.BigEndian.PutUint64(header[fanoutStart+int64(p)*8:], offset)
+	}
+	if _, err := tmp.WriteAt(header, 0); err != nil {
+		return nil, err
+	}
+	if err := tmp.Sync(); err != nil {
+		return nil, err
+	}
+	if err := tmp.Close(); err != nil {
+		return nil, err
+	}
+	if err := os.Rename(tmp.Name(), outPath); err != nil {
+		return nil, err
+	}
+	return stats, nil
+}
+
+// readRange parses the range file of prefix p into sorted records
+func readRange(srcDir string, p int) ([][]byte, int64, error) {
+	prefix := fmt.Sprintf("%05X", p)
+	f, err := os.Open(filepath.Join(srcDir, prefix+".txt"))
+	if errors.Is(err, os.ErrNotExist) {
+		f, err = os.Open(filepath.Join(srcDir, strings.ToLower(prefix)+".txt"))
+	}
+	if err != nil {
+		return nil, 0, err
+	}
+	defer func() { _ = f.Close() }()
+
+	var records [][]byte
+	var skipped int64
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		suffix, countText, ok := strings.Cut(line, ":")
+		count, err := strconv.ParseUint(countText, 10, 64)
+		if !ok || len(suffix) != hex.EncodedLen(sha1.Size)-5 || err != nil || count == 0 {
+			skipped++
+			continue
+		}
+		hash, err := hex.DecodeString(prefix + suffix)
+		if err != nil {
+			skipped++
+			continue
+		}
+
+		record := make([]byte, recordSize)
+		copy(record, hash[hashOffset:])
+		binary.BigEndian.PutUint32(record[hashPart:], uint32(min(count, math.MaxUint32)))
+		records = append(records, record)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, 0, fmt.Errorf("range %s: %w", prefix, err)
+	}
+
+	sort.Slice(records, func(i, j int) bool {
+		return bytes.Compare(records[i][:hashPart], records[j][:hashPart]) < 0
+	})
+	return records, skipped, nil
+}
@@ -0,0 +1,81 @@
+package entrytype
+
+import (
+	"errors"
+	"github.com/AleksZelenchuk/vault-server/gen/go/vaultpb"
+	"strings"
+)
+
+// validateCard checks expiry and, unless they are client encrypted, the number and code. The number is
+// stored without separators and the brand is detected from it when not given
+func validateCard(c *vaultpb.Card, clientEncrypted bool) error {
+	if c.ExpMonth < 0 || c.ExpMonth > 12 {
+		return errors.New("exp_month must be between 1 and 12")
+	}
+	if c.ExpYear != 0 && (c.ExpYear < 1000 || c.ExpYear > 9999) {
+		return errors.New("exp_year must have four digits")
+	}
+	if clientEncrypted {
+		return nil
+	}
+
+	c.Number = strings.NewReplacer(" ", "", "-", "").Replace(c.Number)
+	if len(c.Number) < 12 || len(c.Number) > 19 || !digits(c.Number) || !luhn(c.Number) {
+		return errors.New("card number is invalid")
+	}
+	if c.Code != "" && (len(c.Code) < 3 || len(c.Code) > 4 || !digits(c.Code)) {
+		return errors.New("card code must have 3 or 4 digits")
+	}
+	if c.Brand == "" {
+		c.Brand = brand(c.Number)
+	}
+	return nil
+}
+
+func digits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// luhn reports whether the check digit of number is valid
+func luhn(number string) bool {
+	sum := 0
+	for i := len(number) - 1; i >= 0; i-- {
+		d := int(number[i] - '0')
+		if (len(number)-i)%2 == 0 {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+	}
+	return sum%10 == 0
+}
+
+// brand detects the card network from the number's prefix, it returns "" for unknown networks
+func brand(number string) string {
+	prefix := func(from, to string) bool {
+		p := number[:len(from)]
+		return p >= from && p <= to
+	}
+	switch {
+	case number[0] == '4':
+		return "Visa"
+	case prefix("51", "55"), prefix("2221", "2720"):
+		return "Mastercard"
+	case prefix("34", "34"), prefix("37", "37"):
+		return "American Express"
+	case prefix("6011", "6011"), prefix("65", "65"), prefix("644", "649"):
+		return "Discover"
+	case prefix("3528", "3589"):
+		return "JCB"
+	case prefix("36", "36"), prefix("300", "305"):
+		return "Diners Club"
+	}
+	return ""
+}
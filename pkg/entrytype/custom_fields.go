@@ -0,0 +1,110 @@
+package entrytype
+
+import (
+	"errors"
+	"fmt"
+	"github.com/AleksZelenchuk/vault-server/gen/go/vaultpb"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"strings"
+)
+
+const (
+	MaxCustomFields         = 64
+	MaxCustomFieldNameLen   = 128
+	MaxCustomFieldValueSize = 8 << 10
+)
+
+// CustomFieldName returns the storage name of t, e.g. "hidden" for CUSTOM_FIELD_TYPE_HIDDEN
+func CustomFieldName(t vaultpb.CustomFieldType) string {
+	return lowerName(t.String(), "CUSTOM_FIELD_TYPE_")
+}
+
+// CustomFieldFromName is the inverse of CustomFieldName, unknown names map to CUSTOM_FIELD_TYPE_TEXT
+func CustomFieldFromName(name string) vaultpb.CustomFieldType {
+	return vaultpb.CustomFieldType(vaultpb.CustomFieldType_value["CUSTOM_FIELD_TYPE_"+strings.ToUpper(name)])
+}
+
+// HiddenFields returns pointers to the values of e's hidden custom fields keyed by field name
+func HiddenFields(e *vaultpb.VaultEntry) map[string]*string {
+	var hidden map[string]*string
+	for _, f := range e.CustomFields {
+		if f.Type != vaultpb.CustomFieldType_CUSTOM_FIELD_TYPE_HIDDEN {
+			continue
+		}
+		if hidden == nil {
+			hidden = make(map[string]*string)
+		}
+		hidden[f.Name] = &f.Value
+	}
+	return hidden
+}
+
+// validateCustomFields checks names are unique and values fit their type, boolean values are normalized
+// to "true" or "false"
+func validateCustomFields(e *vaultpb.VaultEntry) error {
+	if len(e.CustomFields) > MaxCustomFields {
+		return fmt.Errorf("at most %d custom fields are allowed", MaxCustomFields)
+	}
+	seen := make(map[string]bool, len(e.CustomFields))
+	for _, f := range e.CustomFields {
+		if f.Name == "" || len(f.Name) > MaxCustomFieldNameLen {
+			return fmt.Errorf("custom field names must have 1 to %d characters", MaxCustomFieldNameLen)
+		}
+		if seen[f.Name] {
+			return fmt.Errorf("custom field %q is defined twice", f.Name)
+		}
+		seen[f.Name] = true
+		if len(f.Value) > MaxCustomFieldValueSize {
+			return fmt.Errorf("custom field %q is too long", f.Name)
+		}
+
+		switch f.Type {
+		case vaultpb.CustomFieldType_CUSTOM_FIELD_TYPE_TEXT, vaultpb.CustomFieldType_CUSTOM_FIELD_TYPE_HIDDEN:
+		case vaultpb.CustomFieldType_CUSTOM_FIELD_TYPE_BOOLEAN:
+			switch f.Value {
+			case "", "false":
+				f.Value = "false"
+			case "true":
+			default:
+				return fmt.Errorf("custom field %q must be true or false", f.Name)
+			}
+		case vaultpb.CustomFieldType_CUSTOM_FIELD_TYPE_LINKED:
+			if !linkable(e, f.Value) {
+				return fmt.Errorf("custom field %q links to unknown field %q", f.Name, f.Value)
+			}
+		default:
+			return errors.New("unknown custom field type")
+		}
+	}
+	return nil
+}
+
+// linkable reports whether a linked custom field of e may mirror the named field: the common entry
+// fields, the password of logins and any field of the entry's payload
+func linkable(e *vaultpb.VaultEntry, name string) bool {
+	switch name {
+	case "title", "username", "notes", "domain":
+		return true
+	case "password":
+		return e.Type == vaultpb.EntryType_ENTRY_TYPE_LOGIN
+	}
+	payload := payloadMessage(e)
+	if payload == nil {
+		return false
+	}
+	return payload.Descriptor().Fields().ByName(protoreflect.Name(name)) != nil
+}
+
+func payloadMessage(e *vaultpb.VaultEntry) protoreflect.Message {
+	switch p := e.Payload.(type) {
+	case *vaultpb.VaultEntry_Card:
+		return p.Card.ProtoReflect()
+	case *vaultpb.VaultEntry_Identity:
+		return p.Identity.ProtoReflect()
+	case *vaultpb.VaultEntry_SshKey:
+		return p.SshKey.ProtoReflect()
+	case *vaultpb.VaultEntry_ApiKey:
+		return p.ApiKey.ProtoReflect()
+	}
+	return nil
+}
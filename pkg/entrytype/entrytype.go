@@ -0,0 +1,188 @@
+// Package entrytype holds the rules of the typed vault entries: how a type is named in storage, which
+// payload fields are secret and how each payload is validated.
+package entrytype
+
+import (
+	"errors"
+	"fmt"
+	"github.com/AleksZelenchuk/vault-server/gen/go/vaultpb"
+	"google.golang.org/protobuf/proto"
+	"strings"
+)
+
+// Name returns the storage name of t, e.g. "ssh_key" for ENTRY_TYPE_SSH_KEY
+func Name(t vaultpb.EntryType) string {
+	return lowerName(t.String(), "ENTRY_TYPE_")
+}
+
+// FromName is the inverse of Name, unknown names map to ENTRY_TYPE_LOGIN
+func FromName(name string) vaultpb.EntryType {
+	return vaultpb.EntryType(vaultpb.EntryType_value["ENTRY_TYPE_"+strings.ToUpper(name)])
+}
+
+func lowerName(enumName string, prefix string) string {
+	return strings.ToLower(strings.TrimPrefix(enumName, prefix))
+}
+
+// Valid reports whether t is a known entry type
+func Valid(t vaultpb.EntryType) bool {
+	_, ok := vaultpb.EntryType_name[int32(t)]
+	return ok
+}
+
+// payloadType returns the type implied by the payload set on e, ok is false when it has none
+func payloadType(e *vaultpb.VaultEntry) (vaultpb.EntryType, bool) {
+	switch e.Payload.(type) {
+	case *vaultpb.VaultEntry_Card:
+		return vaultpb.EntryType_ENTRY_TYPE_CARD, true
+	case *vaultpb.VaultEntry_Identity:
+		return vaultpb.EntryType_ENTRY_TYPE_IDENTITY, true
+	case *vaultpb.VaultEntry_SshKey:
+		return vaultpb.EntryType_ENTRY_TYPE_SSH_KEY, true
+	case *vaultpb.VaultEntry_ApiKey:
+		return vaultpb.EntryType_ENTRY_TYPE_API_KEY, true
+	}
+	return 0, false
+}
+
+// HasPayload reports whether entries of type t carry a typed payload
+func HasPayload(t vaultpb.EntryType) bool {
+	return t != vaultpb.EntryType_ENTRY_TYPE_LOGIN && t != vaultpb.EntryType_ENTRY_TYPE_SECURE_NOTE
+}
+
+// PayloadSecrets returns pointers to the secret fields of e's payload keyed by the field that reveals them
+func PayloadSecrets(e *vaultpb.VaultEntry) map[vaultpb.SecretField]*string {
+	switch p := e.Payload.(type) {
+	case *vaultpb.VaultEntry_Card:
+		return map[vaultpb.SecretField]*string{
+			vaultpb.SecretField_SECRET_FIELD_CARD_NUMBER: &p.Card.Number,
+			vaultpb.SecretField_SECRET_FIELD_CARD_CODE:   &p.Card.Code,
+		}
+	case *vaultpb.VaultEntry_Identity:
+		return map[vaultpb.SecretField]*string{
+			vaultpb.SecretField_SECRET_FIELD_IDENTITY_SSN:             &p.Identity.Ssn,
+			vaultpb.SecretField_SECRET_FIELD_IDENTITY_PASSPORT_NUMBER: &p.Identity.PassportNumber,
+			vaultpb.SecretField_SECRET_FIELD_IDENTITY_LICENSE_NUMBER:  &p.Identity.LicenseNumber,
+		}
+	case *vaultpb.VaultEntry_SshKey:
+		return map[vaultpb.SecretField]*string{vaultpb.SecretField_SECRET_FIELD_SSH_PRIVATE_KEY: &p.SshKey.PrivateKey}
+	case *vaultpb.VaultEntry_ApiKey:
+		return map[vaultpb.SecretField]*string{vaultpb.SecretField_SECRET_FIELD_API_KEY_SECRET: &p.ApiKey.Secret}
+	}
+	return nil
+}
+
+// MarshalPayload serializes the payload of e, it returns nil for types without payload
+func MarshalPayload(e *vaultpb.VaultEntry) ([]byte, error) {
+	m := payloadMessage(e)
+	if m == nil {
+		return nil, nil
+	}
+	return proto.Marshal(m.Interface())
+}
+
+// UnmarshalPayload sets the payload of e from data serialized by MarshalPayload, e.Type selects the message
+func UnmarshalPayload(e *vaultpb.VaultEntry, data []byte) error {
+	if len(data) == 0 {
+		return nil
+	}
+	switch e.Type {
+	case vaultpb.EntryType_ENTRY_TYPE_CARD:
+		p := &vaultpb.Card{}
+		e.Payload = &vaultpb.VaultEntry_Card{Card: p}
+		return proto.Unmarshal(data, p)
+	case vaultpb.EntryType_ENTRY_TYPE_IDENTITY:
+		p := &vaultpb.Identity{}
+		e.Payload = &vaultpb.VaultEntry_Identity{Identity: p}
+		return proto.Unmarshal(data, p)
+	case vaultpb.EntryType_ENTRY_TYPE_SSH_KEY:
+		p := &vaultpb.SshKey{}
+		e.Payload = &vaultpb.VaultEntry_SshKey{SshKey: p}
+		return proto.Unmarshal(data, p)
+	case vaultpb.EntryType_ENTRY_TYPE_API_KEY:
+		p := &vaultpb.ApiKey{}
+		e.Payload = &vaultpb.VaultEntry_ApiKey{ApiKey: p}
+		return proto.Unmarshal(data, p)
+	}
+	return fmt.Errorf("entry type %s has no payload", e.Type)
+}
+
+// Redact clears the secret payload fields and hidden custom field values of e before it is returned in
+// a listing, cards keep the last four digits of their number
+func Redact(e *vaultpb.VaultEntry) {
+	if c := e.GetCard(); c != nil && len(c.Number) >= 4 && !e.ClientEncrypted {
+		c.LastFour = c.Number[len(c.Number)-4:]
+	}
+	for _, f := range PayloadSecrets(e) {
+		*f = ""
+	}
+	for _, f := range HiddenFields(e) {
+		*f = ""
+	}
+}
+
+// KeepSecrets fills the empty secret payload fields and hidden custom field values of e from stored, so
+// updates do not need to resend secrets they did not change. Both entries must have the same type
+func KeepSecrets(e *vaultpb.VaultEntry, stored *vaultpb.VaultEntry) {
+	old := PayloadSecrets(stored)
+	for field, value := range PayloadSecrets(e) {
+		if *value == "" && old[field] != nil {
+			*value = *old[field]
+		}
+	}
+	oldHidden := HiddenFields(stored)
+	for name, value := range HiddenFields(e) {
+		if *value == "" && oldHidden[name] != nil {
+			*value = *oldHidden[name]
+		}
+	}
+}
+
+// Validate checks e against the rules of its type and normalizes its payload in place. The password of
+// login entries is not checked, it may be omitted on update
+func Validate(e *vaultpb.VaultEntry) error {
+	if !Valid(e.Type) {
+		return errors.New("unknown entry type")
+	}
+	if e.Title == "" {
+		return errors.New("title is required")
+	}
+	if e.Type != vaultpb.EntryType_ENTRY_TYPE_LOGIN && e.Password != "" {
+		return errors.New("password is only supported on login entries")
+	}
+	if t, ok := payloadType(e); ok != HasPayload(e.Type) || (ok && t != e.Type) {
+		return fmt.Errorf("%s entries require a matching payload", Name(e.Type))
+	}
+	if err := validateCustomFields(e); err != nil {
+		return err
+	}
+
+	switch e.Type {
+	case vaultpb.EntryType_ENTRY_TYPE_LOGIN:
+		if e.Username == "" {
+			return errors.New("username is required")
+		}
+	case vaultpb.EntryType_ENTRY_TYPE_SECURE_NOTE:
+		if e.Notes == "" {
+			return errors.New("notes are required")
+		}
+	case vaultpb.EntryType_ENTRY_TYPE_CARD:
+		return validateCard(e.GetCard(), e.ClientEncrypted)
+	case vaultpb.EntryType_ENTRY_TYPE_IDENTITY:
+		i := e.GetIdentity()
+		if i.FirstName == "" && i.LastName == "" && i.Email == "" && i.Company == "" {
+			return errors.New("identity requires a name, email or company")
+		}
+	case vaultpb.EntryType_ENTRY_TYPE_SSH_KEY:
+		return validateSshKey(e.GetSshKey(), e.ClientEncrypted)
+	case vaultpb.EntryType_ENTRY_TYPE_API_KEY:
+		k := e.GetApiKey()
+		if k.Secret == "" {
+			return errors.New("api key secret is required")
+		}
+		if k.ExpiresAt < 0 {
+			return errors.New("expires_at must not be negative")
+		}
+	}
+	return nil
+}
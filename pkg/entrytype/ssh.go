@@ -0,0 +1,60 @@
+package entrytype
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"github.com/AleksZelenchuk/vault-server/gen/go/vaultpb"
+	"golang.org/x/crypto/ssh"
+	"strings"
+)
+
+// validateSshKey parses the key pair and fills in the public key and its fingerprint. Passphrase
+// protected private keys are accepted, their public key is taken from the key file when it has one
+func validateSshKey(k *vaultpb.SshKey, clientEncrypted bool) error {
+	if k.PrivateKey == "" {
+		return errors.New("ssh private key is required")
+	}
+
+	var pub ssh.PublicKey
+	if k.PublicKey != "" {
+		parsed, _, _, _, err := ssh.ParseAuthorizedKey([]byte(k.PublicKey))
+		if err != nil {
+			return fmt.Errorf("ssh public key is invalid: %v", err)
+		}
+		pub = parsed
+	}
+
+	if !clientEncrypted {
+		derived, err := privateKeyPublic([]byte(k.PrivateKey))
+		if err != nil {
+			return err
+		}
+		if pub != nil && derived != nil && !bytes.Equal(pub.Marshal(), derived.Marshal()) {
+			return errors.New("ssh public key does not match the private key")
+		}
+		if derived != nil {
+			pub = derived
+		}
+	}
+
+	if pub != nil {
+		k.PublicKey = strings.TrimSpace(string(ssh.MarshalAuthorizedKey(pub)))
+		k.Fingerprint = ssh.FingerprintSHA256(pub)
+	}
+	return nil
+}
+
+// privateKeyPublic returns the public half of a PEM or OpenSSH private key, nil when the key is
+// encrypted and its file does not carry the public key
+func privateKeyPublic(pemBytes []byte) (ssh.PublicKey, error) {
+	signer, err := ssh.ParsePrivateKey(pemBytes)
+	var missing *ssh.PassphraseMissingError
+	if errors.As(err, &missing) {
+		return missing.PublicKey, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("ssh private key is invalid: %v", err)
+	}
+	return signer.PublicKey(), nil
+}
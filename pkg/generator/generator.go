@@ -0,0 +1,263 @@
+// Package generator creates random passwords and diceware passphrases and checks passwords
+// against generation policies.
+//
+// Passphrases use the EFF large wordlist (https://www.eff.org/dice), licensed under CC BY 3.0 US.
+package generator
+
+import (
+	"bufio"
+	"crypto/rand"
+	_ "embed"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+const (
+	Lowercase = "abcdefghijklmnopqrstuvwxyz"
+	Uppercase = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+	Digits    = "0123456789"
+	Symbols   = "!@#$%^&*-_=+?~;:,.()[]{}<>/|"
+	// Ambiguous characters are easily confused when read or typed by hand
+	Ambiguous = "Il1O0o;:,.()[]{}<>/|"
+
+	MinLength = 4
+	MaxLength = 1024
+	MinWords  = 3
+	MaxWords  = 64
+)
+
+//go:embed eff_large_wordlist.txt
+var wordlistFile string
+
+var loadWords = sync.OnceValue(func() []string {
+	var words []string
+	scanner := bufio.NewScanner(strings.NewReader(wordlistFile))
+	for scanner.Scan() {
+		// "<dice rolls>\t<word>"
+		if _, word, ok := strings.Cut(scanner.Text(), "\t"); ok {
+			words = append(words, word)
+		}
+	}
+	return words
+})
+
+// Words returns the embedded diceware word list
+func Words() []string {
+	return loadWords()
+}
+
+// Options control random password generation, when no character class is selected all are used
+type Options struct {
+	Length           int
+	Lowercase        bool
+	Uppercase        bool
+	Digits           bool
+	Symbols          bool
+	ExcludeAmbiguous bool
+}
+
+// PassphraseOptions control diceware passphrase generation
+type PassphraseOptions struct {
+	Words         int
+	Separator     string
+	Capitalize    bool
+	IncludeNumber bool
+}
+
+// Policy is a password policy, the zero value allows everything
+type Policy struct {
+	MinLength        int
+	RequireLowercase bool
+	RequireUppercase bool
+	RequireDigits    bool
+	RequireSymbols   bool
+	MinWords         int
+}
+
+// Password generates a random password containing at least one character of every selected class
+// and returns it with its entropy in bits
+func Password(o Options) (string, float64, error) {
+	if o.Length < MinLength || o.Length > MaxLength {
+		return "", 0, fmt.Errorf("length must be between %d and %d", MinLength, MaxLength)
+	}
+	if !o.Lowercase && !o.Uppercase && !o.Digits && !o.Symbols {
+		o.Lowercase, o.Uppercase, o.Digits, o.Symbols = true, true, true, true
+	}
+
+	var classes []string
+	for _, c := range []struct {
+		enabled bool
+		set     string
+	}{{o.Lowercase, Lowercase}, {o.Uppercase, Uppercase}, {o.Digits, Digits}, {o.Symbols, Symbols}} {
+		if !c.enabled {
+			continue
+		}
+		set := c.set
+		if o.ExcludeAmbiguous {
+			set = removeChars(set, Ambiguous)
+		}
+		classes = append(classes, set)
+	}
+	if len(classes) > o.Length {
+		return "", 0, errors.New("length is too short for the selected character classes")
+	}
+	all := strings.Join(classes, "")
+
+	out := make([]byte, 0, o.Length)
+	for _, set := range classes {
+		c, err := pick(set)
+		if err != nil {
+			return "", 0, err
+		}
+		out = append(out, c)
+	}
+	for len(out) < o.Length {
+		c, err := pick(all)
+		if err != nil {
+			return "", 0, err
+		}
+		out = append(out, c)
+	}
+	if err := shuffle(out); err != nil {
+		return "", 0, err
+	}
+
+	return string(out), float64(o.Length) * math.Log2(float64(len(all))), nil
+}
+
+// Passphrase generates a diceware passphrase and returns it with its entropy in bits
+func Passphrase(o PassphraseOptions) (string, float64, error) {
+	if o.Words < MinWords || o.Words > MaxWords {
+		return "", 0, fmt.Errorf("words must be between %d and %d", MinWords, MaxWords)
+	}
+	list := Words()
+
+	words := make([]string, o.Words)
+	for i := range words {
+		n, err := randInt(len(list))
+		if err != nil {
+			return "", 0, err
+		}
+		words[i] = list[n]
+		if o.Capitalize {
+			words[i] = strings.ToUpper(words[i][:1]) + words[i][1:]
+		}
+	}
+	entropy := float64(o.Words) * math.Log2(float64(len(list)))
+
+	if o.IncludeNumber {
+		w, err := randInt(len(words))
+		if err != nil {
+			return "", 0, err
+		}
+		d, err := randInt(10)
+		if err != nil {
+			return "", 0, err
+		}
+		words[w] += string(Digits[d])
+		entropy += math.Log2(float64(len(words) * 10))
+	}
+
+	return strings.Join(words, o.Separator), entropy, nil
+}
+
+// ApplyToOptions raises o so generated passwords satisfy p
+func (p Policy) ApplyToOptions(o Options) Options {
+	if o.Length < p.MinLength {
+		o.Length = p.MinLength
+	}
+	anySelected := o.Lowercase || o.Uppercase || o.Digits || o.Symbols
+	if anySelected {
+		o.Lowercase = o.Lowercase || p.RequireLowercase
+		o.Uppercase = o.Uppercase || p.RequireUppercase
+		o.Digits = o.Digits || p.RequireDigits
+		o.Symbols = o.Symbols || p.RequireSymbols
+	}
+	return o
+}
+
+// ApplyToPassphrase raises o so generated passphrases satisfy p
+func (p Policy) ApplyToPassphrase(o PassphraseOptions) PassphraseOptions {
+	if o.Words < p.MinWords {
+		o.Words = p.MinWords
+	}
+	o.Capitalize = o.Capitalize || p.RequireUppercase
+	o.IncludeNumber = o.IncludeNumber || p.RequireDigits
+	if p.RequireSymbols && !strings.ContainsAny(o.Separator, Symbols) {
+		o.Separator = "-"
+	}
+	if p.MinLength > 0 {
+		// the shortest word in the EFF list has 3 letters
+		for o.Words*3+(o.Words-1)*len(o.Separator) < p.MinLength && o.Words < MaxWords {
+			o.Words++
+		}
+	}
+	return o
+}
+
+// Check returns the rules of p that password violates, nil when it complies
+func (p Policy) Check(password string) []string {
+	var violations []string
+	if len([]rune(password)) < p.MinLength {
+		violations = append(violations, fmt.Sprintf("must be at least %d characters long", p.MinLength))
+	}
+	if p.RequireLowercase && !strings.ContainsFunc(password, unicode.IsLower) {
+		violations = append(violations, "must contain a lowercase letter")
+	}
+	if p.RequireUppercase && !strings.ContainsFunc(password, unicode.IsUpper) {
+		violations = append(violations, "must contain an uppercase letter")
+	}
+	if p.RequireDigits && !strings.ContainsFunc(password, unicode.IsDigit) {
+		violations = append(violations, "must contain a digit")
+	}
+	if p.RequireSymbols && !strings.ContainsFunc(password, isSymbol) {
+		violations = append(violations, "must contain a symbol")
+	}
+	return violations
+}
+
+func isSymbol(r rune) bool {
+	return !unicode.IsLetter(r) && !unicode.IsDigit(r) && !unicode.IsSpace(r)
+}
+
+func removeChars(set, remove string) string {
+	return strings.Map(func(r rune) rune {
+		if strings.ContainsRune(remove, r) {
+			return -1
+		}
+		return r
+	}, set)
+}
+
+func pick(set string) (byte, error) {
+	n, err := randInt(len(set))
+	if err != nil {
+		return 0, err
+	}
+	return set[n], nil
+}
+
+// shuffle is a Fisher-Yates shuffle driven by crypto/rand
+func shuffle(b []byte) error {
+	for i := len(b) - 1; i > 0; i-- {
+		j, err := randInt(i + 1)
+		if err != nil {
+			return err
+		}
+		b[i], b[j] = b[j], b[i]
+	}
+	return nil
+}
+
+func randInt(n int) (int, error) {
+	v, err := rand.Int(rand.Reader, big.NewInt(int64(n)))
+	if err != nil {
+		return 0, err
+	}
+	return int(v.Int64()), nil
+}
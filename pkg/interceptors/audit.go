@@ -0,0 +1,42 @@
+package interceptors
+
+import (
+	"context"
+	"log"
+
+	"github.com/AleksZelenchuk/vault-server/pkg/audit"
+	"google.golang.org/grpc"
+)
+
+// UnaryAuditInterceptor records every unary call with its outcome, it must be chained after the auth
+// interceptor so the actor is known. Events are queued so the call never waits on the audit chain lock
+func UnaryAuditInterceptor(auditor *audit.Auditor) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		resp, err := handler(ctx, req)
+		if recErr := auditor.RecordAsync(ctx, info.FullMethod, "", err); recErr != nil {
+			log.Printf("audit: failed to record %s: %v", info.FullMethod, recErr)
+		}
+		return resp, err
+	}
+}
+
+// StreamAuditInterceptor queues an event for every streaming call once it finishes
+func StreamAuditInterceptor(auditor *audit.Auditor) grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		err := handler(srv, ss)
+		if recErr := auditor.RecordAsync(ss.Context(), info.FullMethod, "", err); recErr != nil {
+			log.Printf("audit: failed to record %s: %v", info.FullMethod, recErr)
+		}
+		return err
+	}
+}
@@ -0,0 +1,43 @@
+package interceptors
+
+import (
+	"context"
+	"net"
+
+	"github.com/AleksZelenchuk/vault-server/pkg/auth"
+	"github.com/AleksZelenchuk/vault-server/pkg/ratelimit"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// UnaryRateLimitInterceptor applies a separate limiter per full method name, methods without a limiter
+// are not limited. Callers are identified by user id, or by peer address before authentication
+func UnaryRateLimitInterceptor(limits map[string]*ratelimit.Limiter) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		limiter, ok := limits[info.FullMethod]
+		if ok && !limiter.Allow(rateLimitKey(ctx)) {
+			return nil, status.Error(codes.ResourceExhausted, "rate limit exceeded")
+		}
+		return handler(ctx, req)
+	}
+}
+
+func rateLimitKey(ctx context.Context) string {
+	if uid, ok := auth.ActorIDFromContext(ctx); ok {
+		return uid
+	}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		if host, _, err := net.SplitHostPort(p.Addr.String()); err == nil {
+			return host
+		}
+		return p.Addr.String()
+	}
+	return ""
+}
@@ -0,0 +1,30 @@
+package notify
+
+import (
+	"context"
+	"log"
+)
+
+// Notification is a message addressed to a single vault user
+type Notification struct {
+	UserID  string
+	Subject string
+	Body    string
+}
+
+// Notifier delivers notifications to users, implementations may send e-mails, push messages etc.
+type Notifier interface {
+	Notify(ctx context.Context, n Notification) error
+}
+
+// LogNotifier writes notifications to the server log, used when no other delivery channel is configured
+type LogNotifier struct{}
+
+func NewLogNotifier() *LogNotifier {
+	return &LogNotifier{}
+}
+
+func (l *LogNotifier) Notify(_ context.Context, n Notification) error {
+	log.Printf("notify user=%s subject=%q: %s", n.UserID, n.Subject, n.Body)
+	return nil
+}
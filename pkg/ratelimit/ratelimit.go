@@ -0,0 +1,78 @@
+package ratelimit
+
+import (
+	"sync"
+	"time"
+)
+
+// sweepEvery is the number of Allow calls between removals of idle buckets
+const sweepEvery = 1024
+
+type bucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// Limiter is a token bucket rate limiter keeping one bucket per key (usually a user id).
+// Each bucket holds up to burst tokens and refills at perMinute tokens per minute
+type Limiter struct {
+	mu      sync.Mutex
+	rate    float64
+	burst   float64
+	buckets map[string]*bucket
+	calls   int
+}
+
+func NewLimiter(perMinute int, burst int) *Limiter {
+	if burst < 1 {
+		burst = 1
+	}
+	return &Limiter{
+		rate:    float64(perMinute) / 60,
+		burst:   float64(burst),
+		buckets: make(map[string]*bucket),
+	}
+}
+
+// Allow takes a token from the bucket of key and reports whether one was available
+func (l *Limiter) Allow(key string) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	l.calls++
+	if l.calls%sweepEvery == 0 {
+		l.sweep(now)
+	}
+
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &bucket{tokens: l.burst, last: now}
+		l.buckets[key] = b
+	}
+	b.tokens = l.refill(b, now)
+	b.last = now
+
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
+
+func (l *Limiter) refill(b *bucket, now time.Time) float64 {
+	tokens := b.tokens + now.Sub(b.last).Seconds()*l.rate
+	if tokens > l.burst {
+		return l.burst
+	}
+	return tokens
+}
+
+// sweep forgets buckets that refilled completely, they behave exactly like new ones
+func (l *Limiter) sweep(now time.Time) {
+	for key, b := range l.buckets {
+		if l.refill(b, now) >= l.burst {
+			delete(l.buckets, key)
+		}
+	}
+}
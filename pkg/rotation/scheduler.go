@@ -0,0 +1,84 @@
+// Package rotation reminds users of passwords that are due for rotation.
+package rotation
+
+import (
+	"context"
+	"fmt"
+	"github.com/AleksZelenchuk/vault-server/pkg/notify"
+	"github.com/AleksZelenchuk/vault-server/pkg/storage"
+	"github.com/google/uuid"
+	"log"
+	"strings"
+	"time"
+)
+
+// Scheduler periodically sends one "rotation due" notification per user listing their due entries.
+// Each entry is announced once per password, changing the password re-arms it
+type Scheduler struct {
+	store    *storage.Store
+	notifier notify.Notifier
+}
+
+func NewScheduler(store *storage.Store, notifier notify.Notifier) *Scheduler {
+	return &Scheduler{store: store, notifier: notifier}
+}
+
+// Run checks for due entries every interval until ctx is cancelled
+func (s *Scheduler) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if err := s.RunOnce(ctx); err != nil {
+			log.Printf("rotation: check failed: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunOnce notifies the owners of all due entries that were not announced yet
+func (s *Scheduler) RunOnce(ctx context.Context) error {
+	entries, err := s.store.ListRotationNotificationsDue(ctx)
+	if err != nil {
+		return err
+	}
+
+	// entries are ordered by user
+	for start := 0; start < len(entries); {
+		end := start
+		for end < len(entries) && entries[end].UserId == entries[start].UserId {
+			end++
+		}
+		s.notifyUser(ctx, entries[start:end])
+		start = end
+	}
+	return nil
+}
+
+// notifyUser sends a single notification for the due entries of one user and marks them as notified.
+// Entries stay unmarked when delivery fails, so they are retried on the next run
+func (s *Scheduler) notifyUser(ctx context.Context, entries []storage.Entry) {
+	userId := entries[0].UserId
+	lines := make([]string, 0, len(entries))
+	ids := make([]uuid.UUID, 0, len(entries))
+	for _, e := range entries {
+		lines = append(lines, fmt.Sprintf("%s (%s) was due on %s", e.Title, e.Username, e.RotationDueAt.Time.Format(time.DateOnly)))
+		ids = append(ids, e.ID)
+	}
+
+	subject := "A password is due for rotation"
+	if len(entries) > 1 {
+		subject = fmt.Sprintf("%d passwords are due for rotation", len(entries))
+	}
+	err := s.notifier.Notify(ctx, notify.Notification{UserID: userId, Subject: subject, Body: strings.Join(lines, "\n")})
+	if err != nil {
+		log.Printf("rotation: failed to notify user %s: %v", userId, err)
+		return
+	}
+	if err := s.store.MarkRotationNotified(ctx, ids); err != nil {
+		log.Printf("rotation: failed to mark entries of user %s as notified: %v", userId, err)
+	}
+}
@@ -0,0 +1,132 @@
+// Package search parses the query language of SearchEntries: free text words combined with title:,
+// user:, tag:, folder: and domain: terms. A domain: term matches entries with a URI in the registrable
+// domain of its value. Values containing spaces are quoted, e.g. title:"my bank".
+// All terms must match.
+package search
+
+import (
+	"errors"
+	"fmt"
+	"github.com/AleksZelenchuk/vault-server/pkg/urimatch"
+	"strings"
+	"unicode"
+)
+
+const (
+	MaxQueryLen = 1024
+	MaxTerms    = 32
+)
+
+var ErrEmptyQuery = errors.New("search query is empty")
+
+// Query is a parsed search query
+type Query struct {
+	// Text words match the title, username, domain or tags by prefix
+	Text    []string
+	Title   []string
+	User    []string
+	Tags    []string
+	Folders []string
+	// Domains are registrable domains, see urimatch.Domain
+	Domains []string
+}
+
+// Parse parses a search query. Words with an unknown prefix, such as "https://example.com", are free text
+func Parse(s string) (*Query, error) {
+	if len(s) > MaxQueryLen {
+		return nil, fmt.Errorf("search query must be at most %d bytes", MaxQueryLen)
+	}
+	q := &Query{}
+	terms := 0
+	for rest := strings.TrimSpace(s); rest != ""; rest = strings.TrimLeftFunc(rest, unicode.IsSpace) {
+		var key, value string
+		var err error
+		key, value, rest, err = nextTerm(rest)
+		if err != nil {
+			return nil, err
+		}
+		if value == "" {
+			if key != "" {
+				return nil, fmt.Errorf("%s: needs a value", key)
+			}
+			continue
+		}
+		if terms++; terms > MaxTerms {
+			return nil, fmt.Errorf("search query must have at most %d terms", MaxTerms)
+		}
+
+		switch key {
+		case "":
+			q.Text = append(q.Text, value)
+		case "title":
+			q.Title = append(q.Title, value)
+		case "user":
+			q.User = append(q.User, value)
+		case "tag":
+			q.Tags = append(q.Tags, value)
+		case "folder":
+			q.Folders = append(q.Folders, value)
+		case "domain":
+			domain, err := urimatch.Domain(value)
+			if err != nil {
+				return nil, fmt.Errorf("domain: invalid domain %q", value)
+			}
+			q.Domains = append(q.Domains, domain)
+		}
+	}
+	if terms == 0 {
+		return nil, ErrEmptyQuery
+	}
+	return q, nil
+}
+
+// nextTerm splits the first term off s, key is empty for free text
+func nextTerm(s string) (key string, value string, rest string, err error) {
+	if k, v, ok := strings.Cut(s, ":"); ok && isKey(strings.ToLower(k)) {
+		key, s = strings.ToLower(k), v
+	}
+	if strings.HasPrefix(s, `"`) {
+		end := strings.IndexByte(s[1:], '"')
+		if end < 0 {
+			return "", "", "", errors.New("unterminated quote in search query")
+		}
+		return key, strings.TrimSpace(s[1 : end+1]), s[end+2:], nil
+	}
+	end := strings.IndexFunc(s, unicode.IsSpace)
+	if end < 0 {
+		end = len(s)
+	}
+	return key, s[:end], s[end:], nil
+}
+
+func isKey(k string) bool {
+	switch k {
+	case "title", "user", "tag", "folder", "domain":
+		return true
+	}
+	return false
+}
+
+// TSQuery returns the text, title and user terms as a Postgres tsquery for the 'simple' configuration,
+// every term matches by prefix and title and user terms only match their weight. It is empty when the
+// query has none of these terms
+func (q *Query) TSQuery() string {
+	var parts []string
+	for _, t := range q.Text {
+		parts = append(parts, tsTerm(t, ""))
+	}
+	for _, t := range q.Title {
+		parts = append(parts, tsTerm(t, "A"))
+	}
+	for _, t := range q.User {
+		parts = append(parts, tsTerm(t, "B"))
+	}
+	return strings.Join(parts, " & ")
+}
+
+// tsTerm quotes value as a tsquery operand, so operators in it are matched literally
+func tsTerm(value string, weight string) string {
+	value = strings.ReplaceAll(value, `\`, `\\`)
+	value = strings.ReplaceAll(value, `'`, `''`)
+	return "'" + value + "':*" + weight
+}
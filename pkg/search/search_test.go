@@ -0,0 +1,103 @@
+package search
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	cases := []struct {
+		name  string
+		query string
+		want  Query
+	}{
+		{"free text", "bank  login", Query{Text: []string{"bank", "login"}}},
+		{"keys", "title:bank user:alice tag:work folder:Finance", Query{
+			Title: []string{"bank"}, User: []string{"alice"}, Tags: []string{"work"}, Folders: []string{"Finance"},
+		}},
+		{"keys ignore case", "TITLE:bank Tag:work", Query{Title: []string{"bank"}, Tags: []string{"work"}}},
+		{"quoted value", `title:"my bank" folder:"Work/Old Projects"`, Query{
+			Title: []string{"my bank"}, Folders: []string{"Work/Old Projects"},
+		}},
+		{"quoted free text", `"my bank" login`, Query{Text: []string{"my bank", "login"}}},
+		{"quoted value is trimmed", `title:"  bank  "`, Query{Title: []string{"bank"}}},
+		{"text after a quote", `title:"my bank"x`, Query{Title: []string{"my bank"}, Text: []string{"x"}}},
+		{"unknown key is text", "https://bank.com/login note:x", Query{Text: []string{"https://bank.com/login", "note:x"}}},
+		{"colon inside a quote", `"title:bank"`, Query{Text: []string{"title:bank"}}},
+		{"domain is registrable", "domain:https://login.bank.co.uk/x", Query{Domains: []string{"bank.co.uk"}}},
+		{"operators are kept", `a&b|c !d (e) f:*`, Query{Text: []string{"a&b|c", "!d", "(e)", "f:*"}}},
+		{"empty quotes are skipped", `"" bank`, Query{Text: []string{"bank"}}},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got, err := Parse(c.query)
+			if err != nil {
+				t.Fatalf("Parse(%q): %v", c.query, err)
+			}
+			if !reflect.DeepEqual(*got, c.want) {
+				t.Fatalf("Parse(%q) = %+v, want %+v", c.query, *got, c.want)
+			}
+		})
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	cases := []struct {
+		name  string
+		query string
+		want  string
+	}{
+		{"key without value", "title:", "title: needs a value"},
+		{"key followed by space", "title: bank", "title: needs a value"},
+		{"key with empty quotes", `tag:""`, "tag: needs a value"},
+		{"unterminated quote", `title:"my bank`, "unterminated quote"},
+		{"unterminated free text quote", `bank "login`, "unterminated quote"},
+		{"invalid domain", "domain:https://", "invalid domain"},
+		{"too long", strings.Repeat("a", MaxQueryLen+1), "at most"},
+		{"too many terms", strings.Repeat("a ", MaxTerms+1), "at most"},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			_, err := Parse(c.query)
+			if err == nil || !strings.Contains(err.Error(), c.want) {
+				t.Fatalf("Parse = %v, want an error containing %q", err, c.want)
+			}
+		})
+	}
+
+	for _, q := range []string{"", "   ", `""`} {
+		if _, err := Parse(q); !errors.Is(err, ErrEmptyQuery) {
+			t.Errorf("Parse(%q) = %v, want ErrEmptyQuery", q, err)
+		}
+	}
+	if _, err := Parse(strings.TrimSpace(strings.Repeat("a ", MaxTerms))); err != nil {
+		t.Errorf("Parse of %d terms: %v", MaxTerms, err)
+	}
+}
+
+func TestTSQuery(t *testing.T) {
+	cases := []struct {
+		query string
+		want  string
+	}{
+		{"bank", `'bank':*`},
+		{"bank title:login user:alice", `'bank':* & 'login':*A & 'alice':*B`},
+		{`title:"my bank"`, `'my bank':*A`},
+		{"it's", `'it''s':*`},
+		{`a\b`, `'a\\b':*`},
+		{`a\'b`, `'a\\''b':*`},
+		{`'&|!():*`, `'''&|!():*':*`},
+		{"tag:work folder:x domain:bank.com", ``},
+	}
+	for _, c := range cases {
+		q, err := Parse(c.query)
+		if err != nil {
+			t.Fatalf("Parse(%q): %v", c.query, err)
+		}
+		if got := q.TSQuery(); got != c.want {
+			t.Errorf("TSQuery of %q = %s, want %s", c.query, got, c.want)
+		}
+	}
+}
@@ -0,0 +1,321 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/AleksZelenchuk/vault-server/gen/go/vaultpb"
+	"github.com/AleksZelenchuk/vault-server/pkg/attachment"
+	"github.com/AleksZelenchuk/vault-server/pkg/audit"
+	"github.com/AleksZelenchuk/vault-server/pkg/auth"
+	"github.com/AleksZelenchuk/vault-server/pkg/storage"
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"io"
+	"log"
+	"strings"
+)
+
+const maxAttachmentNameLen = 255
+
+// UploadAttachment receives a header followed by content chunks. The content is encrypted frame by frame
+// with a new key of the attachment while it streams into the blob store, the owner's quota is checked
+// as chunks arrive and again when the attachment is recorded
+func (s *VaultService) UploadAttachment(stream grpc.ClientStreamingServer[vaultpb.UploadAttachmentRequest, vaultpb.UploadAttachmentResponse]) error {
+	ctx := stream.Context()
+	_, errValidate := auth.UserIDFromContext(ctx)
+	if errValidate != true {
+		return errors.New("no user id provided")
+	}
+	if s.blobs == nil {
+		return status.Errorf(codes.FailedPrecondition, "attachments are not configured on this server")
+	}
+
+	first, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	header := first.GetHeader()
+	if header == nil {
+		return status.Errorf(codes.InvalidArgument, "the first message must be the header")
+	}
+	ctx, err = s.ownerContext(ctx, header.OwnerId, true)
+	if err != nil {
+		return err
+	}
+	if !validAttachmentName(header.FileName) {
+		return status.Errorf(codes.InvalidArgument, "file_name must have 1 to %d characters and no path separators", maxAttachmentNameLen)
+	}
+	entryId, err := uuid.Parse(header.EntryId)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid entry id")
+	}
+	if _, err := s.store.GetMetadata(ctx, entryId); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return status.Errorf(codes.NotFound, "entry not found")
+		}
+		return status.Errorf(codes.Internal, "database error: %v", err)
+	}
+
+	used, err := s.attachments.Usage(ctx)
+	if err != nil {
+		return status.Errorf(codes.Internal, "database error: %v", err)
+	}
+	if header.Size < 0 || used+header.Size > s.attachmentQuota {
+		return status.Errorf(codes.ResourceExhausted, "attachment quota of %d bytes exceeded", s.attachmentQuota)
+	}
+
+	a := &storage.Attachment{
+		ID:          uuid.New(),
+		EntryId:     entryId,
+		FileName:    header.FileName,
+		ContentType: header.ContentType,
+		Backend:     s.blobs.Name(),
+	}
+	key, err := attachment.NewKey()
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to create attachment key")
+	}
+	defer clear(key)
+
+	ref, err := s.receiveAttachment(ctx, stream, a, key, used)
+	if err == nil {
+		a.BlobRef = ref
+		a.EncryptionKey, err = storage.Encrypt(key)
+		if err == nil {
+			err = s.attachments.Insert(ctx, a, s.attachmentQuota)
+		}
+		if err != nil {
+			s.deleteBlob(a.BlobRef)
+		}
+	}
+	if auditErr := s.auditor.Record(ctx, audit.ActionAttachmentUpload, entryId.String(), err); auditErr != nil {
+		log.Printf("audit: failed to record attachment upload: %v", auditErr)
+	}
+	if err != nil {
+		if errors.Is(err, storage.QuotaExceeded) {
+			return status.Errorf(codes.ResourceExhausted, "attachment quota of %d bytes exceeded", s.attachmentQuota)
+		}
+		if _, ok := status.FromError(err); ok {
+			return err
+		}
+		return status.Errorf(codes.Internal, "failed to store attachment: %v", err)
+	}
+
+	return stream.SendAndClose(&vaultpb.UploadAttachmentResponse{Attachment: attachmentToProto(a)})
+}
+
+// receiveAttachment streams the chunks into a new blob and returns its reference, the blob is removed
+// again when anything fails
+func (s *VaultService) receiveAttachment(ctx context.Context, stream grpc.ClientStreamingServer[vaultpb.UploadAttachmentRequest, vaultpb.UploadAttachmentResponse], a *storage.Attachment, key []byte, used int64) (string, error) {
+	w, err := s.blobs.Create(ctx)
+	if err != nil {
+		return "", err
+	}
+	enc, err := attachment.NewEncrypter(w, key, a.ID)
+	if err != nil {
+		w.Abort()
+		return "", err
+	}
+
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			w.Abort()
+			return "", err
+		}
+		chunk := req.GetChunk()
+		if req.GetHeader() != nil {
+			w.Abort()
+			return "", status.Errorf(codes.InvalidArgument, "the header must only be sent once")
+		}
+		a.Size += int64(len(chunk))
+		if used+a.Size > s.attachmentQuota {
+			w.Abort()
+			return "", storage.QuotaExceeded
+		}
+		if _, err := enc.Write(chunk); err != nil {
+			w.Abort()
+			return "", err
+		}
+	}
+
+	if err := enc.Close(); err != nil {
+		w.Abort()
+		return "", err
+	}
+	return w.Commit()
+}
+
+// DownloadAttachment sends the attachment's metadata followed by its decrypted content in chunks of
+// attachment.ChunkSize. Send blocks under flow control, so a slow client never makes the server buffer
+// more than one chunk, and a cancelled download stops reading the blob
+func (s *VaultService) DownloadAttachment(req *vaultpb.DownloadAttachmentRequest, stream grpc.ServerStreamingServer[vaultpb.DownloadAttachmentResponse]) error {
+	ctx := stream.Context()
+	_, errValidate := auth.UserIDFromContext(ctx)
+	if errValidate != true {
+		return errors.New("no user id provided")
+	}
+	if s.blobs == nil {
+		return status.Errorf(codes.FailedPrecondition, "attachments are not configured on this server")
+	}
+	ctx, err := s.ownerContext(ctx, req.OwnerId, false)
+	if err != nil {
+		return err
+	}
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid attachment id")
+	}
+
+	a, err := s.attachments.Get(ctx, id)
+	entryId := ""
+	if a != nil {
+		entryId = a.EntryId.String()
+	}
+	if auditErr := s.auditor.Record(ctx, audit.ActionAttachmentDownload, entryId, err); auditErr != nil {
+		return status.Errorf(codes.Internal, "failed to record audit event")
+	}
+	if errors.Is(err, sql.ErrNoRows) {
+		return status.Errorf(codes.NotFound, "attachment not found")
+	}
+	if err != nil {
+		return status.Errorf(codes.Internal, "database error: %v", err)
+	}
+	if a.Backend != s.blobs.Name() {
+		return status.Errorf(codes.FailedPrecondition, "attachment is stored in the %s backend", a.Backend)
+	}
+
+	key, err := storage.Decrypt(a.EncryptionKey)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to decrypt attachment key")
+	}
+	defer clear(key)
+	blob, err := s.blobs.Open(ctx, a.BlobRef)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to open attachment: %v", err)
+	}
+	defer func() { _ = blob.Close() }()
+	dec, err := attachment.NewDecrypter(blob, key, a.ID)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to decrypt attachment")
+	}
+
+	if err := stream.Send(&vaultpb.DownloadAttachmentResponse{Data: &vaultpb.DownloadAttachmentResponse_Attachment{Attachment: attachmentToProto(a)}}); err != nil {
+		return err
+	}
+	buf := make([]byte, attachment.ChunkSize)
+	defer clear(buf)
+	for {
+		if err := ctx.Err(); err != nil {
+			return status.FromContextError(err).Err()
+		}
+		n, err := io.ReadFull(dec, buf)
+		if n > 0 {
+			if err := stream.Send(&vaultpb.DownloadAttachmentResponse{Data: &vaultpb.DownloadAttachmentResponse_Chunk{Chunk: buf[:n]}}); err != nil {
+				return err
+			}
+		}
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			return nil
+		}
+		if err != nil {
+			return status.Errorf(codes.DataLoss, "failed to read attachment: %v", err)
+		}
+	}
+}
+
+// ListAttachments returns the attachments of an entry together with the owner's quota usage
+func (s *VaultService) ListAttachments(ctx context.Context, req *vaultpb.ListAttachmentsRequest) (*vaultpb.ListAttachmentsResponse, error) {
+	_, errValidate := auth.UserIDFromContext(ctx)
+	if errValidate != true {
+		return nil, errors.New("no user id provided")
+	}
+	ctx, err := s.ownerContext(ctx, req.OwnerId, false)
+	if err != nil {
+		return nil, err
+	}
+	entryId, err := uuid.Parse(req.EntryId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid entry id")
+	}
+
+	attachments, err := s.attachments.ListByEntry(ctx, entryId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "database error: %v", err)
+	}
+	used, err := s.attachments.Usage(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "database error: %v", err)
+	}
+
+	resp := &vaultpb.ListAttachmentsResponse{UsedBytes: used, QuotaBytes: s.attachmentQuota}
+	for i := range attachments {
+		resp.Attachments = append(resp.Attachments, attachmentToProto(&attachments[i]))
+	}
+	return resp, nil
+}
+
+// DeleteAttachment removes an attachment and its content
+func (s *VaultService) DeleteAttachment(ctx context.Context, req *vaultpb.DeleteAttachmentRequest) (*vaultpb.DeleteAttachmentResponse, error) {
+	_, errValidate := auth.UserIDFromContext(ctx)
+	if errValidate != true {
+		return nil, errors.New("no user id provided")
+	}
+	ctx, err := s.ownerContext(ctx, req.OwnerId, true)
+	if err != nil {
+		return nil, err
+	}
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid attachment id")
+	}
+
+	a, err := s.attachments.Delete(ctx, id)
+	entryId := ""
+	if a != nil {
+		entryId = a.EntryId.String()
+	}
+	if auditErr := s.auditor.Record(ctx, audit.ActionAttachmentDelete, entryId, err); auditErr != nil {
+		log.Printf("audit: failed to record attachment deletion: %v", auditErr)
+	}
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "attachment not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "database error: %v", err)
+	}
+	s.deleteBlob(a.BlobRef)
+	return &vaultpb.DeleteAttachmentResponse{}, nil
+}
+
+// deleteBlob removes attachment content whose metadata is gone, failures only leave an unreachable blob
+// behind and are logged
+func (s *VaultService) deleteBlob(ref string) {
+	if s.blobs == nil || ref == "" {
+		return
+	}
+	if err := s.blobs.Delete(context.Background(), ref); err != nil {
+		log.Printf("attachments: failed to delete blob %s: %v", ref, err)
+	}
+}
+
+func validAttachmentName(name string) bool {
+	return name != "" && len(name) <= maxAttachmentNameLen && !strings.ContainsAny(name, "/\\\x00")
+}
+
+func attachmentToProto(a *storage.Attachment) *vaultpb.Attachment {
+	return &vaultpb.Attachment{
+		Id:          a.ID.String(),
+		EntryId:     a.EntryId.String(),
+		FileName:    a.FileName,
+		ContentType: a.ContentType,
+		Size:        a.Size,
+		CreatedAt:   a.CreatedAt.Unix(),
+	}
+}
@@ -0,0 +1,72 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/AleksZelenchuk/vault-server/gen/go/vaultpb"
+	"github.com/AleksZelenchuk/vault-server/pkg/auth"
+	"github.com/AleksZelenchuk/vault-server/pkg/storage"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"log"
+)
+
+// CheckBreached looks up a candidate password, or the password of one of the caller's entries, in the
+// local breach index
+func (s *VaultService) CheckBreached(ctx context.Context, req *vaultpb.CheckBreachedRequest) (*vaultpb.CheckBreachedResponse, error) {
+	_, errValidate := auth.UserIDFromContext(ctx)
+	if errValidate != true {
+		return nil, errors.New("no user id provided")
+	}
+	if s.breaches == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "breach check is not configured on this server")
+	}
+	if (req.Password == "") == (req.EntryId == "") {
+		return nil, status.Errorf(codes.InvalidArgument, "exactly one of password and entry_id is required")
+	}
+
+	password := req.Password
+	if req.EntryId != "" {
+		id, err := uuid.Parse(req.EntryId)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid entry id")
+		}
+		entry, err := s.store.Get(ctx, id)
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, status.Errorf(codes.NotFound, "entry not found")
+		}
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "database error: %v", err)
+		}
+		if entry.ClientEncrypted {
+			return nil, status.Errorf(codes.FailedPrecondition, "client encrypted entries cannot be checked by the server")
+		}
+		if entry.Type != storage.EntryTypeLogin {
+			return nil, status.Errorf(codes.InvalidArgument, "%s entries have no password", entry.Type)
+		}
+		password = string(entry.Password)
+		clear(entry.Password)
+	}
+
+	count, err := s.breaches.Check(password)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "breach check failed: %v", err)
+	}
+	return &vaultpb.CheckBreachedResponse{Result: &vaultpb.BreachCheck{Breached: count > 0, Count: int64(count)}}, nil
+}
+
+// breachCheck is the best effort check run by CreateEntry, it returns nil when no index is configured
+// or the lookup fails
+func (s *VaultService) breachCheck(e *vaultpb.VaultEntry) *vaultpb.BreachCheck {
+	if s.breaches == nil || e.ClientEncrypted || e.Type != vaultpb.EntryType_ENTRY_TYPE_LOGIN {
+		return nil
+	}
+	count, err := s.breaches.Check(e.Password)
+	if err != nil {
+		log.Printf("breach check failed: %v", err)
+		return nil
+	}
+	return &vaultpb.BreachCheck{Breached: count > 0, Count: int64(count)}
+}
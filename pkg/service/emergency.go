@@ -0,0 +1,220 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/AleksZelenchuk/vault-server/gen/go/vaultuserpb"
+	"github.com/AleksZelenchuk/vault-server/pkg/auth"
+	"github.com/AleksZelenchuk/vault-server/pkg/notify"
+	"github.com/AleksZelenchuk/vault-server/pkg/storage"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"log"
+	"time"
+)
+
+// AddEmergencyContact trusts another user to request access to the active user's vault
+func (s *UserVaultService) AddEmergencyContact(ctx context.Context, req *vaultuserpb.AddEmergencyContactRequest) (*vaultuserpb.AddEmergencyContactResponse, error) {
+	_, ok := auth.UserIDFromContext(ctx)
+	if !ok {
+		return nil, errors.New("no user id provided")
+	}
+	if req.GranteeUsername == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "grantee username is required")
+	}
+	if req.WaitPeriodSeconds < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "wait period cannot be negative")
+	}
+
+	wait := req.WaitPeriodSeconds
+	if wait == 0 {
+		wait = int64(s.emergencyWait / time.Second)
+	}
+
+	contact, err := s.emergency.AddContact(ctx, req.GranteeUsername, accessLevelFromProto(req.AccessLevel), wait)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, status.Errorf(codes.NotFound, "user not found")
+		}
+		if errors.Is(err, storage.SelfEmergencyContact) {
+			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		}
+		return nil, err
+	}
+
+	return &vaultuserpb.AddEmergencyContactResponse{Contact: emergencyToProto(contact)}, nil
+}
+
+func (s *UserVaultService) ListEmergencyContacts(ctx context.Context, _ *vaultuserpb.ListEmergencyContactsRequest) (*vaultuserpb.ListEmergencyContactsResponse, error) {
+	_, ok := auth.UserIDFromContext(ctx)
+	if !ok {
+		return nil, errors.New("no user id provided")
+	}
+
+	grantedBy, err := s.emergency.ListGrantedBy(ctx)
+	if err != nil {
+		return nil, err
+	}
+	grantedTo, err := s.emergency.ListGrantedTo(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &vaultuserpb.ListEmergencyContactsResponse{}
+	for _, c := range grantedBy {
+		resp.GrantedByMe = append(resp.GrantedByMe, emergencyToProto(&c))
+	}
+	for _, c := range grantedTo {
+		resp.GrantedToMe = append(resp.GrantedToMe, emergencyToProto(&c))
+	}
+	return resp, nil
+}
+
+func (s *UserVaultService) RemoveEmergencyContact(ctx context.Context, req *vaultuserpb.RemoveEmergencyContactRequest) (*vaultuserpb.RemoveEmergencyContactResponse, error) {
+	_, ok := auth.UserIDFromContext(ctx)
+	if !ok {
+		return nil, errors.New("no user id provided")
+	}
+
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	success, err := s.emergency.RemoveContact(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, status.Errorf(codes.NotFound, "emergency contact not found")
+		}
+		return nil, err
+	}
+
+	return &vaultuserpb.RemoveEmergencyContactResponse{Success: success}, nil
+}
+
+// RequestEmergencyAccess is called by the grantee, the grantor is notified and access is granted
+// automatically unless the grantor rejects the request within the wait period
+func (s *UserVaultService) RequestEmergencyAccess(ctx context.Context, req *vaultuserpb.EmergencyAccessRequest) (*vaultuserpb.EmergencyAccessResponse, error) {
+	userId, ok := auth.UserIDFromContext(ctx)
+	if !ok {
+		return nil, errors.New("no user id provided")
+	}
+
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	contact, err := s.emergency.RequestAccess(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, status.Errorf(codes.NotFound, "emergency contact not found")
+		}
+		return nil, err
+	}
+
+	if contact.Status == storage.EmergencyStatusRequested {
+		s.notify(ctx, notify.Notification{
+			UserID:  contact.GrantorId,
+			Subject: "Emergency access requested",
+			Body: fmt.Sprintf("User %s requested %s access to your vault. Access is granted at %s unless you reject the request.",
+				userId, contact.AccessLevel, accessAvailableAt(contact).Format(time.RFC3339)),
+		})
+	}
+
+	return &vaultuserpb.EmergencyAccessResponse{Contact: emergencyToProto(contact)}, nil
+}
+
+// ApproveEmergencyAccess lets the grantor skip the rest of the wait period
+func (s *UserVaultService) ApproveEmergencyAccess(ctx context.Context, req *vaultuserpb.EmergencyAccessRequest) (*vaultuserpb.EmergencyAccessResponse, error) {
+	return s.decideEmergencyAccess(ctx, req, true)
+}
+
+// RejectEmergencyAccess denies a pending request, the grantee may request again later
+func (s *UserVaultService) RejectEmergencyAccess(ctx context.Context, req *vaultuserpb.EmergencyAccessRequest) (*vaultuserpb.EmergencyAccessResponse, error) {
+	return s.decideEmergencyAccess(ctx, req, false)
+}
+
+func (s *UserVaultService) decideEmergencyAccess(ctx context.Context, req *vaultuserpb.EmergencyAccessRequest, approve bool) (*vaultuserpb.EmergencyAccessResponse, error) {
+	_, ok := auth.UserIDFromContext(ctx)
+	if !ok {
+		return nil, errors.New("no user id provided")
+	}
+
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	contact, err := s.emergency.Decide(ctx, id, approve)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, status.Errorf(codes.FailedPrecondition, "no pending emergency access request")
+		}
+		return nil, err
+	}
+
+	subject := "Emergency access rejected"
+	if approve {
+		subject = "Emergency access approved"
+	}
+	s.notify(ctx, notify.Notification{
+		UserID:  contact.GranteeId,
+		Subject: subject,
+		Body:    fmt.Sprintf("Your emergency access request to the vault of user %s was %s.", contact.GrantorId, contact.Status),
+	})
+
+	return &vaultuserpb.EmergencyAccessResponse{Contact: emergencyToProto(contact)}, nil
+}
+
+// notify delivers n without failing the request, a lost notification must not block emergency access
+func (s *UserVaultService) notify(ctx context.Context, n notify.Notification) {
+	if s.notifier == nil {
+		return
+	}
+	if err := s.notifier.Notify(ctx, n); err != nil {
+		log.Printf("failed to notify user %s: %v", n.UserID, err)
+	}
+}
+
+func accessAvailableAt(c *storage.EmergencyContact) time.Time {
+	if !c.RequestedAt.Valid {
+		return time.Time{}
+	}
+	return c.RequestedAt.Time.Add(time.Duration(c.WaitPeriodSeconds) * time.Second)
+}
+
+func accessLevelFromProto(l vaultuserpb.EmergencyAccessLevel) string {
+	if l == vaultuserpb.EmergencyAccessLevel_EMERGENCY_ACCESS_TAKEOVER {
+		return storage.EmergencyAccessTakeover
+	}
+	return storage.EmergencyAccessView
+}
+
+func emergencyToProto(c *storage.EmergencyContact) *vaultuserpb.EmergencyContact {
+	p := &vaultuserpb.EmergencyContact{
+		Id:                c.ID.String(),
+		GrantorId:         c.GrantorId,
+		GranteeId:         c.GranteeId,
+		WaitPeriodSeconds: c.WaitPeriodSeconds,
+	}
+	if c.AccessLevel == storage.EmergencyAccessTakeover {
+		p.AccessLevel = vaultuserpb.EmergencyAccessLevel_EMERGENCY_ACCESS_TAKEOVER
+	}
+	switch c.Status {
+	case storage.EmergencyStatusRequested:
+		p.Status = vaultuserpb.EmergencyAccessStatus_EMERGENCY_STATUS_REQUESTED
+	case storage.EmergencyStatusApproved:
+		p.Status = vaultuserpb.EmergencyAccessStatus_EMERGENCY_STATUS_APPROVED
+	case storage.EmergencyStatusRejected:
+		p.Status = vaultuserpb.EmergencyAccessStatus_EMERGENCY_STATUS_REJECTED
+	}
+	if c.RequestedAt.Valid {
+		p.RequestedAt = c.RequestedAt.Time.Unix()
+		p.AccessAvailableAt = accessAvailableAt(c).Unix()
+	}
+	return p
+}
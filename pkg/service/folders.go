@@ -0,0 +1,183 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/AleksZelenchuk/vault-server/gen/go/vaultpb"
+	"github.com/AleksZelenchuk/vault-server/pkg/auth"
+	"github.com/AleksZelenchuk/vault-server/pkg/storage"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"strings"
+)
+
+const maxFolderNameLen = 255
+
+func (s *VaultService) CreateFolder(ctx context.Context, req *vaultpb.CreateFolderRequest) (*vaultpb.CreateFolderResponse, error) {
+	_, errValidate := auth.UserIDFromContext(ctx)
+	if errValidate != true {
+		return nil, errors.New("no user id provided")
+	}
+	if !validFolderName(req.Name) {
+		return nil, status.Errorf(codes.InvalidArgument, "folder names must have 1 to %d characters and no %q", maxFolderNameLen, storage.FolderSeparator)
+	}
+	parent, err := optionalFolderId(req.ParentId)
+	if err != nil {
+		return nil, err
+	}
+
+	f := &storage.Folder{ID: uuid.New(), ParentId: parent, Name: req.Name}
+	if err := s.store.CreateFolder(ctx, f); err != nil {
+		return nil, folderStatus(err)
+	}
+	return &vaultpb.CreateFolderResponse{Folder: folderToProto(f)}, nil
+}
+
+func (s *VaultService) RenameFolder(ctx context.Context, req *vaultpb.RenameFolderRequest) (*vaultpb.RenameFolderResponse, error) {
+	_, errValidate := auth.UserIDFromContext(ctx)
+	if errValidate != true {
+		return nil, errors.New("no user id provided")
+	}
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid folder id")
+	}
+	if !validFolderName(req.Name) {
+		return nil, status.Errorf(codes.InvalidArgument, "folder names must have 1 to %d characters and no %q", maxFolderNameLen, storage.FolderSeparator)
+	}
+
+	f, err := s.store.RenameFolder(ctx, id, req.Name)
+	if err != nil {
+		return nil, folderStatus(err)
+	}
+	return &vaultpb.RenameFolderResponse{Folder: folderToProto(f)}, nil
+}
+
+func (s *VaultService) MoveFolder(ctx context.Context, req *vaultpb.MoveFolderRequest) (*vaultpb.MoveFolderResponse, error) {
+	_, errValidate := auth.UserIDFromContext(ctx)
+	if errValidate != true {
+		return nil, errors.New("no user id provided")
+	}
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid folder id")
+	}
+	parent, err := optionalFolderId(req.ParentId)
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := s.store.MoveFolder(ctx, id, parent)
+	if err != nil {
+		return nil, folderStatus(err)
+	}
+	return &vaultpb.MoveFolderResponse{Folder: folderToProto(f)}, nil
+}
+
+// DeleteFolder deletes a folder, with recursive including its subfolders. Entries are never deleted, they
+// move to the parent of the deleted folder
+func (s *VaultService) DeleteFolder(ctx context.Context, req *vaultpb.DeleteFolderRequest) (*vaultpb.DeleteFolderResponse, error) {
+	_, errValidate := auth.UserIDFromContext(ctx)
+	if errValidate != true {
+		return nil, errors.New("no user id provided")
+	}
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid folder id")
+	}
+
+	if err := s.store.DeleteFolder(ctx, id, req.Recursive); err != nil {
+		return nil, folderStatus(err)
+	}
+	return &vaultpb.DeleteFolderResponse{}, nil
+}
+
+func (s *VaultService) ListFolders(ctx context.Context, _ *vaultpb.ListFoldersRequest) (*vaultpb.ListFoldersResponse, error) {
+	_, errValidate := auth.UserIDFromContext(ctx)
+	if errValidate != true {
+		return nil, errors.New("no user id provided")
+	}
+
+	folders, err := s.store.ListFolders(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "database error: %v", err)
+	}
+	resp := &vaultpb.ListFoldersResponse{}
+	for i := range folders {
+		resp.Folders = append(resp.Folders, folderToProto(&folders[i]))
+	}
+	return resp, nil
+}
+
+// entryFolder returns the folder an entry is filed in: folder_id when set, otherwise the folder at the
+// entry's folder path, which is created when missing. Blank segments such as in "Work//Projects" are
+// skipped like the folder migration did for legacy paths
+func (s *VaultService) entryFolder(ctx context.Context, e *vaultpb.VaultEntry) (uuid.NullUUID, error) {
+	if e.FolderId != "" {
+		return optionalFolderId(e.FolderId)
+	}
+	empty := true
+	for _, name := range strings.Split(e.Folder, storage.FolderSeparator) {
+		if strings.TrimSpace(name) == "" {
+			continue
+		}
+		if !validFolderName(name) {
+			return uuid.NullUUID{}, status.Errorf(codes.InvalidArgument, "invalid folder path %q", e.Folder)
+		}
+		empty = false
+	}
+	if empty {
+		return uuid.NullUUID{}, nil
+	}
+	id, err := s.store.ResolveFolderPath(ctx, e.Folder, true)
+	if err != nil {
+		return uuid.NullUUID{}, folderStatus(err)
+	}
+	return uuid.NullUUID{UUID: id, Valid: true}, nil
+}
+
+func optionalFolderId(id string) (uuid.NullUUID, error) {
+	if id == "" {
+		return uuid.NullUUID{}, nil
+	}
+	parsed, err := uuid.Parse(id)
+	if err != nil {
+		return uuid.NullUUID{}, status.Errorf(codes.InvalidArgument, "invalid folder id")
+	}
+	return uuid.NullUUID{UUID: parsed, Valid: true}, nil
+}
+
+func folderStatus(err error) error {
+	switch {
+	case errors.Is(err, storage.FolderNotFound):
+		return status.Errorf(codes.NotFound, "folder not found")
+	case errors.Is(err, storage.FolderExists):
+		return status.Errorf(codes.AlreadyExists, "%v", err)
+	case errors.Is(err, storage.FolderNotEmpty), errors.Is(err, storage.FolderCycle):
+		return status.Errorf(codes.FailedPrecondition, "%v", err)
+	}
+	return status.Errorf(codes.Internal, "database error: %v", err)
+}
+
+func validFolderName(name string) bool {
+	return strings.TrimSpace(name) != "" && len(name) <= maxFolderNameLen && !strings.Contains(name, storage.FolderSeparator)
+}
+
+func folderToProto(f *storage.Folder) *vaultpb.Folder {
+	p := &vaultpb.Folder{
+		Id:         f.ID.String(),
+		Name:       f.Name,
+		Path:       f.Path,
+		EntryCount: int32(f.EntryCount),
+		CreatedAt:  f.CreatedAt.Unix(),
+		UpdatedAt:  f.UpdatedAt.Unix(),
+	}
+	if f.ParentId.Valid {
+		p.ParentId = f.ParentId.UUID.String()
+	}
+	if f.RotationIntervalDays.Valid {
+		p.RotationIntervalDays = &f.RotationIntervalDays.Int32
+	}
+	return p
+}
@@ -0,0 +1,174 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/AleksZelenchuk/vault-server/gen/go/vaultpb"
+	"github.com/AleksZelenchuk/vault-server/pkg/auth"
+	"github.com/AleksZelenchuk/vault-server/pkg/generator"
+	"github.com/AleksZelenchuk/vault-server/pkg/storage"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"strings"
+)
+
+const (
+	defaultPasswordLength  = 20
+	defaultPassphraseWords = 6
+	defaultWordSeparator   = "-"
+)
+
+// GeneratePassword generates a random password or a diceware passphrase, the caller's effective policy
+// raises the requested options where they are weaker
+func (s *VaultService) GeneratePassword(ctx context.Context, req *vaultpb.GeneratePasswordRequest) (*vaultpb.GeneratePasswordResponse, error) {
+	_, errValidate := auth.UserIDFromContext(ctx)
+	if errValidate != true {
+		return nil, errors.New("no user id provided")
+	}
+
+	policy, err := s.effectivePolicy(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var password string
+	var entropy float64
+	if req.Passphrase {
+		o := generator.PassphraseOptions{
+			Words:         int(req.Words),
+			Separator:     defaultWordSeparator,
+			Capitalize:    req.Capitalize,
+			IncludeNumber: req.IncludeNumber,
+		}
+		if o.Words == 0 {
+			o.Words = defaultPassphraseWords
+		}
+		if req.Separator != nil {
+			o.Separator = *req.Separator
+		}
+		password, entropy, err = generator.Passphrase(policy.ApplyToPassphrase(o))
+	} else {
+		o := generator.Options{
+			Length:           int(req.Length),
+			Lowercase:        req.Lowercase,
+			Uppercase:        req.Uppercase,
+			Digits:           req.Digits,
+			Symbols:          req.Symbols,
+			ExcludeAmbiguous: req.ExcludeAmbiguous,
+		}
+		if o.Length == 0 {
+			o.Length = defaultPasswordLength
+		}
+		password, entropy, err = generator.Password(policy.ApplyToOptions(o))
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	return &vaultpb.GeneratePasswordResponse{Password: password, EntropyBits: entropy}, nil
+}
+
+// GetPasswordPolicy returns the caller's own or the organization policy together with the effective one
+func (s *VaultService) GetPasswordPolicy(ctx context.Context, req *vaultpb.GetPasswordPolicyRequest) (*vaultpb.GetPasswordPolicyResponse, error) {
+	_, errValidate := auth.UserIDFromContext(ctx)
+	if errValidate != true {
+		return nil, errors.New("no user id provided")
+	}
+
+	policy, err := s.policies.Get(ctx, req.Organization)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "database error: %v", err)
+	}
+	effective, err := s.policies.Effective(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "database error: %v", err)
+	}
+
+	return &vaultpb.GetPasswordPolicyResponse{Policy: policyToProto(policy), Effective: policyToProto(effective)}, nil
+}
+
+// SetPasswordPolicy replaces the caller's own policy, or the organization policy for admins
+func (s *VaultService) SetPasswordPolicy(ctx context.Context, req *vaultpb.SetPasswordPolicyRequest) (*vaultpb.SetPasswordPolicyResponse, error) {
+	_, errValidate := auth.UserIDFromContext(ctx)
+	if errValidate != true {
+		return nil, errors.New("no user id provided")
+	}
+
+	p := req.Policy
+	if p == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "policy is required")
+	}
+	if p.MinLength < 0 || p.MinLength > generator.MaxLength {
+		return nil, status.Errorf(codes.InvalidArgument, "min_length must be between 0 and %d", generator.MaxLength)
+	}
+	if p.MinWords < 0 || p.MinWords > generator.MaxWords {
+		return nil, status.Errorf(codes.InvalidArgument, "min_words must be between 0 and %d", generator.MaxWords)
+	}
+
+	policy := &storage.PasswordPolicy{
+		MinLength:        int(p.MinLength),
+		RequireLowercase: p.RequireLowercase,
+		RequireUppercase: p.RequireUppercase,
+		RequireDigits:    p.RequireDigits,
+		RequireSymbols:   p.RequireSymbols,
+		MinWords:         int(p.MinWords),
+	}
+	var err error
+	if req.Organization {
+		policy, err = s.policies.SetOrganizationPolicy(ctx, policy)
+	} else {
+		policy, err = s.policies.SetUserPolicy(ctx, policy)
+	}
+	if err != nil {
+		if errors.Is(err, storage.PermissionDenied) {
+			return nil, status.Errorf(codes.PermissionDenied, "only admins can change the organization policy")
+		}
+		return nil, status.Errorf(codes.Internal, "database error: %v", err)
+	}
+
+	return &vaultpb.SetPasswordPolicyResponse{Policy: policyToProto(policy)}, nil
+}
+
+// enforcePolicy rejects a password that violates the effective policy of the user in ctx
+func (s *VaultService) enforcePolicy(ctx context.Context, password string) error {
+	policy, err := s.effectivePolicy(ctx)
+	if err != nil {
+		return err
+	}
+	if violations := policy.Check(password); len(violations) > 0 {
+		return status.Errorf(codes.InvalidArgument, "password does not satisfy the password policy: %s", strings.Join(violations, ", "))
+	}
+	return nil
+}
+
+func (s *VaultService) effectivePolicy(ctx context.Context) (generator.Policy, error) {
+	p, err := s.policies.Effective(ctx)
+	if err != nil {
+		return generator.Policy{}, status.Errorf(codes.Internal, "database error: %v", err)
+	}
+	if p == nil {
+		return generator.Policy{}, nil
+	}
+	return generator.Policy{
+		MinLength:        p.MinLength,
+		RequireLowercase: p.RequireLowercase,
+		RequireUppercase: p.RequireUppercase,
+		RequireDigits:    p.RequireDigits,
+		RequireSymbols:   p.RequireSymbols,
+		MinWords:         p.MinWords,
+	}, nil
+}
+
+func policyToProto(p *storage.PasswordPolicy) *vaultpb.PasswordPolicy {
+	if p == nil {
+		return nil
+	}
+	return &vaultpb.PasswordPolicy{
+		MinLength:        int32(p.MinLength),
+		RequireLowercase: p.RequireLowercase,
+		RequireUppercase: p.RequireUppercase,
+		RequireDigits:    p.RequireDigits,
+		RequireSymbols:   p.RequireSymbols,
+		MinWords:         int32(p.MinWords),
+	}
+}
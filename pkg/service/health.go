@@ -0,0 +1,144 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"github.com/AleksZelenchuk/vault-server/gen/go/vaultpb"
+	"github.com/AleksZelenchuk/vault-server/pkg/auth"
+	"github.com/AleksZelenchuk/vault-server/pkg/storage"
+	"github.com/AleksZelenchuk/vault-server/pkg/strength"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"time"
+)
+
+const (
+	defaultHealthMinScore   = 3
+	defaultHealthMaxAgeDays = 365
+)
+
+// GetVaultHealthReport decrypts the caller's login passwords in memory only and lists weak, reused, old
+// and breached ones. Client encrypted entries cannot be analysed and are counted as skipped
+func (s *VaultService) GetVaultHealthReport(ctx context.Context, req *vaultpb.GetVaultHealthReportRequest) (*vaultpb.GetVaultHealthReportResponse, error) {
+	_, errValidate := auth.UserIDFromContext(ctx)
+	if errValidate != true {
+		return nil, errors.New("no user id provided")
+	}
+
+	minScore := int(req.MinScore)
+	if minScore == 0 {
+		minScore = defaultHealthMinScore
+	}
+	maxAgeDays := int(req.MaxAgeDays)
+	if maxAgeDays == 0 {
+		maxAgeDays = defaultHealthMaxAgeDays
+	}
+	if minScore < 0 || minScore > 4 || maxAgeDays < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "min_score must be between 0 and 4 and max_age_days positive")
+	}
+
+	entries, err := s.store.ListDecrypted(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "database error: %v", err)
+	}
+	defer func() {
+		for i := range entries {
+			clear(entries[i].Password)
+		}
+	}()
+
+	resp := &vaultpb.GetVaultHealthReportResponse{TotalEntries: int32(len(entries)), BreachCheckEnabled: s.breaches != nil}
+	cutoff := time.Now().AddDate(0, 0, -maxAgeDays)
+	var groups [][]*vaultpb.EntryRef
+	byFingerprint := make(map[string]int)
+
+	for i := range entries {
+		e := &entries[i]
+		if e.ClientEncrypted {
+			resp.SkippedEntries++
+			continue
+		}
+		ref := entryRef(e)
+
+		result := strength.Estimate(string(e.Password), e.Title, e.Username, e.Domain.String)
+		if result.Score < minScore {
+			resp.Weak = append(resp.Weak, &vaultpb.WeakPassword{Entry: ref, Strength: strengthToProto(result)})
+		}
+
+		if s.breaches != nil {
+			count, err := s.breaches.Check(string(e.Password))
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "breach check failed: %v", err)
+			}
+			if count > 0 {
+				resp.Breached = append(resp.Breached, &vaultpb.BreachedPassword{Entry: ref, Count: int64(count)})
+			}
+		}
+
+		fp, err := storage.Fingerprint(e.UserId, e.Password)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to fingerprint password")
+		}
+		if g, ok := byFingerprint[string(fp)]; ok {
+			groups[g] = append(groups[g], ref)
+		} else {
+			byFingerprint[string(fp)] = len(groups)
+			groups = append(groups, []*vaultpb.EntryRef{ref})
+		}
+
+		if e.PasswordChangedAt.Before(cutoff) {
+			resp.Old = append(resp.Old, &vaultpb.OldPassword{Entry: ref, ChangedAt: e.PasswordChangedAt.Unix()})
+		}
+	}
+
+	for _, g := range groups {
+		if len(g) > 1 {
+			resp.Reused = append(resp.Reused, &vaultpb.ReusedPassword{Entries: g})
+		}
+	}
+
+	return resp, nil
+}
+
+// FindReusedPasswords groups the caller's entries sharing a password by their stored fingerprints,
+// nothing is decrypted
+func (s *VaultService) FindReusedPasswords(ctx context.Context, _ *vaultpb.FindReusedPasswordsRequest) (*vaultpb.FindReusedPasswordsResponse, error) {
+	_, errValidate := auth.UserIDFromContext(ctx)
+	if errValidate != true {
+		return nil, errors.New("no user id provided")
+	}
+
+	entries, err := s.store.ListReused(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "database error: %v", err)
+	}
+
+	resp := &vaultpb.FindReusedPasswordsResponse{}
+	for i := range entries {
+		// entries come ordered by fingerprint, a new fingerprint starts a new group
+		if i == 0 || !bytes.Equal(entries[i].PasswordFingerprint, entries[i-1].PasswordFingerprint) {
+			resp.Groups = append(resp.Groups, &vaultpb.ReusedPassword{})
+		}
+		group := resp.Groups[len(resp.Groups)-1]
+		group.Entries = append(group.Entries, entryRef(&entries[i]))
+	}
+	return resp, nil
+}
+
+// passwordStrength scores the password of a new login entry, the entry's own fields count as guessable
+// words
+func passwordStrength(e *vaultpb.VaultEntry) *vaultpb.PasswordStrength {
+	if e.ClientEncrypted || e.Type != vaultpb.EntryType_ENTRY_TYPE_LOGIN {
+		return nil
+	}
+	return strengthToProto(strength.Estimate(e.Password, e.Title, e.Username, e.Domain))
+}
+
+func strengthToProto(r *strength.Result) *vaultpb.PasswordStrength {
+	return &vaultpb.PasswordStrength{Score: int32(r.Score), GuessesLog10: r.GuessesLog10, Warning: r.Warning}
+}
+
+func entryRef(e *storage.Entry) *vaultpb.EntryRef {
+	return &vaultpb.EntryRef{Id: e.ID.String(), Title: e.Title, Username: e.Username, Domain: e.Domain.String}
+}
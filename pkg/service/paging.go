@@ -0,0 +1,159 @@
+package service
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"github.com/AleksZelenchuk/vault-server/gen/go/vaultpb"
+	"github.com/AleksZelenchuk/vault-server/pkg/entrytype"
+	"github.com/AleksZelenchuk/vault-server/pkg/storage"
+	"github.com/AleksZelenchuk/vault-server/pkg/urimatch"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"strings"
+	"time"
+)
+
+const (
+	defaultPageSize = 100
+	maxPageSize     = 1000
+)
+
+// listOptions translates the filters, sort order and page of a ListEntries request. The page token must
+// have been issued to userId for the same filters and order
+func listOptions(req *vaultpb.ListEntriesRequest, userId string) (storage.ListOptions, error) {
+	filter, err := listFilters(req)
+	if err != nil {
+		return storage.ListOptions{}, err
+	}
+	opts := storage.ListOptions{Filter: filter, PageSize: int(req.PageSize)}
+
+	switch {
+	case opts.PageSize < 0:
+		return opts, status.Errorf(codes.InvalidArgument, "page_size must not be negative")
+	case opts.PageSize == 0:
+		opts.PageSize = defaultPageSize
+	case opts.PageSize > maxPageSize:
+		opts.PageSize = maxPageSize
+	}
+
+	field, direction, _ := strings.Cut(strings.TrimSpace(strings.ToLower(req.OrderBy)), " ")
+	switch field {
+	case "":
+		field = "created_at"
+	case "title", "created_at", "updated_at", "favorite", "last_used_at":
+	default:
+		return opts, status.Errorf(codes.InvalidArgument, "order_by must be title, created_at, updated_at, favorite or last_used_at")
+	}
+	switch strings.TrimSpace(direction) {
+	case "", "asc":
+	case "desc":
+		opts.Desc = true
+	default:
+		return opts, status.Errorf(codes.InvalidArgument, "order_by direction must be asc or desc")
+	}
+	opts.OrderBy = field
+
+	if req.PageToken == "" {
+		return opts, nil
+	}
+	cursor, err := storage.DecodeCursor(req.PageToken, userId)
+	if err != nil {
+		return opts, status.Errorf(codes.InvalidArgument, "invalid page_token")
+	}
+	if cursor.OrderBy != opts.OrderBy || cursor.Desc != opts.Desc || cursor.Filter != filterFingerprint(req) {
+		return opts, status.Errorf(codes.InvalidArgument, "page_token does not match the request")
+	}
+	opts.After = cursor
+	return opts, nil
+}
+
+// listFilters combines the filters of a ListEntries request, all of them must match
+func listFilters(req *vaultpb.ListEntriesRequest) (storage.Filter, error) {
+	var filters []storage.Filter
+	if req.Domain != "" {
+		domain, err := urimatch.Domain(req.Domain)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid domain")
+		}
+		filters = append(filters, storage.InDomain(domain))
+	}
+	if req.Folder != "" {
+		filters = append(filters, storage.FolderIs(req.Folder))
+	}
+	if req.FolderPrefix != "" {
+		filters = append(filters, storage.FolderPrefix(req.FolderPrefix))
+	}
+	if req.FolderId != "" {
+		id, err := uuid.Parse(req.FolderId)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid folder id")
+		}
+		filters = append(filters, storage.InFolder(id, req.IncludeSubfolders))
+	}
+	if len(req.Tags) > 0 {
+		filters = append(filters, storage.HasAllTags(req.Tags...))
+	}
+	if len(req.TagSets) > 0 {
+		var sets []storage.Filter
+		for _, set := range req.TagSets {
+			if len(set.Tags) == 0 {
+				return nil, status.Errorf(codes.InvalidArgument, "tag_sets must not contain empty sets")
+			}
+			sets = append(sets, storage.HasAllTags(set.Tags...))
+		}
+		filters = append(filters, storage.Or(sets...))
+	}
+	if len(req.Types) > 0 {
+		types := make([]string, 0, len(req.Types))
+		for _, t := range req.Types {
+			if !entrytype.Valid(t) {
+				return nil, status.Errorf(codes.InvalidArgument, "unknown entry type %d", t)
+			}
+			types = append(types, entrytype.Name(t))
+		}
+		filters = append(filters, storage.TypeIn(types...))
+	}
+	if len(req.FieldNames) > 0 {
+		filters = append(filters, storage.HasCustomField(req.FieldNames...))
+	}
+	if req.CreatedAfter != 0 || req.CreatedBefore != 0 {
+		filters = append(filters, storage.CreatedBetween(unixTime(req.CreatedAfter), unixTime(req.CreatedBefore)))
+	}
+	if req.UpdatedAfter != 0 || req.UpdatedBefore != 0 {
+		filters = append(filters, storage.UpdatedBetween(unixTime(req.UpdatedAfter), unixTime(req.UpdatedBefore)))
+	}
+	return storage.And(filters...), nil
+}
+
+// unixTime converts a unix timestamp of a request, 0 is the zero time
+func unixTime(sec int64) time.Time {
+	if sec == 0 {
+		return time.Time{}
+	}
+	return time.Unix(sec, 0)
+}
+
+// filterFingerprint fingerprints the parts of a ListEntries request that must stay the same between pages
+func filterFingerprint(req *vaultpb.ListEntriesRequest) string {
+	filter := proto.Clone(req).(*vaultpb.ListEntriesRequest)
+	filter.PageToken = ""
+	filter.PageSize = 0
+	data, _ := proto.MarshalOptions{Deterministic: true}.Marshal(filter)
+	sum := sha256.Sum256(data)
+	return base64.RawURLEncoding.EncodeToString(sum[:16])
+}
+
+// nextPageToken returns the token of the page after result, empty on the last page
+func nextPageToken(req *vaultpb.ListEntriesRequest, result *storage.ListResult, userId string) (string, error) {
+	if result.Next == nil {
+		return "", nil
+	}
+	result.Next.Filter = filterFingerprint(req)
+	token, err := result.Next.Encode(userId)
+	if err != nil {
+		return "", status.Errorf(codes.Internal, "failed to create page token")
+	}
+	return token, nil
+}
@@ -0,0 +1,104 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/AleksZelenchuk/vault-server/gen/go/vaultpb"
+	"github.com/AleksZelenchuk/vault-server/pkg/auth"
+	"github.com/AleksZelenchuk/vault-server/pkg/entrytype"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"time"
+)
+
+// ListEntriesDueForRotation lists the caller's entries whose password is overdue, or becomes due within
+// the requested number of days, most overdue first
+func (s *VaultService) ListEntriesDueForRotation(ctx context.Context, req *vaultpb.ListEntriesDueForRotationRequest) (*vaultpb.ListEntriesDueForRotationResponse, error) {
+	_, errValidate := auth.UserIDFromContext(ctx)
+	if errValidate != true {
+		return nil, errors.New("no user id provided")
+	}
+	if req.WithinDays < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "within_days must not be negative")
+	}
+
+	entries, err := s.store.ListDueForRotation(ctx, time.Now().AddDate(0, 0, int(req.WithinDays)))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "database error: %v", err)
+	}
+
+	resp := &vaultpb.ListEntriesDueForRotationResponse{}
+	for i := range entries {
+		// passwords are never listed here, so secrets are redacted regardless of legacy reveal
+		result := toProto(&entries[i])
+		entrytype.Redact(result)
+		resp.Entries = append(resp.Entries, &vaultpb.DueEntry{
+			Entry: result,
+			DueAt: entries[i].RotationDueAt.Time.Unix(),
+		})
+	}
+	return resp, nil
+}
+
+// SetFolderRotation sets the rotation interval for all entries of a folder and its subfolders without
+// their own interval
+func (s *VaultService) SetFolderRotation(ctx context.Context, req *vaultpb.SetFolderRotationRequest) (*vaultpb.SetFolderRotationResponse, error) {
+	_, errValidate := auth.UserIDFromContext(ctx)
+	if errValidate != true {
+		return nil, errors.New("no user id provided")
+	}
+	if req.RotationIntervalDays < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "rotation_interval_days must not be negative")
+	}
+	var id uuid.UUID
+	var err error
+	switch {
+	case req.FolderId != "":
+		if id, err = uuid.Parse(req.FolderId); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid folder id")
+		}
+	case req.Folder != "":
+		if id, err = s.store.ResolveFolderPath(ctx, req.Folder, false); err != nil {
+			return nil, folderStatus(err)
+		}
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "folder_id is required")
+	}
+
+	if err := s.store.SetFolderRotation(ctx, id, req.RotationIntervalDays); err != nil {
+		return nil, folderStatus(err)
+	}
+	return &vaultpb.SetFolderRotationResponse{}, nil
+}
+
+func (s *VaultService) ListFolderRotations(ctx context.Context, _ *vaultpb.ListFolderRotationsRequest) (*vaultpb.ListFolderRotationsResponse, error) {
+	_, errValidate := auth.UserIDFromContext(ctx)
+	if errValidate != true {
+		return nil, errors.New("no user id provided")
+	}
+
+	rotations, err := s.store.ListFolderRotations(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "database error: %v", err)
+	}
+
+	resp := &vaultpb.ListFolderRotationsResponse{}
+	for _, f := range rotations {
+		resp.Rotations = append(resp.Rotations, &vaultpb.FolderRotation{
+			Folder:               f.Path,
+			RotationIntervalDays: f.RotationIntervalDays.Int32,
+			FolderId:             f.ID.String(),
+		})
+	}
+	return resp, nil
+}
+
+// rotationInterval converts the optional interval of an entry, unset inherits the folder's interval
+func rotationInterval(e *vaultpb.VaultEntry) sql.NullInt32 {
+	if e.RotationIntervalDays == nil {
+		return sql.NullInt32{}
+	}
+	return sql.NullInt32{Int32: *e.RotationIntervalDays, Valid: true}
+}
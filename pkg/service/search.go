@@ -0,0 +1,67 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/AleksZelenchuk/vault-server/gen/go/vaultpb"
+	"github.com/AleksZelenchuk/vault-server/pkg/audit"
+	"github.com/AleksZelenchuk/vault-server/pkg/auth"
+	"github.com/AleksZelenchuk/vault-server/pkg/entrytype"
+	"github.com/AleksZelenchuk/vault-server/pkg/search"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SearchEntries returns the caller's entries matching a search query, best ranked first. Entries are
+// redacted like ListEntries
+func (s *VaultService) SearchEntries(ctx context.Context, req *vaultpb.SearchEntriesRequest) (*vaultpb.SearchEntriesResponse, error) {
+	_, errValidate := auth.UserIDFromContext(ctx)
+	if errValidate != true {
+		return nil, errors.New("no user id provided")
+	}
+	ctx, err := s.ownerContext(ctx, req.OwnerId, false)
+	if err != nil {
+		return nil, err
+	}
+
+	query, err := search.Parse(req.Query)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid query: %v", err)
+	}
+	limit := int(req.Limit)
+	switch {
+	case limit < 0:
+		return nil, status.Errorf(codes.InvalidArgument, "limit must not be negative")
+	case limit == 0:
+		limit = defaultPageSize
+	case limit > maxPageSize:
+		limit = maxPageSize
+	}
+
+	entries, err := s.store.Search(ctx, query, limit, s.legacyReveal)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "database error: %v", err)
+	}
+
+	resp := &vaultpb.SearchEntriesResponse{}
+	if !s.legacyReveal {
+		for i := range entries {
+			result := toProto(&entries[i])
+			entrytype.Redact(result)
+			resp.Results = append(resp.Results, &vaultpb.SearchResult{Entry: result, Rank: float32(entries[i].Rank)})
+		}
+		return resp, nil
+	}
+
+	ids := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		ids = append(ids, entry.ID.String())
+	}
+	if auditErr := s.auditor.RecordEntries(ctx, audit.ActionEntryRead, ids, nil); auditErr != nil {
+		return nil, status.Errorf(codes.Internal, "failed to record audit event")
+	}
+	for i := range entries {
+		resp.Results = append(resp.Results, &vaultpb.SearchResult{Entry: toProto(&entries[i]), Rank: float32(entries[i].Rank)})
+	}
+	return resp, nil
+}
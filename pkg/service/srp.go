@@ -0,0 +1,176 @@
+package service
+
+import (
+	"container/list"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"github.com/AleksZelenchuk/vault-server/gen/go/vaultuserpb"
+	"github.com/AleksZelenchuk/vault-server/pkg/auth"
+	"github.com/AleksZelenchuk/vault-server/pkg/srp"
+	"github.com/AleksZelenchuk/vault-server/pkg/storage"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"sync"
+	"time"
+)
+
+// srpSessionTTL is how long a client has between BeginLogin and FinishLogin
+const srpSessionTTL = 2 * time.Minute
+
+// maxSrpSessions caps the pending logins, BeginLogin needs no authentication so they must be bounded
+const maxSrpSessions = 10000
+
+var errSrpSessionsFull = errors.New("too many pending logins")
+
+type srpLogin struct {
+	session *srp.ServerSession
+	userId  uuid.UUID
+	// known is false for sessions of unknown users, they can never succeed
+	known   bool
+	expires time.Time
+	// queued is the login's element in srpSessions.queue
+	queued *list.Element
+}
+
+// srpSessions keeps pending logins in memory, each session allows a single FinishLogin attempt. All
+// sessions live for srpSessionTTL, so the queue in creation order is also ordered by expiry and expired
+// sessions are dropped from its front
+type srpSessions struct {
+	mu    sync.Mutex
+	m     map[string]*srpLogin
+	queue *list.List
+}
+
+func newSrpSessions() *srpSessions {
+	return &srpSessions{m: make(map[string]*srpLogin), queue: list.New()}
+}
+
+func (s *srpSessions) put(l *srpLogin) (string, error) {
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	id := hex.EncodeToString(buf)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := time.Now()
+	for front := s.queue.Front(); front != nil; front = s.queue.Front() {
+		expiredId := front.Value.(string)
+		if !now.After(s.m[expiredId].expires) {
+			break
+		}
+		s.remove(expiredId)
+	}
+	if len(s.m) >= maxSrpSessions {
+		return "", errSrpSessionsFull
+	}
+	l.queued = s.queue.PushBack(id)
+	s.m[id] = l
+	return id, nil
+}
+
+func (s *srpSessions) take(id string) (*srpLogin, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	l, ok := s.m[id]
+	if !ok {
+		return nil, false
+	}
+	s.remove(id)
+	if time.Now().After(l.expires) {
+		return nil, false
+	}
+	return l, true
+}
+
+func (s *srpSessions) remove(id string) {
+	s.queue.Remove(s.m[id].queued)
+	delete(s.m, id)
+}
+
+// BeginLogin is the first SRP-6a round: the client sends its public value A and receives its salt and
+// the server's public value B. Unknown users get a deterministic fake salt so usernames cannot be probed
+func (s *UserVaultService) BeginLogin(ctx context.Context, req *vaultuserpb.BeginLoginRequest) (*vaultuserpb.BeginLoginResponse, error) {
+	if req.Username == "" || len(req.A) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "username and client public value are required")
+	}
+
+	login := &srpLogin{expires: time.Now().Add(srpSessionTTL)}
+	var salt, verifier []byte
+	user, err := s.store.GetByUsername(ctx, req.Username)
+	switch {
+	case err == nil && len(user.SrpVerifier) > 0:
+		salt, verifier = user.SrpSalt, user.SrpVerifier
+		login.userId, login.known = user.ID, true
+	case err == nil || errors.Is(err, sql.ErrNoRows):
+		// users without a verifier log in with a password, they look exactly like unknown users here
+		salt, verifier, err = fakeSrpCredentials(req.Username)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to start login")
+		}
+	default:
+		return nil, status.Errorf(codes.Internal, "database error: %v", err)
+	}
+
+	session, err := srp.NewServerSession(req.Username, salt, verifier, req.A)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	login.session = session
+
+	id, err := s.srpSessions.put(login)
+	if errors.Is(err, errSrpSessionsFull) {
+		return nil, status.Errorf(codes.ResourceExhausted, "too many pending logins, try again later")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to start login")
+	}
+
+	return &vaultuserpb.BeginLoginResponse{SessionId: id, Salt: salt, B: session.PublicB()}, nil
+}
+
+// FinishLogin is the second SRP-6a round: the client proves it knows the shared key and receives the
+// same JWT as from Login together with the server's proof
+func (s *UserVaultService) FinishLogin(_ context.Context, req *vaultuserpb.FinishLoginRequest) (*vaultuserpb.FinishLoginResponse, error) {
+	login, ok := s.srpSessions.take(req.SessionId)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "login session expired")
+	}
+
+	m2, err := login.session.Verify(req.M1)
+	if err != nil || !login.known {
+		return nil, status.Errorf(codes.Unauthenticated, "invalid credentials")
+	}
+
+	token, err := auth.GenerateToken(login.userId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate token: %v", err)
+	}
+
+	return &vaultuserpb.FinishLoginResponse{Token: token, M2: m2}, nil
+}
+
+// fakeSrpCredentials returns a salt that stays the same for a username across attempts, as a real one
+// would, and a random verifier
+func fakeSrpCredentials(username string) ([]byte, []byte, error) {
+	key, err := storage.DeriveKey("srp-fake-salt")
+	if err != nil {
+		return nil, nil, err
+	}
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(username))
+	salt := mac.Sum(nil)[:srp.SaltLength]
+
+	verifier := make([]byte, 256)
+	if _, err := rand.Read(verifier); err != nil {
+		return nil, nil, err
+	}
+	return salt, verifier, nil
+}
@@ -0,0 +1,83 @@
+package service
+
+import (
+	"errors"
+	"github.com/AleksZelenchuk/vault-server/gen/go/vaultpb"
+	"github.com/AleksZelenchuk/vault-server/pkg/audit"
+	"github.com/AleksZelenchuk/vault-server/pkg/auth"
+	"github.com/AleksZelenchuk/vault-server/pkg/entrytype"
+	"github.com/AleksZelenchuk/vault-server/pkg/storage"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// StreamEntries sends the caller's entries in batches read from a database cursor. Send blocks under flow
+// control, so a slow client holds the cursor instead of making the server buffer the vault, and a
+// cancelled stream stops fetching. Entries are redacted like ListEntries
+func (s *VaultService) StreamEntries(req *vaultpb.StreamEntriesRequest, stream grpc.ServerStreamingServer[vaultpb.StreamEntriesResponse]) error {
+	ctx := stream.Context()
+	_, errValidate := auth.UserIDFromContext(ctx)
+	if errValidate != true {
+		return errors.New("no user id provided")
+	}
+	ctx, err := s.ownerContext(ctx, req.OwnerId, false)
+	if err != nil {
+		return err
+	}
+
+	var filter storage.Filter
+	if len(req.Types) > 0 {
+		types := make([]string, 0, len(req.Types))
+		for _, t := range req.Types {
+			if !entrytype.Valid(t) {
+				return status.Errorf(codes.InvalidArgument, "unknown entry type %d", t)
+			}
+			types = append(types, entrytype.Name(t))
+		}
+		filter = storage.TypeIn(types...)
+	}
+	batchSize := int(req.BatchSize)
+	switch {
+	case batchSize < 0:
+		return status.Errorf(codes.InvalidArgument, "batch_size must not be negative")
+	case batchSize == 0:
+		batchSize = defaultPageSize
+	case batchSize > maxPageSize:
+		batchSize = maxPageSize
+	}
+
+	err = s.store.Stream(ctx, filter, s.legacyReveal, batchSize, func(batch []storage.Entry) error {
+		resp := &vaultpb.StreamEntriesResponse{Entries: make([]*vaultpb.VaultEntry, 0, len(batch))}
+		if !s.legacyReveal {
+			for i := range batch {
+				result := toProto(&batch[i])
+				entrytype.Redact(result)
+				resp.Entries = append(resp.Entries, result)
+			}
+			return stream.Send(resp)
+		}
+
+		ids := make([]string, 0, len(batch))
+		for _, entry := range batch {
+			ids = append(ids, entry.ID.String())
+		}
+		if auditErr := s.auditor.RecordEntries(ctx, audit.ActionEntryRead, ids, nil); auditErr != nil {
+			return status.Errorf(codes.Internal, "failed to record audit event")
+		}
+		for i := range batch {
+			resp.Entries = append(resp.Entries, toProto(&batch[i]))
+		}
+		return stream.Send(resp)
+	})
+	if err == nil {
+		return nil
+	}
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		return status.FromContextError(ctxErr).Err()
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	return status.Errorf(codes.Internal, "database error: %v", err)
+}
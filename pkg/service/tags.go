@@ -0,0 +1,137 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/AleksZelenchuk/vault-server/gen/go/vaultpb"
+	"github.com/AleksZelenchuk/vault-server/pkg/audit"
+	"github.com/AleksZelenchuk/vault-server/pkg/auth"
+	"github.com/AleksZelenchuk/vault-server/pkg/storage"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"log"
+	"strings"
+)
+
+const (
+	maxTagLen          = 255
+	maxMergeSources    = 100
+	defaultSuggestTags = 10
+	maxSuggestTags     = 100
+)
+
+func (s *VaultService) ListTags(ctx context.Context, _ *vaultpb.ListTagsRequest) (*vaultpb.ListTagsResponse, error) {
+	_, errValidate := auth.UserIDFromContext(ctx)
+	if errValidate != true {
+		return nil, errors.New("no user id provided")
+	}
+
+	tags, err := s.store.ListTags(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "database error: %v", err)
+	}
+	return &vaultpb.ListTagsResponse{Tags: tagsToProto(tags)}, nil
+}
+
+func (s *VaultService) SuggestTags(ctx context.Context, req *vaultpb.SuggestTagsRequest) (*vaultpb.SuggestTagsResponse, error) {
+	_, errValidate := auth.UserIDFromContext(ctx)
+	if errValidate != true {
+		return nil, errors.New("no user id provided")
+	}
+	if len(req.Prefix) > maxTagLen {
+		return nil, status.Errorf(codes.InvalidArgument, "prefix must be at most %d bytes", maxTagLen)
+	}
+	limit := int(req.Limit)
+	switch {
+	case limit < 0:
+		return nil, status.Errorf(codes.InvalidArgument, "limit must not be negative")
+	case limit == 0:
+		limit = defaultSuggestTags
+	case limit > maxSuggestTags:
+		limit = maxSuggestTags
+	}
+
+	tags, err := s.store.SuggestTags(ctx, req.Prefix, limit)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "database error: %v", err)
+	}
+	return &vaultpb.SuggestTagsResponse{Tags: tagsToProto(tags)}, nil
+}
+
+func (s *VaultService) RenameTag(ctx context.Context, req *vaultpb.RenameTagRequest) (*vaultpb.RenameTagResponse, error) {
+	_, errValidate := auth.UserIDFromContext(ctx)
+	if errValidate != true {
+		return nil, errors.New("no user id provided")
+	}
+	if !validTag(req.From) || !validTag(req.To) {
+		return nil, status.Errorf(codes.InvalidArgument, "tags must have 1 to %d characters", maxTagLen)
+	}
+	if req.From == req.To {
+		return nil, status.Errorf(codes.InvalidArgument, "from and to must differ")
+	}
+
+	ids, err := s.store.RenameTag(ctx, req.From, req.To)
+	s.recordTagChange(ctx, ids, err)
+	if err != nil {
+		return nil, tagStatus(err)
+	}
+	return &vaultpb.RenameTagResponse{UpdatedEntries: int32(len(ids))}, nil
+}
+
+func (s *VaultService) MergeTags(ctx context.Context, req *vaultpb.MergeTagsRequest) (*vaultpb.MergeTagsResponse, error) {
+	_, errValidate := auth.UserIDFromContext(ctx)
+	if errValidate != true {
+		return nil, errors.New("no user id provided")
+	}
+	if len(req.Sources) == 0 || len(req.Sources) > maxMergeSources {
+		return nil, status.Errorf(codes.InvalidArgument, "sources must have 1 to %d tags", maxMergeSources)
+	}
+	valid := validTag(req.Target)
+	for _, t := range req.Sources {
+		valid = valid && validTag(t)
+	}
+	if !valid {
+		return nil, status.Errorf(codes.InvalidArgument, "tags must have 1 to %d characters", maxTagLen)
+	}
+
+	ids, err := s.store.MergeTags(ctx, req.Sources, req.Target)
+	s.recordTagChange(ctx, ids, err)
+	if err != nil {
+		return nil, tagStatus(err)
+	}
+	return &vaultpb.MergeTagsResponse{UpdatedEntries: int32(len(ids))}, nil
+}
+
+// recordTagChange audits a tag change as an update of every changed entry
+func (s *VaultService) recordTagChange(ctx context.Context, ids []uuid.UUID, err error) {
+	entryIds := make([]string, 0, len(ids))
+	for _, id := range ids {
+		entryIds = append(entryIds, id.String())
+	}
+	if auditErr := s.auditor.RecordEntries(ctx, audit.ActionEntryUpdate, entryIds, err); auditErr != nil {
+		log.Printf("audit: failed to record tag change: %v", auditErr)
+	}
+}
+
+func tagStatus(err error) error {
+	switch {
+	case errors.Is(err, storage.TagNotFound):
+		return status.Errorf(codes.NotFound, "%v", err)
+	case errors.Is(err, storage.TagExists):
+		return status.Errorf(codes.AlreadyExists, "%v, use MergeTags to join tags", err)
+	}
+	return status.Errorf(codes.Internal, "database error: %v", err)
+}
+
+func validTag(tag string) bool {
+	return strings.TrimSpace(tag) != "" && len(tag) <= maxTagLen
+}
+
+func tagsToProto(tags []storage.Tag) []*vaultpb.Tag {
+	result := make([]*vaultpb.Tag, 0, len(tags))
+	for _, t := range tags {
+		result = append(result, &vaultpb.Tag{Name: t.Name, Count: int32(t.Count)})
+	}
+	return result
+}
@@ -0,0 +1,79 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/AleksZelenchuk/vault-server/gen/go/vaultpb"
+	"github.com/AleksZelenchuk/vault-server/pkg/audit"
+	"github.com/AleksZelenchuk/vault-server/pkg/auth"
+	"github.com/AleksZelenchuk/vault-server/pkg/totp"
+	"github.com/AleksZelenchuk/vault-server/pkg/zkclient"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"time"
+)
+
+// GetTOTPCode returns the current one-time code of an entry without returning its secret, which is only
+// revealed by RevealPassword with SECRET_FIELD_TOTP
+func (s *VaultService) GetTOTPCode(ctx context.Context, req *vaultpb.GetTOTPCodeRequest) (*vaultpb.GetTOTPCodeResponse, error) {
+	_, errValidate := auth.UserIDFromContext(ctx)
+	if errValidate != true {
+		return nil, errors.New("no user id provided")
+	}
+	ctx, err := s.ownerContext(ctx, req.OwnerId, false)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid entry id")
+	}
+
+	entry, err := s.store.Get(ctx, id)
+	if auditErr := s.auditor.Record(ctx, audit.ActionEntryTotp, id.String(), err); auditErr != nil {
+		return nil, status.Errorf(codes.Internal, "failed to record audit event")
+	}
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "entry not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "database error: %v", err)
+	}
+	clear(entry.Password)
+	if entry.Totp == nil {
+		return nil, status.Errorf(codes.NotFound, "entry has no totp secret")
+	}
+	if entry.ClientEncrypted {
+		return nil, status.Errorf(codes.FailedPrecondition, "client encrypted entries generate codes on the client")
+	}
+
+	key, err := totp.Parse(string(entry.Totp))
+	clear(entry.Totp)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "stored totp secret is invalid: %v", err)
+	}
+	code, remaining := key.Code(time.Now())
+	return &vaultpb.GetTOTPCodeResponse{Code: code, RemainingSeconds: int32(remaining), Period: int32(key.Period)}, nil
+}
+
+// validateTotp checks the TOTP URI of a created or updated entry, client encrypted entries must carry it
+// sealed
+func validateTotp(e *vaultpb.VaultEntry) error {
+	uri := e.GetTotp()
+	if uri == "" {
+		return nil
+	}
+	if e.ClientEncrypted {
+		if !zkclient.IsSealed(uri) {
+			return status.Errorf(codes.InvalidArgument, "client encrypted entry must carry a sealed totp")
+		}
+		return nil
+	}
+	if _, err := totp.Parse(uri); err != nil {
+		return status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	return nil
+}
@@ -0,0 +1,110 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/AleksZelenchuk/vault-server/gen/go/vaultpb"
+	"github.com/AleksZelenchuk/vault-server/pkg/audit"
+	"github.com/AleksZelenchuk/vault-server/pkg/auth"
+	"github.com/AleksZelenchuk/vault-server/pkg/entrytype"
+	"github.com/AleksZelenchuk/vault-server/pkg/storage"
+	"github.com/AleksZelenchuk/vault-server/pkg/urimatch"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"strings"
+)
+
+const maxEntryURIs = 32
+
+// FindEntriesForURL returns the caller's entries with a URI matching a URL, redacted like ListEntries
+func (s *VaultService) FindEntriesForURL(ctx context.Context, req *vaultpb.FindEntriesForURLRequest) (*vaultpb.FindEntriesForURLResponse, error) {
+	_, errValidate := auth.UserIDFromContext(ctx)
+	if errValidate != true {
+		return nil, errors.New("no user id provided")
+	}
+	target, err := urimatch.ParseURL(req.Url)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "url must be an absolute url of at most %d bytes", urimatch.MaxURILen)
+	}
+
+	domain := urimatch.RegistrableDomain(urimatch.Hostname(target))
+	candidates, err := s.store.ListURLCandidates(ctx, domain, s.legacyReveal)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "database error: %v", err)
+	}
+	var matched []*storage.Entry
+	for i := range candidates {
+		for _, u := range candidates[i].URIs {
+			if urimatch.Matches(u.URI, u.Match, target) {
+				matched = append(matched, &candidates[i])
+				break
+			}
+		}
+	}
+
+	if s.legacyReveal {
+		ids := make([]string, 0, len(matched))
+		for _, e := range matched {
+			ids = append(ids, e.ID.String())
+		}
+		if auditErr := s.auditor.RecordEntries(ctx, audit.ActionEntryRead, ids, nil); auditErr != nil {
+			return nil, status.Errorf(codes.Internal, "failed to record audit event")
+		}
+	}
+	resp := &vaultpb.FindEntriesForURLResponse{}
+	for _, e := range matched {
+		result := toProto(e)
+		if !s.legacyReveal {
+			entrytype.Redact(result)
+		}
+		resp.Entries = append(resp.Entries, result)
+	}
+	return resp, nil
+}
+
+// entryURIs validates the URIs of a created or updated entry. An entry without URIs gets its domain as
+// base domain URI, an entry without domain gets the host of its first URI with a host as domain
+func entryURIs(e *vaultpb.VaultEntry) (storage.EntryURIs, error) {
+	if len(e.Uris) > maxEntryURIs {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d uris are allowed", maxEntryURIs)
+	}
+	if len(e.Uris) == 0 {
+		// free text domains that are no URL are kept as they are, without a URI
+		if host, err := urimatch.Validate(e.Domain, urimatch.BaseDomain); err == nil {
+			return storage.EntryURIs{{URI: strings.TrimSpace(e.Domain), Match: urimatch.BaseDomain, Host: host}}, nil
+		}
+		return nil, nil
+	}
+
+	uris := make(storage.EntryURIs, 0, len(e.Uris))
+	for _, u := range e.Uris {
+		uri, match := strings.TrimSpace(u.Uri), uriMatchName(u.Match)
+		host, err := urimatch.Validate(uri, match)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		}
+		uris = append(uris, storage.EntryURI{URI: uri, Match: match, Host: host})
+		if e.Domain == "" && host != "" {
+			e.Domain = host
+		}
+	}
+	return uris, nil
+}
+
+// uriMatchName is the stored name of a match strategy, e.g. "base_domain" for URI_MATCH_BASE_DOMAIN
+func uriMatchName(m vaultpb.UriMatch) string {
+	return strings.ToLower(strings.TrimPrefix(m.String(), "URI_MATCH_"))
+}
+
+// uriMatchFromName is the inverse of uriMatchName, unknown names map to URI_MATCH_BASE_DOMAIN
+func uriMatchFromName(name string) vaultpb.UriMatch {
+	return vaultpb.UriMatch(vaultpb.UriMatch_value["URI_MATCH_"+strings.ToUpper(name)])
+}
+
+func urisToProto(uris storage.EntryURIs) []*vaultpb.EntryUri {
+	var result []*vaultpb.EntryUri
+	for _, u := range uris {
+		result = append(result, &vaultpb.EntryUri{Uri: u.URI, Match: uriMatchFromName(u.Match)})
+	}
+	return result
+}
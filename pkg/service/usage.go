@@ -0,0 +1,94 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/AleksZelenchuk/vault-server/gen/go/vaultpb"
+	"github.com/AleksZelenchuk/vault-server/pkg/audit"
+	"github.com/AleksZelenchuk/vault-server/pkg/auth"
+	"github.com/AleksZelenchuk/vault-server/pkg/entrytype"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"log"
+)
+
+const (
+	defaultRecentEntries = 20
+	maxRecentEntries     = 100
+)
+
+func (s *VaultService) SetFavorite(ctx context.Context, req *vaultpb.SetFavoriteRequest) (*vaultpb.SetFavoriteResponse, error) {
+	_, errValidate := auth.UserIDFromContext(ctx)
+	if errValidate != true {
+		return nil, errors.New("no user id provided")
+	}
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid entry id")
+	}
+
+	if err := s.store.SetFavorite(ctx, id, req.Favorite); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, status.Errorf(codes.NotFound, "entry not found")
+		}
+		return nil, status.Errorf(codes.Internal, "database error: %v", err)
+	}
+	return &vaultpb.SetFavoriteResponse{}, nil
+}
+
+// ListRecent lists the caller's most recently used entries, redacted like ListEntries
+func (s *VaultService) ListRecent(ctx context.Context, req *vaultpb.ListRecentRequest) (*vaultpb.ListRecentResponse, error) {
+	_, errValidate := auth.UserIDFromContext(ctx)
+	if errValidate != true {
+		return nil, errors.New("no user id provided")
+	}
+	limit := int(req.Limit)
+	switch {
+	case limit < 0:
+		return nil, status.Errorf(codes.InvalidArgument, "limit must not be negative")
+	case limit == 0:
+		limit = defaultRecentEntries
+	case limit > maxRecentEntries:
+		limit = maxRecentEntries
+	}
+
+	entries, err := s.store.ListRecent(ctx, limit, s.legacyReveal)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "database error: %v", err)
+	}
+	if s.legacyReveal {
+		ids := make([]string, 0, len(entries))
+		for _, e := range entries {
+			ids = append(ids, e.ID.String())
+		}
+		if auditErr := s.auditor.RecordEntries(ctx, audit.ActionEntryRead, ids, nil); auditErr != nil {
+			return nil, status.Errorf(codes.Internal, "failed to record audit event")
+		}
+	}
+
+	resp := &vaultpb.ListRecentResponse{}
+	for i := range entries {
+		result := toProto(&entries[i])
+		if !s.legacyReveal {
+			entrytype.Redact(result)
+		}
+		resp.Entries = append(resp.Entries, result)
+	}
+	return resp, nil
+}
+
+// markUsed records a read or reveal of an entry. Only the owner's own use counts, an emergency contact
+// reading the grantor's vault leaves the grantor's history alone. Failures are only logged, they must
+// not fail the read
+func (s *VaultService) markUsed(ctx context.Context, id uuid.UUID) {
+	actorId, _ := auth.ActorIDFromContext(ctx)
+	ownerId, _ := auth.UserIDFromContext(ctx)
+	if actorId != ownerId {
+		return
+	}
+	if err := s.store.MarkUsed(ctx, id); err != nil {
+		log.Printf("failed to record use of entry %s: %v", id, err)
+	}
+}
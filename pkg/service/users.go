@@ -6,11 +6,14 @@ import (
 	"errors"
 	"github.com/AleksZelenchuk/vault-server/gen/go/vaultuserpb"
 	"github.com/AleksZelenchuk/vault-server/pkg/auth"
-	"golang.org/x/crypto/bcrypt"
+	"github.com/AleksZelenchuk/vault-server/pkg/notify"
+	"github.com/AleksZelenchuk/vault-server/pkg/srp"
+	"github.com/AleksZelenchuk/vault-server/pkg/zkclient"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
-	_ "log"
+	"log"
 	"strconv"
+	"time"
 
 	"github.com/AleksZelenchuk/vault-server/pkg/storage"
 	"github.com/google/uuid"
@@ -18,32 +21,63 @@ import (
 
 type UserVaultService struct {
 	vaultuserpb.UnimplementedVaultUserServiceServer
-	store *storage.UserStore
+	store     *storage.UserStore
+	emergency *storage.EmergencyStore
+	notifier  notify.Notifier
+	// emergencyWait is used for contacts added without an explicit wait period
+	emergencyWait time.Duration
+	// kdfDefaults are assigned to users requesting zero-knowledge parameters for the first time
+	kdfDefaults storage.KdfParams
+	// hashParams are the Argon2id costs for new password hashes, older hashes are upgraded on login
+	hashParams  auth.HashParams
+	srpSessions *srpSessions
 	// publisher can be used for Redis PubSub broadcasting
 }
 
-func NewUserVaultService(store *storage.UserStore) *UserVaultService {
-	return &UserVaultService{store: store}
+func NewUserVaultService(store *storage.UserStore, emergency *storage.EmergencyStore, notifier notify.Notifier, emergencyWait time.Duration, kdfDefaults storage.KdfParams, hashParams auth.HashParams) *UserVaultService {
+	return &UserVaultService{
+		store:         store,
+		emergency:     emergency,
+		notifier:      notifier,
+		emergencyWait: emergencyWait,
+		kdfDefaults:   kdfDefaults,
+		hashParams:    hashParams,
+		srpSessions:   newSrpSessions(),
+	}
 }
 
-// Register will create new user from given data
+// Register will create new user from given data. Users registering with an SRP salt and verifier
+// log in with BeginLogin/FinishLogin only and have no password stored
 // todo: add password confirmation field to validate before save
 func (s *UserVaultService) Register(ctx context.Context, req *vaultuserpb.CreateUserRequest) (*vaultuserpb.CreateUserResponse, error) {
 	if req == nil || req.User == nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid request: user data is required")
 	}
 
-	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(req.User.Password), bcrypt.DefaultCost)
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to hash password: %v", err)
-	}
-
 	user := &storage.User{
 		ID:       uuid.New(),
 		Email:    req.User.Email,
 		Username: req.User.Username,
-		Password: hashedPassword,
 	}
+	if len(req.SrpSalt) > 0 || len(req.SrpVerifier) > 0 {
+		// salts of unknown users are faked with exactly SaltLength bytes, any other length would tell
+		// registered users apart in BeginLogin
+		if len(req.SrpSalt) != srp.SaltLength || len(req.SrpVerifier) > 256 || !srp.ValidVerifier(req.SrpVerifier) {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid srp salt or verifier")
+		}
+		if req.User.Password != "" {
+			return nil, status.Errorf(codes.InvalidArgument, "password must not be sent with an srp verifier")
+		}
+		user.SrpSalt = req.SrpSalt
+		user.SrpVerifier = req.SrpVerifier
+	} else {
+		hashedPassword, err := auth.HashPassword(req.User.Password, s.hashParams)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to hash password: %v", err)
+		}
+		user.Password = []byte(hashedPassword)
+	}
+
 	result, err := s.store.CreateUser(ctx, user)
 	if err != nil {
 		return nil, err
@@ -66,10 +100,20 @@ func (s *UserVaultService) Login(ctx context.Context, req *vaultuserpb.LoginRequ
 		return nil, status.Errorf(codes.Internal, "database error: %v", err)
 	}
 
-	// Compare hashed password
-	if err := bcrypt.CompareHashAndPassword(user.Password, []byte(req.Password)); err != nil {
+	// Compare hashed password, SRP users have none and can only use BeginLogin
+	if len(user.Password) == 0 {
 		return nil, status.Errorf(codes.Unauthenticated, "invalid credentials")
 	}
+	ok, needsRehash, err := auth.VerifyPassword(string(user.Password), req.Password, s.hashParams)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to verify password: %v", err)
+	}
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "invalid credentials")
+	}
+	if needsRehash {
+		s.rehashPassword(ctx, user.ID, req.Password)
+	}
 	token, err := auth.GenerateToken(user.ID)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to generate token: %v", err)
@@ -78,6 +122,18 @@ func (s *UserVaultService) Login(ctx context.Context, req *vaultuserpb.LoginRequ
 	return &vaultuserpb.LoginResponse{Token: token}, nil
 }
 
+// rehashPassword upgrades a legacy or outdated hash while the plaintext is at hand, a failure only
+// postpones the upgrade to the next login
+func (s *UserVaultService) rehashPassword(ctx context.Context, id uuid.UUID, password string) {
+	hash, err := auth.HashPassword(password, s.hashParams)
+	if err == nil {
+		err = s.store.UpdatePasswordHash(ctx, id, []byte(hash))
+	}
+	if err != nil {
+		log.Printf("failed to rehash password of user %s: %v", id, err)
+	}
+}
+
 // Deprecated: GetUserByUsername not sure if this is needed
 // probably will rework it to retrieve user by its active id to avoid security issues
 func (s *UserVaultService) GetUserByUsername(ctx context.Context, req *vaultuserpb.GetUserRequest) (*vaultuserpb.GetUserResponse, error) {
@@ -113,6 +169,28 @@ func (s *UserVaultService) DeleteUser(ctx context.Context, req *vaultuserpb.Dele
 	return &vaultuserpb.DeleteUserResponse{Success: success}, nil
 }
 
+// GetKdfParams returns the Argon2id parameters zero-knowledge clients derive their vault key with
+func (s *UserVaultService) GetKdfParams(ctx context.Context, _ *vaultuserpb.GetKdfParamsRequest) (*vaultuserpb.GetKdfParamsResponse, error) {
+	_, ok := auth.UserIDFromContext(ctx)
+	if !ok {
+		return nil, errors.New("no user id provided")
+	}
+
+	params, err := s.store.KdfParams(ctx, s.kdfDefaults)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to load kdf parameters: %v", err)
+	}
+
+	return &vaultuserpb.GetKdfParamsResponse{
+		Algorithm: zkclient.Algorithm,
+		Salt:      params.Salt,
+		Time:      uint32(params.Time.Int32),
+		MemoryKib: uint32(params.MemoryKiB.Int32),
+		Threads:   uint32(params.Threads.Int32),
+		KeyLength: 32,
+	}, nil
+}
+
 // convert user data to proto format
 func userToProto(e *storage.User) *vaultuserpb.VaultUser {
 	return &vaultuserpb.VaultUser{
@@ -5,48 +5,129 @@ import (
 	"database/sql"
 	"errors"
 	"github.com/AleksZelenchuk/vault-server/gen/go/vaultpb"
+	"github.com/AleksZelenchuk/vault-server/pkg/attachment"
+	"github.com/AleksZelenchuk/vault-server/pkg/audit"
 	"github.com/AleksZelenchuk/vault-server/pkg/auth"
+	"github.com/AleksZelenchuk/vault-server/pkg/breach"
+	"github.com/AleksZelenchuk/vault-server/pkg/entrytype"
 	"github.com/AleksZelenchuk/vault-server/pkg/storage"
+	"github.com/AleksZelenchuk/vault-server/pkg/zkclient"
 	"github.com/google/uuid"
-	_ "log"
-	"reflect"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"log"
+	"time"
 )
 
 type VaultService struct {
 	vaultpb.UnimplementedVaultServiceServer
-	store *storage.Store
+	store     *storage.Store
+	emergency *storage.EmergencyStore
+	auditor   *audit.Auditor
+	policies  *storage.PolicyStore
+	// breaches is nil when no breach index is configured
+	breaches    *breach.Index
+	attachments *storage.AttachmentStore
+	// blobs is nil when attachments are disabled
+	blobs           attachment.BlobStore
+	attachmentQuota int64
+	// legacyReveal makes GetEntry and ListEntries return decrypted passwords like before RevealPassword existed
+	legacyReveal bool
 	// publisher can be used for Redis PubSub broadcasting
 }
 
-func NewVaultService(store *storage.Store) *VaultService {
-	return &VaultService{store: store}
+func NewVaultService(store *storage.Store, emergency *storage.EmergencyStore, auditor *audit.Auditor, policies *storage.PolicyStore, breaches *breach.Index, attachments *storage.AttachmentStore, blobs attachment.BlobStore, attachmentQuota int64, legacyReveal bool) *VaultService {
+	return &VaultService{
+		store:           store,
+		emergency:       emergency,
+		auditor:         auditor,
+		policies:        policies,
+		breaches:        breaches,
+		attachments:     attachments,
+		blobs:           blobs,
+		attachmentQuota: attachmentQuota,
+		legacyReveal:    legacyReveal,
+	}
 }
 
 // CreateEntry create entry from given data
 // user_id value is taken from an active user and cannot be passed to avoid data consistency problems
 func (s *VaultService) CreateEntry(ctx context.Context, req *vaultpb.CreateEntryRequest) (*vaultpb.CreateEntryResponse, error) {
+	ctx, err := s.ownerContext(ctx, req.OwnerId, true)
+	if err != nil {
+		return nil, err
+	}
 	userId, errValidate := auth.UserIDFromContext(ctx)
 	if errValidate != true {
 		return nil, errors.New("no user id provided")
 	}
 
-	if !validateEntry(req) {
-		return nil, errors.New("invalid entry data")
+	if err := validateEntry(req.Entry); err != nil {
+		return nil, err
+	}
+	isLogin := req.Entry.Type == vaultpb.EntryType_ENTRY_TYPE_LOGIN
+	if isLogin && req.Entry.Password == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "password is required")
+	}
+	if req.Entry.RotationIntervalDays != nil && *req.Entry.RotationIntervalDays < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "rotation_interval_days must not be negative")
+	}
+	if req.Entry.ClientEncrypted && !validateSealedEntry(req.Entry) {
+		return nil, status.Errorf(codes.InvalidArgument, "client encrypted entry must carry sealed password and notes")
+	}
+	if err := validateTotp(req.Entry); err != nil {
+		return nil, err
+	}
+	if req.EnforcePolicy && isLogin {
+		if req.Entry.ClientEncrypted {
+			return nil, status.Errorf(codes.InvalidArgument, "password policy cannot be enforced on client encrypted entries")
+		}
+		if err := s.enforcePolicy(ctx, req.Entry.Password); err != nil {
+			return nil, err
+		}
+	}
+
+	payload, err := entrytype.MarshalPayload(req.Entry)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to encode payload: %v", err)
+	}
+	// URIs are validated first, resolving the folder path may create folders
+	uris, err := entryURIs(req.Entry)
+	if err != nil {
+		return nil, err
+	}
+	folder, err := s.entryFolder(ctx, req.Entry)
+	if err != nil {
+		return nil, err
 	}
 
 	newUuid := uuid.New()
 	entry := &storage.Entry{
-		ID:       newUuid,
-		UserId:   userId,
-		Title:    req.Entry.Title,
-		Username: req.Entry.Username,
-		Password: []byte(req.Entry.Password),
-		Notes:    sqlNull(req.Entry.Notes),
-		Tags:     req.Entry.Tags,
-		Folder:   sqlNull(req.Entry.Folder),
-		Domain:   sqlNull(req.Entry.Domain),
+		ID:                   newUuid,
+		UserId:               userId,
+		Title:                req.Entry.Title,
+		Username:             req.Entry.Username,
+		Password:             []byte(req.Entry.Password),
+		Notes:                sqlNull(req.Entry.Notes),
+		Tags:                 req.Entry.Tags,
+		FolderId:             folder,
+		Domain:               sqlNull(req.Entry.Domain),
+		ClientEncrypted:      req.Entry.ClientEncrypted,
+		RotationIntervalDays: rotationInterval(req.Entry),
+		Totp:                 []byte(req.Entry.GetTotp()),
+		Type:                 entrytype.Name(req.Entry.Type),
+		Payload:              payload,
+		CustomFields:         customFieldsFromProto(req.Entry.CustomFields),
+		URIs:                 uris,
+		Favorite:             req.Entry.Favorite,
 	}
 	result, err := s.store.Create(ctx, entry)
+	if auditErr := s.auditor.Record(ctx, audit.ActionEntryCreate, newUuid.String(), err); auditErr != nil {
+		log.Printf("audit: failed to record entry creation: %v", auditErr)
+	}
+	if errors.Is(err, storage.FolderNotFound) {
+		return nil, status.Errorf(codes.NotFound, "folder not found")
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -55,49 +136,212 @@ func (s *VaultService) CreateEntry(ctx context.Context, req *vaultpb.CreateEntry
 		return nil, err
 	}
 
-	return &vaultpb.CreateEntryResponse{Id: newUuid.String()}, nil
+	return &vaultpb.CreateEntryResponse{
+		Id:       newUuid.String(),
+		Strength: passwordStrength(req.Entry),
+		Breach:   s.breachCheck(req.Entry),
+	}, nil
+}
+
+// UpdateEntry replaces an entry, the stored password is kept when no new one is given
+func (s *VaultService) UpdateEntry(ctx context.Context, req *vaultpb.UpdateEntryRequest) (*vaultpb.UpdateEntryResponse, error) {
+	ctx, err := s.ownerContext(ctx, req.OwnerId, true)
+	if err != nil {
+		return nil, err
+	}
+	userId, errValidate := auth.UserIDFromContext(ctx)
+	if errValidate != true {
+		return nil, errors.New("no user id provided")
+	}
+
+	if req.Entry == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "entry is required")
+	}
+	id, err := uuid.Parse(req.Entry.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid entry id")
+	}
+	// the stored secrets are needed to keep the ones the update leaves empty
+	current, err := s.store.Get(ctx, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "entry not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "database error: %v", err)
+	}
+	clear(current.Password)
+	current.Password = nil
+
+	if entrytype.Name(req.Entry.Type) != current.Type {
+		return nil, status.Errorf(codes.InvalidArgument, "the type of an entry cannot be changed")
+	}
+
+	// stored secrets are kept unless their encryption changes, then all of them must be sent again
+	updatePassword := req.Entry.Password != ""
+	stored := toProto(current)
+	defer entrytype.Redact(stored)
+	if req.Entry.ClientEncrypted == current.ClientEncrypted {
+		entrytype.KeepSecrets(req.Entry, stored)
+	} else {
+		if current.Type == storage.EntryTypeLogin && !updatePassword {
+			return nil, status.Errorf(codes.InvalidArgument, "changing client_encrypted requires a new password")
+		}
+		if current.Totp != nil && req.Entry.Totp == nil {
+			return nil, status.Errorf(codes.InvalidArgument, "changing client_encrypted requires the totp to be sent again")
+		}
+		storedSecrets := entrytype.PayloadSecrets(stored)
+		for field, secret := range entrytype.PayloadSecrets(req.Entry) {
+			if old := storedSecrets[field]; *secret == "" && old != nil && *old != "" {
+				return nil, status.Errorf(codes.InvalidArgument, "changing client_encrypted requires the payload secrets to be sent again")
+			}
+		}
+		storedHidden := entrytype.HiddenFields(stored)
+		for name, value := range entrytype.HiddenFields(req.Entry) {
+			if old := storedHidden[name]; *value == "" && old != nil && *old != "" {
+				return nil, status.Errorf(codes.InvalidArgument, "changing client_encrypted requires hidden custom fields to be sent again")
+			}
+		}
+	}
+	if err := validateEntry(req.Entry); err != nil {
+		return nil, err
+	}
+	if req.Entry.RotationIntervalDays != nil && *req.Entry.RotationIntervalDays < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "rotation_interval_days must not be negative")
+	}
+	if req.Entry.ClientEncrypted && !validateSealedEntry(req.Entry) {
+		return nil, status.Errorf(codes.InvalidArgument, "client encrypted entry must carry sealed password and notes")
+	}
+	if err := validateTotp(req.Entry); err != nil {
+		return nil, err
+	}
+	if updatePassword && req.EnforcePolicy && current.Type == storage.EntryTypeLogin {
+		if req.Entry.ClientEncrypted {
+			return nil, status.Errorf(codes.InvalidArgument, "password policy cannot be enforced on client encrypted entries")
+		}
+		if err := s.enforcePolicy(ctx, req.Entry.Password); err != nil {
+			return nil, err
+		}
+	}
+
+	payload, err := entrytype.MarshalPayload(req.Entry)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to encode payload: %v", err)
+	}
+	// URIs are validated first, resolving the folder path may create folders
+	uris, err := entryURIs(req.Entry)
+	if err != nil {
+		return nil, err
+	}
+	folder, err := s.entryFolder(ctx, req.Entry)
+	if err != nil {
+		return nil, err
+	}
+	entry := &storage.Entry{
+		ID:                   id,
+		UserId:               userId,
+		Title:                req.Entry.Title,
+		Username:             req.Entry.Username,
+		Password:             []byte(req.Entry.Password),
+		Notes:                sqlNull(req.Entry.Notes),
+		Tags:                 req.Entry.Tags,
+		FolderId:             folder,
+		Domain:               sqlNull(req.Entry.Domain),
+		ClientEncrypted:      req.Entry.ClientEncrypted,
+		RotationIntervalDays: rotationInterval(req.Entry),
+		Totp:                 []byte(req.Entry.GetTotp()),
+		Type:                 current.Type,
+		Payload:              payload,
+		CustomFields:         customFieldsFromProto(req.Entry.CustomFields),
+		URIs:                 uris,
+	}
+	err = s.store.Update(ctx, entry, updatePassword, req.Entry.Totp != nil)
+	if auditErr := s.auditor.Record(ctx, audit.ActionEntryUpdate, id.String(), err); auditErr != nil {
+		log.Printf("audit: failed to record entry update: %v", auditErr)
+	}
+	if err != nil {
+		if errors.Is(err, storage.PermissionDenied) {
+			return nil, status.Errorf(codes.PermissionDenied, "%v", err)
+		}
+		if errors.Is(err, storage.FolderNotFound) {
+			return nil, status.Errorf(codes.NotFound, "folder not found")
+		}
+		return nil, status.Errorf(codes.Internal, "database error: %v", err)
+	}
+
+	resp := &vaultpb.UpdateEntryResponse{}
+	if updatePassword {
+		resp.Strength = passwordStrength(req.Entry)
+		resp.Breach = s.breachCheck(req.Entry)
+	}
+	return resp, nil
 }
 
-// validateEntry need to validate entry data to make sure required fields are there to avoid panic
-func validateEntry(req *vaultpb.CreateEntryRequest) bool {
-	entry := req.Entry
+// validateEntry applies the rules of the entry's type, see pkg/entrytype
+func validateEntry(entry *vaultpb.VaultEntry) error {
 	if entry == nil {
+		return status.Errorf(codes.InvalidArgument, "entry is required")
+	}
+	if err := entrytype.Validate(entry); err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid entry: %v", err)
+	}
+	return nil
+}
+
+// validateSealedEntry makes sure secrets of a client encrypted entry are ciphertext, so plaintext is
+// never stored without server side encryption. Empty secrets are left to the type validation
+func validateSealedEntry(entry *vaultpb.VaultEntry) bool {
+	if entry.Password != "" && !zkclient.IsSealed(entry.Password) {
 		return false
 	}
-	v := reflect.ValueOf(entry).Elem()
-	for _, field := range []string{"Title", "Username", "Password"} {
-		val := v.FieldByName(field)
-		if !val.IsValid() || val.Kind() != reflect.String || val.String() == "" {
+	for _, secret := range entrytype.PayloadSecrets(entry) {
+		if *secret != "" && !zkclient.IsSealed(*secret) {
 			return false
 		}
 	}
-
-	// ToDo for future check this field
-	/*tags := v.FieldByName("Tags")
-	if !tags.IsValid() || tags.Kind() != reflect.Slice || tags.Len() == 0 {
-		return false
-	}*/
-
-	return true
+	for _, value := range entrytype.HiddenFields(entry) {
+		if *value != "" && !zkclient.IsSealed(*value) {
+			return false
+		}
+	}
+	return entry.Notes == "" || zkclient.IsSealed(entry.Notes)
 }
 
+// GetEntry returns entry metadata, the password is left empty unless legacy reveal is enabled
 func (s *VaultService) GetEntry(ctx context.Context, req *vaultpb.GetEntryRequest) (*vaultpb.GetEntryResponse, error) {
 	_, err := auth.UserIDFromContext(ctx)
 	if err != true {
 		return nil, errors.New("no user id provided")
 	}
+	ctx, errOwner := s.ownerContext(ctx, req.OwnerId, false)
+	if errOwner != nil {
+		return nil, errOwner
+	}
 
 	id, err2 := uuid.Parse(req.Id)
 	if err2 != nil {
 		return nil, err2
 	}
 
-	entry, err2 := s.store.Get(ctx, id)
+	var entry *storage.Entry
+	if s.legacyReveal {
+		entry, err2 = s.store.Get(ctx, id)
+	} else {
+		entry, err2 = s.store.GetMetadata(ctx, id)
+	}
+	// reads are audited before any secret leaves the server, an unrecorded read is refused
+	if auditErr := s.auditor.Record(ctx, audit.ActionEntryRead, id.String(), err2); auditErr != nil {
+		return nil, status.Errorf(codes.Internal, "failed to record audit event")
+	}
 	if err2 != nil {
 		return nil, err2
 	}
+	s.markUsed(ctx, id)
 
-	return &vaultpb.GetEntryResponse{Entry: toProto(entry)}, nil
+	result := toProto(entry)
+	if !s.legacyReveal {
+		entrytype.Redact(result)
+	}
+	return &vaultpb.GetEntryResponse{Entry: result}, nil
 }
 
 func (s *VaultService) DeleteEntry(ctx context.Context, req *vaultpb.DeleteEntryRequest) (*vaultpb.DeleteEntryResponse, error) {
@@ -105,54 +349,281 @@ func (s *VaultService) DeleteEntry(ctx context.Context, req *vaultpb.DeleteEntry
 	if errValidate != true {
 		return nil, errors.New("no user id provided")
 	}
+	ctx, errOwner := s.ownerContext(ctx, req.OwnerId, true)
+	if errOwner != nil {
+		return nil, errOwner
+	}
 
 	id, err2 := uuid.Parse(req.Id)
 	if err2 != nil {
 		return nil, err2
 	}
 
+	// attachment rows go with the entry, their blobs are deleted once the entry is gone
+	attachments, err2 := s.attachments.ListByEntry(ctx, id)
+	if err2 != nil {
+		return nil, status.Errorf(codes.Internal, "database error: %v", err2)
+	}
 	success, err2 := s.store.Delete(ctx, id)
+	if auditErr := s.auditor.Record(ctx, audit.ActionEntryDelete, id.String(), err2); auditErr != nil {
+		log.Printf("audit: failed to record entry deletion: %v", auditErr)
+	}
 	if err2 != nil {
 		if errors.Is(err2, sql.ErrNoRows) {
 			return nil, errors.New("entry not found")
 		}
 		return nil, err2
 	}
+	for _, a := range attachments {
+		s.deleteBlob(a.BlobRef)
+	}
 
 	return &vaultpb.DeleteEntryResponse{Success: success}, nil
 }
 
-// ListEntries here we retrieve list of all entries eligible for active user
+// ListEntries here we retrieve list of all entries eligible for active user,
+// passwords are left empty unless legacy reveal is enabled
 func (s *VaultService) ListEntries(ctx context.Context, req *vaultpb.ListEntriesRequest) (*vaultpb.ListEntriesResponse, error) {
 	_, errValidate := auth.UserIDFromContext(ctx)
 	if errValidate != true {
 		return nil, errors.New("no user id provided")
 	}
+	ctx, err := s.ownerContext(ctx, req.OwnerId, false)
+	if err != nil {
+		return nil, err
+	}
+
+	userId, _ := auth.UserIDFromContext(ctx)
+	opts, err := listOptions(req, userId)
+	if err != nil {
+		return nil, err
+	}
+	opts.RevealPasswords = s.legacyReveal
 
-	resp, err := s.store.List(ctx, req.Domain, req.Folder, req.Tags)
+	page, err := s.store.List(ctx, opts)
 	if err != nil {
 		return nil, err
 	}
+	token, err := nextPageToken(req, page, userId)
+	if err != nil {
+		return nil, err
+	}
+	resp := page.Entries
 	var vaultEntries []*vaultpb.VaultEntry
+	if !s.legacyReveal {
+		for _, entry := range resp {
+			result := toProto(&entry)
+			entrytype.Redact(result)
+			vaultEntries = append(vaultEntries, result)
+		}
+		return &vaultpb.ListEntriesResponse{Entries: vaultEntries, NextPageToken: token, TotalSize: int32(page.Total)}, nil
+	}
+
+	ids := make([]string, 0, len(resp))
+	for _, entry := range resp {
+		ids = append(ids, entry.ID.String())
+	}
+	if auditErr := s.auditor.RecordEntries(ctx, audit.ActionEntryRead, ids, nil); auditErr != nil {
+		return nil, status.Errorf(codes.Internal, "failed to record audit event")
+	}
+
 	for _, entry := range resp {
-		entry.Password, _ = storage.Decrypt(entry.Password)
 		vaultEntries = append(vaultEntries, toProto(&entry))
 	}
 
-	return &vaultpb.ListEntriesResponse{Entries: vaultEntries}, nil
+	return &vaultpb.ListEntriesResponse{Entries: vaultEntries, NextPageToken: token, TotalSize: int32(page.Total)}, nil
+}
+
+// RevealPassword decrypts a single secret field of an entry. Every call is audited before the secret is
+// returned and the RPC has its own rate limit
+func (s *VaultService) RevealPassword(ctx context.Context, req *vaultpb.RevealPasswordRequest) (*vaultpb.RevealPasswordResponse, error) {
+	_, errValidate := auth.UserIDFromContext(ctx)
+	if errValidate != true {
+		return nil, errors.New("no user id provided")
+	}
+	ctx, err := s.ownerContext(ctx, req.OwnerId, false)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid entry id")
+	}
+	if _, ok := vaultpb.SecretField_name[int32(req.Field)]; !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported secret field")
+	}
+
+	entry, err := s.store.Get(ctx, id)
+	if auditErr := s.auditor.Record(ctx, audit.ActionEntryReveal, id.String(), err); auditErr != nil {
+		return nil, status.Errorf(codes.Internal, "failed to record audit event")
+	}
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, status.Errorf(codes.NotFound, "entry not found")
+		}
+		return nil, err
+	}
+	s.markUsed(ctx, id)
+
+	if req.Field == vaultpb.SecretField_SECRET_FIELD_TOTP {
+		if entry.Totp == nil {
+			return nil, status.Errorf(codes.NotFound, "entry has no totp secret")
+		}
+		return &vaultpb.RevealPasswordResponse{Value: string(entry.Totp)}, nil
+	}
+	if req.Field == vaultpb.SecretField_SECRET_FIELD_CUSTOM_FIELD {
+		result := toProto(entry)
+		defer entrytype.Redact(result)
+		value, ok := entrytype.HiddenFields(result)[req.CustomFieldName]
+		if !ok {
+			return nil, status.Errorf(codes.NotFound, "entry has no hidden custom field %q", req.CustomFieldName)
+		}
+		return &vaultpb.RevealPasswordResponse{Value: *value}, nil
+	}
+	if req.Field != vaultpb.SecretField_SECRET_FIELD_PASSWORD {
+		result := toProto(entry)
+		defer entrytype.Redact(result)
+		secret, ok := entrytype.PayloadSecrets(result)[req.Field]
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "secret field %s does not belong to a %s entry", req.Field, entry.Type)
+		}
+		if *secret == "" {
+			return nil, status.Errorf(codes.NotFound, "secret field %s is not set", req.Field)
+		}
+		return &vaultpb.RevealPasswordResponse{Value: *secret}, nil
+	}
+	if entry.Type != storage.EntryTypeLogin {
+		return nil, status.Errorf(codes.InvalidArgument, "%s entries have no password", entry.Type)
+	}
+	return &vaultpb.RevealPasswordResponse{Value: string(entry.Password)}, nil
+}
+
+// QueryAuditLog returns audit events of the active user, admins may query events of all users
+func (s *VaultService) QueryAuditLog(ctx context.Context, req *vaultpb.QueryAuditLogRequest) (*vaultpb.QueryAuditLogResponse, error) {
+	_, errValidate := auth.UserIDFromContext(ctx)
+	if errValidate != true {
+		return nil, errors.New("no user id provided")
+	}
+
+	q := storage.AuditQuery{
+		ActorId:  req.ActorId,
+		EntryId:  req.EntryId,
+		Action:   req.Action,
+		Limit:    int(req.Limit),
+		AllUsers: req.AllUsers,
+	}
+	if req.Since > 0 {
+		q.Since = time.Unix(req.Since, 0)
+	}
+	if req.Until > 0 {
+		q.Until = time.Unix(req.Until, 0)
+	}
+
+	events, err := s.auditor.Query(ctx, q)
+	if err != nil {
+		if errors.Is(err, storage.PermissionDenied) {
+			return nil, status.Errorf(codes.PermissionDenied, "%v", err)
+		}
+		return nil, err
+	}
+
+	resp := &vaultpb.QueryAuditLogResponse{}
+	for _, e := range events {
+		resp.Events = append(resp.Events, auditEventToProto(&e))
+	}
+	return resp, nil
+}
+
+// ownerContext lets an emergency contact act on the grantor's vault. When ownerId is set and differs
+// from the active user an active emergency grant is required (a takeover grant when takeover is true)
+// and the returned context carries the grantor as the active user for storage calls
+func (s *VaultService) ownerContext(ctx context.Context, ownerId string, takeover bool) (context.Context, error) {
+	userId, _ := auth.UserIDFromContext(ctx)
+	if ownerId == "" || ownerId == userId {
+		return ctx, nil
+	}
+	if _, err := uuid.Parse(ownerId); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid owner id")
+	}
+
+	grant, err := s.emergency.ActiveGrant(ctx, ownerId)
+	if err != nil {
+		if errors.Is(err, storage.NoEmergencyGrant) {
+			return nil, status.Errorf(codes.PermissionDenied, "%v", err)
+		}
+		return nil, err
+	}
+	if takeover && grant.AccessLevel != storage.EmergencyAccessTakeover {
+		return nil, status.Errorf(codes.PermissionDenied, "emergency grant allows view access only")
+	}
+
+	ctx = auth.WithActorID(ctx, userId)
+	return auth.WithUserID(ctx, ownerId), nil
 }
 
 // Helpers
 func toProto(e *storage.Entry) *vaultpb.VaultEntry {
-	return &vaultpb.VaultEntry{
-		Id:       e.ID.String(),
-		Title:    e.Title,
-		Username: e.Username,
-		Password: string(e.Password),
-		Notes:    e.Notes.String,
-		Tags:     e.Tags,
-		Folder:   e.Folder.String,
-		Domain:   e.Domain.String,
+	entry := &vaultpb.VaultEntry{
+		Id:                e.ID.String(),
+		Title:             e.Title,
+		Username:          e.Username,
+		Password:          string(e.Password),
+		Notes:             e.Notes.String,
+		Tags:              e.Tags,
+		Folder:            e.Folder.String,
+		Domain:            e.Domain.String,
+		ClientEncrypted:   e.ClientEncrypted,
+		Reused:            e.Reused,
+		PasswordChangedAt: e.PasswordChangedAt.Unix(),
+		HasTotp:           e.Totp != nil,
+		Type:              entrytype.FromName(e.Type),
+		Favorite:          e.Favorite,
+		Uris:              urisToProto(e.URIs),
+	}
+	if e.LastUsedAt.Valid {
+		entry.LastUsedAt = e.LastUsedAt.Time.Unix()
+	}
+	if e.FolderId.Valid {
+		entry.FolderId = e.FolderId.UUID.String()
+	}
+	if e.RotationIntervalDays.Valid {
+		entry.RotationIntervalDays = &e.RotationIntervalDays.Int32
+	}
+	if err := entrytype.UnmarshalPayload(entry, e.Payload); err != nil {
+		log.Printf("entry %s: failed to decode payload: %v", e.ID, err)
+	}
+	for _, f := range e.CustomFields {
+		entry.CustomFields = append(entry.CustomFields, &vaultpb.CustomField{
+			Name:  f.Name,
+			Type:  entrytype.CustomFieldFromName(f.Type),
+			Value: string(f.Value),
+		})
+	}
+	return entry
+}
+
+func customFieldsFromProto(fields []*vaultpb.CustomField) storage.CustomFields {
+	if len(fields) == 0 {
+		return nil
+	}
+	result := make(storage.CustomFields, 0, len(fields))
+	for _, f := range fields {
+		result = append(result, storage.CustomField{Name: f.Name, Type: entrytype.CustomFieldName(f.Type), Value: []byte(f.Value)})
+	}
+	return result
+}
+
+func auditEventToProto(e *storage.AuditEvent) *vaultpb.AuditEvent {
+	return &vaultpb.AuditEvent{
+		Id:        e.ID.String(),
+		ActorId:   e.ActorId.String,
+		OwnerId:   e.OwnerId.String,
+		Action:    e.Action,
+		EntryId:   e.EntryId.String,
+		Peer:      e.Peer.String,
+		Outcome:   e.Outcome,
+		Timestamp: e.CreatedAt.Unix(),
 	}
 }
 
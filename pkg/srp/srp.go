@@ -0,0 +1,228 @@
+// Package srp implements the SRP-6a augmented PAKE (RFC 5054 group, SHA-256) used by
+// BeginLogin/FinishLogin, so the login password never reaches the server. The server only keeps
+// the salt and the verifier v = g^x, with x = H(salt | H(username | ":" | password)).
+package srp
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"errors"
+	"math/big"
+)
+
+// 2048-bit group from RFC 5054 appendix A
+var (
+	groupN, _ = new(big.Int).SetString(""+
+		"AC6BDB41324A9A9BF166DE5E1389582FAF72B6651987EE07FC3192943DB56050"+
+		"A37329CBB4A099ED8193E0757767A13DD52312AB4B03310DCD7F48A9DA04FD50"+
+		"E8083969EDB767B0CF6095179A163AB3661A05FBD5FAAAE82918A9962F0B93B8"+
+		"55F97993EC975EEAA80D740ADBF4FF747359D041D5C33EA71D281E446B14773B"+
+		"CA97B43A23FB801676BD207A436C6481F1D2B9078717461A5B9D32E688F87748"+
+		"544523B524B0D57D5EA77A2775D2ECFA032CFBDBF52FB3786160279004E57AE6"+
+		"AF874E7303CE53299CCC041C7BC308D82A5698F3A8D0C38271AE35F8E9DBFBB6"+
+		"94B5C803D89F7AE435DE236D525F54759B65E372FCD68EF20FA7111F9E4AFF73", 16)
+	groupG = big.NewInt(2)
+	// k = H(N | PAD(g))
+	multiplierK = hashInt(groupN.Bytes(), pad(groupG))
+)
+
+const SaltLength = 16
+
+var ErrInvalidPublicValue = errors.New("srp: invalid public value")
+var ErrAuthenticationFailed = errors.New("srp: authentication failed")
+
+// NewSalt returns a random salt for ComputeVerifier
+func NewSalt() ([]byte, error) {
+	salt := make([]byte, SaltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+	return salt, nil
+}
+
+// ComputeVerifier returns v = g^x mod N, it is computed by the client at registration
+func ComputeVerifier(username, password string, salt []byte) []byte {
+	x := privateKey(username, password, salt)
+	return new(big.Int).Exp(groupG, x, groupN).Bytes()
+}
+
+// ValidVerifier reports whether a verifier sent at registration is a value in (0, N). A verifier of 0
+// would make every login succeed without knowing the password
+func ValidVerifier(verifier []byte) bool {
+	return validPublic(new(big.Int).SetBytes(verifier))
+}
+
+// ServerSession is the server side of a single login attempt
+type ServerSession struct {
+	publicB *big.Int
+	key     []byte
+	m1      []byte
+	m2      []byte
+}
+
+// NewServerSession starts a login for a user with stored salt and verifier after receiving the
+// client's public value A
+func NewServerSession(username string, salt, verifier, publicA []byte) (*ServerSession, error) {
+	A := new(big.Int).SetBytes(publicA)
+	if !validPublic(A) {
+		return nil, ErrInvalidPublicValue
+	}
+	v := new(big.Int).SetBytes(verifier)
+
+	b, err := randomExponent()
+	if err != nil {
+		return nil, err
+	}
+	// B = k*v + g^b mod N
+	B := new(big.Int).Mul(multiplierK, v)
+	B.Add(B, new(big.Int).Exp(groupG, b, groupN))
+	B.Mod(B, groupN)
+
+	u := hashInt(pad(A), pad(B))
+	if u.Sign() == 0 {
+		return nil, ErrInvalidPublicValue
+	}
+
+	// S = (A * v^u) ^ b mod N
+	S := new(big.Int).Exp(v, u, groupN)
+	S.Mul(S, A)
+	S.Mod(S, groupN)
+	S.Exp(S, b, groupN)
+
+	key := hash(pad(S))
+	m1 := clientProof(username, salt, A, B, key)
+	return &ServerSession{
+		publicB: B,
+		key:     key,
+		m1:      m1,
+		m2:      hash(pad(A), m1, key),
+	}, nil
+}
+
+// PublicB is the server's public value sent back to the client
+func (s *ServerSession) PublicB() []byte {
+	return pad(s.publicB)
+}
+
+// Verify checks the client proof M1 and returns the server proof M2 on success
+func (s *ServerSession) Verify(m1 []byte) ([]byte, error) {
+	if subtle.ConstantTimeCompare(m1, s.m1) != 1 {
+		return nil, ErrAuthenticationFailed
+	}
+	return s.m2, nil
+}
+
+// Client is the client side of a single login attempt
+type Client struct {
+	username string
+	password string
+	a, A     *big.Int
+	m2       []byte
+	key      []byte
+}
+
+func NewClient(username, password string) (*Client, error) {
+	a, err := randomExponent()
+	if err != nil {
+		return nil, err
+	}
+	return &Client{
+		username: username,
+		password: password,
+		a:        a,
+		A:        new(big.Int).Exp(groupG, a, groupN),
+	}, nil
+}
+
+// PublicA is sent in BeginLogin
+func (c *Client) PublicA() []byte {
+	return pad(c.A)
+}
+
+// ProcessChallenge takes salt and B from BeginLogin and returns the proof M1 for FinishLogin
+func (c *Client) ProcessChallenge(salt, publicB []byte) ([]byte, error) {
+	B := new(big.Int).SetBytes(publicB)
+	if !validPublic(B) {
+		return nil, ErrInvalidPublicValue
+	}
+	u := hashInt(pad(c.A), pad(B))
+	if u.Sign() == 0 {
+		return nil, ErrInvalidPublicValue
+	}
+	x := privateKey(c.username, c.password, salt)
+
+	// S = (B - k * g^x) ^ (a + u * x) mod N
+	base := new(big.Int).Exp(groupG, x, groupN)
+	base.Mul(base, multiplierK)
+	base.Sub(B, base)
+	base.Mod(base, groupN)
+	exp := new(big.Int).Mul(u, x)
+	exp.Add(exp, c.a)
+	S := new(big.Int).Exp(base, exp, groupN)
+
+	c.key = hash(pad(S))
+	m1 := clientProof(c.username, salt, c.A, B, c.key)
+	c.m2 = hash(pad(c.A), m1, c.key)
+	return m1, nil
+}
+
+// VerifyServer checks the server proof M2 returned by FinishLogin
+func (c *Client) VerifyServer(m2 []byte) error {
+	if c.m2 == nil || subtle.ConstantTimeCompare(m2, c.m2) != 1 {
+		return ErrAuthenticationFailed
+	}
+	return nil
+}
+
+// SessionKey is the shared key K, both sides know it after a successful exchange
+func (c *Client) SessionKey() []byte {
+	return c.key
+}
+
+// clientProof computes M1 = H(H(N) xor H(g) | H(I) | s | PAD(A) | PAD(B) | K)
+func clientProof(username string, salt []byte, A, B *big.Int, key []byte) []byte {
+	hn := hash(groupN.Bytes())
+	hg := hash(pad(groupG))
+	for i := range hn {
+		hn[i] ^= hg[i]
+	}
+	return hash(hn, hash([]byte(username)), salt, pad(A), pad(B), key)
+}
+
+// privateKey computes x = H(s | H(I | ":" | P))
+func privateKey(username, password string, salt []byte) *big.Int {
+	inner := hash([]byte(username), []byte(":"), []byte(password))
+	return hashInt(salt, inner)
+}
+
+// validPublic only accepts public values in (0, N), values that are 0 mod N would let an attacker force S
+func validPublic(v *big.Int) bool {
+	return v.Sign() > 0 && v.Cmp(groupN) < 0
+}
+
+func randomExponent() (*big.Int, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return nil, err
+	}
+	return new(big.Int).SetBytes(buf), nil
+}
+
+// pad left-pads v to the byte length of N
+func pad(v *big.Int) []byte {
+	out := make([]byte, (groupN.BitLen()+7)/8)
+	return v.FillBytes(out)
+}
+
+func hash(parts ...[]byte) []byte {
+	h := sha256.New()
+	for _, p := range parts {
+		h.Write(p)
+	}
+	return h.Sum(nil)
+}
+
+func hashInt(parts ...[]byte) *big.Int {
+	return new(big.Int).SetBytes(hash(parts...))
+}
@@ -0,0 +1,110 @@
+package srp
+
+import (
+	"bytes"
+	"errors"
+	"math/big"
+	"testing"
+)
+
+// login runs one exchange of a client knowing password against a user registered with verifier
+func login(t *testing.T, username, password string, salt, verifier []byte) (*Client, *ServerSession, []byte) {
+	t.Helper()
+	c, err := NewClient(username, password)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := NewServerSession(username, salt, verifier, c.PublicA())
+	if err != nil {
+		t.Fatal(err)
+	}
+	m1, err := c.ProcessChallenge(salt, s.PublicB())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c, s, m1
+}
+
+func TestRoundTrip(t *testing.T) {
+	salt, err := NewSalt()
+	if err != nil {
+		t.Fatal(err)
+	}
+	verifier := ComputeVerifier("alice", "correct horse", salt)
+	if !ValidVerifier(verifier) {
+		t.Fatal("computed verifier is not valid")
+	}
+
+	c, s, m1 := login(t, "alice", "correct horse", salt, verifier)
+	m2, err := s.Verify(m1)
+	if err != nil {
+		t.Fatalf("server rejected the client proof: %v", err)
+	}
+	if err := c.VerifyServer(m2); err != nil {
+		t.Fatalf("client rejected the server proof: %v", err)
+	}
+	if len(c.SessionKey()) == 0 || !bytes.Equal(c.SessionKey(), s.key) {
+		t.Fatal("client and server derived different session keys")
+	}
+}
+
+func TestWrongPassword(t *testing.T) {
+	salt, err := NewSalt()
+	if err != nil {
+		t.Fatal(err)
+	}
+	verifier := ComputeVerifier("alice", "correct horse", salt)
+
+	cases := []struct {
+		name     string
+		username string
+		password string
+	}{
+		{"wrong password", "alice", "battery staple"},
+		{"wrong username", "bob", "correct horse"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			c, s, m1 := login(t, tc.username, tc.password, salt, verifier)
+			if _, err := s.Verify(m1); !errors.Is(err, ErrAuthenticationFailed) {
+				t.Fatalf("Verify = %v, want ErrAuthenticationFailed", err)
+			}
+			if bytes.Equal(c.SessionKey(), s.key) {
+				t.Fatal("session keys match without the password")
+			}
+			// a server proof from another exchange must not convince the client either
+			if err := c.VerifyServer(s.m2); !errors.Is(err, ErrAuthenticationFailed) {
+				t.Fatalf("VerifyServer = %v, want ErrAuthenticationFailed", err)
+			}
+		})
+	}
+}
+
+func TestInvalidPublicValues(t *testing.T) {
+	salt, err := NewSalt()
+	if err != nil {
+		t.Fatal(err)
+	}
+	verifier := ComputeVerifier("alice", "correct horse", salt)
+	invalid := map[string][]byte{
+		"zero":       {0},
+		"empty":      nil,
+		"N":          groupN.Bytes(),
+		"N plus one": new(big.Int).Add(groupN, big.NewInt(1)).Bytes(),
+	}
+	for name, v := range invalid {
+		if _, err := NewServerSession("alice", salt, verifier, v); !errors.Is(err, ErrInvalidPublicValue) {
+			t.Errorf("A = %s: NewServerSession = %v, want ErrInvalidPublicValue", name, err)
+		}
+		c, err := NewClient("alice", "correct horse")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := c.ProcessChallenge(salt, v); !errors.Is(err, ErrInvalidPublicValue) {
+			t.Errorf("B = %s: ProcessChallenge = %v, want ErrInvalidPublicValue", name, err)
+		}
+		if ValidVerifier(v) {
+			t.Errorf("verifier %s is accepted", name)
+		}
+	}
+}
@@ -0,0 +1,111 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"github.com/AleksZelenchuk/vault-server/pkg/auth"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+var QuotaExceeded = errors.New("attachment quota exceeded")
+
+type AttachmentStore struct{ db *sqlx.DB }
+
+func NewAttachmentStore(db *sqlx.DB) *AttachmentStore {
+	return &AttachmentStore{db: db}
+}
+
+// Insert records an uploaded attachment of the active user, it fails with QuotaExceeded when the user's
+// attachments would exceed quota bytes in total. Inserts of a user are serialized, so concurrent uploads
+// cannot each pass the quota check
+func (s *AttachmentStore) Insert(ctx context.Context, a *Attachment, quota int64) error {
+	userId, _ := auth.UserIDFromContext(ctx)
+	if userId == "" {
+		return NoUserId
+	}
+	a.UserId = userId
+
+	tx, err := s.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if _, err := tx.ExecContext(ctx, `SELECT pg_advisory_xact_lock(hashtext('vault_attachments/' || $1))`, userId); err != nil {
+		return err
+	}
+	var used int64
+	if err := tx.GetContext(ctx, &used, `SELECT COALESCE(SUM(size), 0) FROM vault_attachments WHERE user_id=$1`, userId); err != nil {
+		return err
+	}
+	if used+a.Size > quota {
+		return QuotaExceeded
+	}
+
+	err = tx.GetContext(ctx, &a.CreatedAt, `
+		INSERT INTO vault_attachments (id, entry_id, user_id, file_name, content_type, size, backend, blob_ref, encryption_key)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		RETURNING created_at`,
+		a.ID, a.EntryId, a.UserId, a.FileName, a.ContentType, a.Size, a.Backend, a.BlobRef, a.EncryptionKey)
+	if err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// Usage returns the total size of the active user's attachments in bytes
+func (s *AttachmentStore) Usage(ctx context.Context) (int64, error) {
+	userId, _ := auth.UserIDFromContext(ctx)
+	if userId == "" {
+		return 0, NoUserId
+	}
+
+	var used int64
+	err := s.db.GetContext(ctx, &used, `SELECT COALESCE(SUM(size), 0) FROM vault_attachments WHERE user_id=$1`, userId)
+	return used, err
+}
+
+// Get returns an attachment of the active user
+func (s *AttachmentStore) Get(ctx context.Context, id uuid.UUID) (*Attachment, error) {
+	userId, _ := auth.UserIDFromContext(ctx)
+	if userId == "" {
+		return nil, NoUserId
+	}
+
+	var a Attachment
+	err := s.db.GetContext(ctx, &a, `SELECT * FROM vault_attachments WHERE id=$1 AND user_id=$2`, id, userId)
+	if err != nil {
+		return nil, err
+	}
+	return &a, nil
+}
+
+// ListByEntry returns the attachments of one of the active user's entries, oldest first
+func (s *AttachmentStore) ListByEntry(ctx context.Context, entryId uuid.UUID) ([]Attachment, error) {
+	userId, _ := auth.UserIDFromContext(ctx)
+	if userId == "" {
+		return nil, NoUserId
+	}
+
+	var attachments []Attachment
+	err := s.db.SelectContext(ctx, &attachments,
+		`SELECT * FROM vault_attachments WHERE entry_id=$1 AND user_id=$2 ORDER BY created_at`, entryId, userId)
+	return attachments, err
+}
+
+// Delete removes the metadata of an attachment of the active user and returns it, so the caller can
+// delete the blob
+func (s *AttachmentStore) Delete(ctx context.Context, id uuid.UUID) (*Attachment, error) {
+	userId, _ := auth.UserIDFromContext(ctx)
+	if userId == "" {
+		return nil, NoUserId
+	}
+
+	var a Attachment
+	err := s.db.GetContext(ctx, &a, `DELETE FROM vault_attachments WHERE id=$1 AND user_id=$2 RETURNING *`, id, userId)
+	if err != nil {
+		return nil, err
+	}
+	return &a, nil
+}
@@ -0,0 +1,197 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+const auditChainPurpose = "audit-chain"
+
+// auditChainLockId is the advisory lock key held while appending to the chain
+const auditChainLockId = 0x7661756c74 // "vault"
+
+// ChainProblem describes a single inconsistency found while verifying the audit chain
+type ChainProblem struct {
+	Seq     int64
+	Problem string
+}
+
+// ChainReport is the result of VerifyChain
+type ChainReport struct {
+	Checked     int64
+	Unchained   int64
+	Head        int64
+	Checkpoints int
+	Problems    []ChainProblem
+}
+
+// Checkpoint stores the current chain head, a later truncation of the chain behind a checkpoint is
+// detected by VerifyChain. Returns nil when the head is already checkpointed or the chain is empty
+func (s *AuditStore) Checkpoint(ctx context.Context) (*AuditCheckpoint, error) {
+	var c AuditCheckpoint
+	err := s.db.GetContext(ctx, &c, `
+		INSERT INTO audit_checkpoints (seq, hash)
+		SELECT seq, hash FROM audit_events WHERE hash IS NOT NULL ORDER BY seq DESC LIMIT 1
+		ON CONFLICT (seq) DO NOTHING
+		RETURNING *`)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &c, nil
+}
+
+// VerifyChain walks the whole audit log in order and reports missing, reordered or modified events
+// as well as checkpoints that no longer match the chain
+func (s *AuditStore) VerifyChain(ctx context.Context) (*ChainReport, error) {
+	key, err := DeriveKey(auditChainPurpose)
+	if err != nil {
+		return nil, err
+	}
+
+	var checkpoints []AuditCheckpoint
+	if err := s.db.SelectContext(ctx, &checkpoints, `SELECT * FROM audit_checkpoints ORDER BY seq`); err != nil {
+		return nil, err
+	}
+
+	rows, err := s.db.QueryxContext(ctx, `SELECT * FROM audit_events ORDER BY seq`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	v := newChainVerifier(key, checkpoints)
+	for rows.Next() {
+		var e AuditEvent
+		if err := rows.StructScan(&e); err != nil {
+			return nil, err
+		}
+		v.check(&e)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return v.finish(), nil
+}
+
+// chainVerifier checks audit events handed to it in seq order against each other and the checkpoints
+type chainVerifier struct {
+	key         []byte
+	checkpoints []AuditCheckpoint
+	pending     map[int64][]byte
+	report      *ChainReport
+	prevSeq     int64
+	prevHash    []byte
+	chained     bool
+}
+
+func newChainVerifier(key []byte, checkpoints []AuditCheckpoint) *chainVerifier {
+	pending := make(map[int64][]byte, len(checkpoints))
+	for _, c := range checkpoints {
+		pending[c.Seq] = c.Hash
+	}
+	return &chainVerifier{
+		key:         key,
+		checkpoints: checkpoints,
+		pending:     pending,
+		report:      &ChainReport{Checkpoints: len(checkpoints)},
+	}
+}
+
+func (v *chainVerifier) problem(seq int64, format string, args ...interface{}) {
+	v.report.Problems = append(v.report.Problems, ChainProblem{Seq: seq, Problem: fmt.Sprintf(format, args...)})
+}
+
+// check verifies the next event of the chain
+func (v *chainVerifier) check(e *AuditEvent) {
+	v.report.Checked++
+
+	if e.Seq != v.prevSeq+1 {
+		v.problem(e.Seq, "gap: events %d..%d are missing", v.prevSeq+1, e.Seq-1)
+	}
+
+	switch {
+	case e.Hash == nil && !v.chained:
+		v.report.Unchained++
+	case e.Hash == nil:
+		v.problem(e.Seq, "event is not chained")
+	default:
+		v.chained = true
+		if !bytes.Equal(e.PrevHash, v.prevHash) {
+			v.problem(e.Seq, "previous hash mismatch: preceding event was removed, reordered or modified")
+		}
+		if !hmac.Equal(e.Hash, auditChainHash(v.key, e)) {
+			v.problem(e.Seq, "hash mismatch: event was modified")
+		}
+	}
+
+	if want, ok := v.pending[e.Seq]; ok {
+		if !bytes.Equal(want, e.Hash) {
+			v.problem(e.Seq, "event does not match its checkpoint")
+		}
+		delete(v.pending, e.Seq)
+	}
+
+	v.prevSeq = e.Seq
+	v.prevHash = e.Hash
+}
+
+// finish reports checkpoints whose events were never seen and returns the report
+func (v *chainVerifier) finish() *ChainReport {
+	v.report.Head = v.prevSeq
+	for _, c := range v.checkpoints {
+		if _, ok := v.pending[c.Seq]; !ok {
+			continue
+		}
+		if c.Seq > v.report.Head {
+			v.problem(c.Seq, "chain truncated: checkpointed event is beyond the chain head %d", v.report.Head)
+		} else {
+			v.problem(c.Seq, "checkpointed event is missing")
+		}
+	}
+	return v.report
+}
+
+// auditChainHash computes HMAC(key, prev_hash || seq || event fields), every variable length
+// field is length prefixed so shifting bytes between fields changes the hash
+func auditChainHash(key []byte, e *AuditEvent) []byte {
+	mac := hmac.New(sha256.New, key)
+	writeField := func(b []byte) {
+		var l [4]byte
+		binary.BigEndian.PutUint32(l[:], uint32(len(b)))
+		mac.Write(l[:])
+		mac.Write(b)
+	}
+	writeNull := func(ns sql.NullString) {
+		if !ns.Valid {
+			mac.Write([]byte{0})
+			return
+		}
+		mac.Write([]byte{1})
+		writeField([]byte(ns.String))
+	}
+	var n [8]byte
+
+	writeField(e.PrevHash)
+	binary.BigEndian.PutUint64(n[:], uint64(e.Seq))
+	mac.Write(n[:])
+	writeField([]byte(e.ID.String()))
+	writeNull(e.ActorId)
+	writeNull(e.OwnerId)
+	writeField([]byte(e.Action))
+	writeNull(e.EntryId)
+	writeNull(e.Peer)
+	writeField([]byte(e.Outcome))
+	binary.BigEndian.PutUint64(n[:], uint64(e.CreatedAt.UnixMicro()))
+	mac.Write(n[:])
+
+	return mac.Sum(nil)
+}
@@ -0,0 +1,150 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/AleksZelenchuk/vault-server/pkg/auth"
+	"github.com/jmoiron/sqlx"
+	"strconv"
+	"time"
+)
+
+const maxAuditQueryLimit = 1000
+
+// AuditQuery narrows down QueryEvents results, zero values mean no filtering
+type AuditQuery struct {
+	ActorId  string
+	EntryId  string
+	Action   string
+	Since    time.Time
+	Until    time.Time
+	Limit    int
+	AllUsers bool
+}
+
+type AuditStore struct{ db *sqlx.DB }
+
+func NewAuditStore(db *sqlx.DB) *AuditStore {
+	return &AuditStore{db: db}
+}
+
+// Insert appends events to the audit log, chaining each of them to the current chain head.
+// Writers are serialized with an advisory lock so the chain never forks, callers should insert in batches
+// from a single writer such as audit.Auditor so the lock is not taken per request
+func (s *AuditStore) Insert(ctx context.Context, events ...*AuditEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+	key, err := DeriveKey(auditChainPurpose)
+	if err != nil {
+		return err
+	}
+
+	tx, err := s.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if _, err := tx.ExecContext(ctx, `SELECT pg_advisory_xact_lock($1)`, auditChainLockId); err != nil {
+		return err
+	}
+	var head AuditCheckpoint
+	err = tx.GetContext(ctx, &head, `SELECT seq, hash FROM audit_events ORDER BY seq DESC LIMIT 1`)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return err
+	}
+
+	query := "INSERT INTO audit_events (id, seq, actor_id, owner_id, action, entry_id, peer, outcome, prev_hash, hash, created_at) VALUES (:id, :seq, :actor_id, :owner_id, :action, :entry_id, :peer, :outcome, :prev_hash, :hash, :created_at)"
+	for _, e := range events {
+		head = linkAuditEvent(key, head, e)
+		if _, err := tx.NamedExecContext(ctx, query, e); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// linkAuditEvent appends e to the chain ending at head and returns the new head
+func linkAuditEvent(key []byte, head AuditCheckpoint, e *AuditEvent) AuditCheckpoint {
+	// postgres keeps microseconds only, the hash must cover exactly what is stored
+	e.CreatedAt = e.CreatedAt.UTC().Truncate(time.Microsecond)
+	e.Seq = head.Seq + 1
+	e.PrevHash = head.Hash
+	e.Hash = auditChainHash(key, e)
+	return AuditCheckpoint{Seq: e.Seq, Hash: e.Hash}
+}
+
+// QueryEvents returns audit events newest first. Regular users only see events they caused or that touched
+// their vault, admins may query everything by setting AllUsers
+func (s *AuditStore) QueryEvents(ctx context.Context, q AuditQuery) ([]AuditEvent, error) {
+	userId, _ := auth.UserIDFromContext(ctx)
+	if userId == "" {
+		return nil, NoUserId
+	}
+
+	isAdmin, err := s.IsAdmin(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+	if q.AllUsers && !isAdmin {
+		return nil, PermissionDenied
+	}
+	if q.ActorId != "" && q.ActorId != userId && !isAdmin {
+		return nil, PermissionDenied
+	}
+
+	query := `SELECT * FROM audit_events WHERE 1=1`
+	var args []interface{}
+	arg := func(v interface{}) string {
+		args = append(args, v)
+		return "$" + strconv.Itoa(len(args))
+	}
+
+	// admins querying someone else's actions are not limited to their own scope
+	if !q.AllUsers && (q.ActorId == "" || q.ActorId == userId) {
+		p := arg(userId)
+		query += ` AND (actor_id=` + p + ` OR owner_id=` + p + `)`
+	}
+	if q.ActorId != "" {
+		query += ` AND actor_id=` + arg(q.ActorId)
+	}
+	if q.EntryId != "" {
+		query += ` AND entry_id=` + arg(q.EntryId)
+	}
+	if q.Action != "" {
+		query += ` AND action=` + arg(q.Action)
+	}
+	if !q.Since.IsZero() {
+		query += ` AND created_at >= ` + arg(q.Since)
+	}
+	if !q.Until.IsZero() {
+		query += ` AND created_at < ` + arg(q.Until)
+	}
+
+	limit := q.Limit
+	if limit <= 0 || limit > maxAuditQueryLimit {
+		limit = maxAuditQueryLimit
+	}
+	query += ` ORDER BY created_at DESC LIMIT ` + arg(limit)
+
+	var events []AuditEvent
+	err = s.db.SelectContext(ctx, &events, query, args...)
+	return events, err
+}
+
+// IsAdmin reports whether the user with given id has the admin flag set
+func (s *AuditStore) IsAdmin(ctx context.Context, userId string) (bool, error) {
+	return isAdmin(ctx, s.db, userId)
+}
+
+func isAdmin(ctx context.Context, db *sqlx.DB, userId string) (bool, error) {
+	var isAdmin bool
+	err := db.GetContext(ctx, &isAdmin, `SELECT is_admin FROM vault_users WHERE id=$1`, userId)
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	}
+	return isAdmin, err
+}
@@ -3,7 +3,9 @@ package storage
 import (
 	"crypto/aes"
 	"crypto/cipher"
+	"crypto/hmac"
 	"crypto/rand"
+	"crypto/sha256"
 	"encoding/base64"
 	"errors"
 	"io"
@@ -71,3 +73,75 @@ func Decrypt(ciphertext []byte) ([]byte, error) {
 	nonce, ct := ciphertext[:nonceSize], ciphertext[nonceSize:]
 	return aesgcm.Open(nil, nonce, ct, nil)
 }
+
+// EncryptPayload encrypts a typed entry payload with a key derived for its entry type, so a payload
+// cannot be decrypted as the payload of another type
+func EncryptPayload(entryType string, plain []byte) ([]byte, error) {
+	key, err := DeriveKey("entry-payload/" + entryType)
+	if err != nil {
+		return nil, err
+	}
+	aesgcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, aesgcm.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+	return aesgcm.Seal(nonce, nonce, plain, nil), nil
+}
+
+// DecryptPayload decrypts a payload encrypted by EncryptPayload for the same entry type
+func DecryptPayload(entryType string, ciphertext []byte) ([]byte, error) {
+	key, err := DeriveKey("entry-payload/" + entryType)
+	if err != nil {
+		return nil, err
+	}
+	aesgcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	nonceSize := aesgcm.NonceSize()
+	if len(ciphertext) < nonceSize {
+		return nil, errors.New("ciphertext too short")
+	}
+	nonce, ct := ciphertext[:nonceSize], ciphertext[nonceSize:]
+	return aesgcm.Open(nil, nonce, ct, nil)
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// DeriveKey returns a 32-byte key for a single purpose (audit chain, fingerprints etc.) derived from the
+// master key, so the master key itself is only ever used for entry encryption
+func DeriveKey(purpose string) ([]byte, error) {
+	if masterKey == nil {
+		err := InitCrypto()
+		if err != nil {
+			return nil, err
+		}
+	}
+	mac := hmac.New(sha256.New, masterKey)
+	mac.Write([]byte("vault-server/" + purpose))
+	return mac.Sum(nil), nil
+}
+
+// Fingerprint returns a keyed hash of a user's password, equal passwords of the same user have equal
+// fingerprints but neither the password nor reuse across users can be recovered without the master key
+func Fingerprint(userId string, password []byte) ([]byte, error) {
+	key, err := DeriveKey("password-fingerprint")
+	if err != nil {
+		return nil, err
+	}
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(userId))
+	mac.Write([]byte{0})
+	mac.Write(password)
+	return mac.Sum(nil), nil
+}
@@ -0,0 +1,82 @@
+package storage
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"github.com/google/uuid"
+	"strings"
+)
+
+var InvalidCursor = errors.New("invalid page token")
+
+// Cursor is the position after the last entry of a page: the values of the sort key of that entry and
+// its id as tie breaker. Filter is an opaque fingerprint of the listing's filters, a token is only valid for the
+// listing it was issued for
+type Cursor struct {
+	OrderBy string    `json:"o"`
+	Desc    bool      `json:"d,omitempty"`
+	Values  []string  `json:"v"`
+	ID      uuid.UUID `json:"i"`
+	Filter  string    `json:"f,omitempty"`
+}
+
+// Encode returns the cursor as an opaque page token authenticated for userId, so clients can neither
+// forge positions nor use another user's tokens
+func (c *Cursor) Encode(userId string) (string, error) {
+	payload, err := json.Marshal(c)
+	if err != nil {
+		return "", err
+	}
+	mac, err := cursorMAC(userId, payload)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(payload) + "." + base64.RawURLEncoding.EncodeToString(mac), nil
+}
+
+// DecodeCursor verifies and decodes a page token issued by Encode for the same user
+func DecodeCursor(token string, userId string) (*Cursor, error) {
+	p, m, ok := strings.Cut(token, ".")
+	if !ok {
+		return nil, InvalidCursor
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(p)
+	if err != nil {
+		return nil, InvalidCursor
+	}
+	mac, err := base64.RawURLEncoding.DecodeString(m)
+	if err != nil {
+		return nil, InvalidCursor
+	}
+	expected, err := cursorMAC(userId, payload)
+	if err != nil {
+		return nil, err
+	}
+	if !hmac.Equal(mac, expected) {
+		return nil, InvalidCursor
+	}
+
+	var c Cursor
+	if err := json.Unmarshal(payload, &c); err != nil {
+		return nil, InvalidCursor
+	}
+	if _, ok := listOrderColumns[c.OrderBy]; !ok {
+		return nil, InvalidCursor
+	}
+	return &c, nil
+}
+
+func cursorMAC(userId string, payload []byte) ([]byte, error) {
+	key, err := DeriveKey("list-cursor")
+	if err != nil {
+		return nil, err
+	}
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(userId))
+	mac.Write([]byte{0})
+	mac.Write(payload)
+	return mac.Sum(nil), nil
+}
@@ -0,0 +1,171 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/AleksZelenchuk/vault-server/pkg/auth"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+const (
+	EmergencyAccessView     = "view"
+	EmergencyAccessTakeover = "takeover"
+
+	EmergencyStatusIdle      = "idle"
+	EmergencyStatusRequested = "requested"
+	EmergencyStatusApproved  = "approved"
+	EmergencyStatusRejected  = "rejected"
+)
+
+var NoEmergencyGrant = errors.New("no active emergency access grant")
+var SelfEmergencyContact = errors.New("you cannot be your own emergency contact")
+
+type EmergencyStore struct{ db *sqlx.DB }
+
+func NewEmergencyStore(db *sqlx.DB) *EmergencyStore {
+	return &EmergencyStore{db: db}
+}
+
+// AddContact registers the user with granteeUsername as an emergency contact of the active user.
+// Adding an existing contact again resets its access level, wait period and any pending request
+func (s *EmergencyStore) AddContact(ctx context.Context, granteeUsername string, accessLevel string, waitPeriodSeconds int64) (*EmergencyContact, error) {
+	userId, _ := auth.UserIDFromContext(ctx)
+	if userId == "" {
+		return nil, NoUserId
+	}
+
+	var granteeId string
+	err := s.db.GetContext(ctx, &granteeId, `SELECT id FROM vault_users WHERE username=$1`, granteeUsername)
+	if err != nil {
+		return nil, err
+	}
+	if granteeId == userId {
+		return nil, SelfEmergencyContact
+	}
+
+	var c EmergencyContact
+	err = s.db.GetContext(ctx, &c, `
+		INSERT INTO vault_emergency_contacts (id, grantor_id, grantee_id, access_level, wait_period_seconds)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (grantor_id, grantee_id) DO UPDATE
+		SET access_level=EXCLUDED.access_level, wait_period_seconds=EXCLUDED.wait_period_seconds,
+		    status='idle', requested_at=NULL, updated_at=NOW()
+		RETURNING *`,
+		uuid.New(), userId, granteeId, accessLevel, waitPeriodSeconds)
+	if err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
+
+// ListGrantedBy returns contacts the active user has trusted with emergency access
+func (s *EmergencyStore) ListGrantedBy(ctx context.Context) ([]EmergencyContact, error) {
+	userId, _ := auth.UserIDFromContext(ctx)
+	if userId == "" {
+		return nil, NoUserId
+	}
+
+	var contacts []EmergencyContact
+	err := s.db.SelectContext(ctx, &contacts, `SELECT * FROM vault_emergency_contacts WHERE grantor_id=$1 ORDER BY created_at`, userId)
+	return contacts, err
+}
+
+// ListGrantedTo returns grants where the active user is the emergency contact
+func (s *EmergencyStore) ListGrantedTo(ctx context.Context) ([]EmergencyContact, error) {
+	userId, _ := auth.UserIDFromContext(ctx)
+	if userId == "" {
+		return nil, NoUserId
+	}
+
+	var contacts []EmergencyContact
+	err := s.db.SelectContext(ctx, &contacts, `SELECT * FROM vault_emergency_contacts WHERE grantee_id=$1 ORDER BY created_at`, userId)
+	return contacts, err
+}
+
+// RemoveContact deletes the grant, either side of it is allowed to do so
+func (s *EmergencyStore) RemoveContact(ctx context.Context, id uuid.UUID) (bool, error) {
+	userId, _ := auth.UserIDFromContext(ctx)
+	if userId == "" {
+		return false, NoUserId
+	}
+
+	res, err := s.db.ExecContext(ctx, `DELETE FROM vault_emergency_contacts WHERE id=$1 AND (grantor_id=$2 OR grantee_id=$2)`, id, userId)
+	if err != nil {
+		return false, err
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	if ra == int64(0) {
+		return false, sql.ErrNoRows
+	}
+	return true, nil
+}
+
+// RequestAccess starts the wait period for the grant with given id, only the grantee can do this.
+// Repeated requests while one is pending or approved leave the grant untouched
+func (s *EmergencyStore) RequestAccess(ctx context.Context, id uuid.UUID) (*EmergencyContact, error) {
+	userId, _ := auth.UserIDFromContext(ctx)
+	if userId == "" {
+		return nil, NoUserId
+	}
+
+	var c EmergencyContact
+	err := s.db.GetContext(ctx, &c, `
+		UPDATE vault_emergency_contacts SET status='requested', requested_at=NOW(), updated_at=NOW()
+		WHERE id=$1 AND grantee_id=$2 AND status IN ('idle', 'rejected')
+		RETURNING *`, id, userId)
+	if errors.Is(err, sql.ErrNoRows) {
+		err = s.db.GetContext(ctx, &c, `SELECT * FROM vault_emergency_contacts WHERE id=$1 AND grantee_id=$2`, id, userId)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
+
+// Decide lets the grantor approve or reject a pending request before the wait period runs out
+func (s *EmergencyStore) Decide(ctx context.Context, id uuid.UUID, approve bool) (*EmergencyContact, error) {
+	userId, _ := auth.UserIDFromContext(ctx)
+	if userId == "" {
+		return nil, NoUserId
+	}
+
+	query := `UPDATE vault_emergency_contacts SET status='rejected', requested_at=NULL, updated_at=NOW()`
+	if approve {
+		query = `UPDATE vault_emergency_contacts SET status='approved', updated_at=NOW()`
+	}
+	query += ` WHERE id=$1 AND grantor_id=$2 AND status='requested' RETURNING *`
+
+	var c EmergencyContact
+	if err := s.db.GetContext(ctx, &c, query, id, userId); err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
+
+// ActiveGrant returns the grant given by grantorId to the active user if it is usable right now:
+// either approved by the grantor or requested and not rejected within the wait period
+func (s *EmergencyStore) ActiveGrant(ctx context.Context, grantorId string) (*EmergencyContact, error) {
+	userId, _ := auth.UserIDFromContext(ctx)
+	if userId == "" {
+		return nil, NoUserId
+	}
+
+	var c EmergencyContact
+	err := s.db.GetContext(ctx, &c, `
+		SELECT * FROM vault_emergency_contacts
+		WHERE grantor_id=$1 AND grantee_id=$2
+		  AND (status='approved' OR (status='requested' AND requested_at + wait_period_seconds * INTERVAL '1 second' <= NOW()))`,
+		grantorId, userId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, NoEmergencyGrant
+		}
+		return nil, err
+	}
+	return &c, nil
+}
@@ -0,0 +1,161 @@
+package storage
+
+import (
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"strings"
+	"time"
+)
+
+// Filter is a condition on the entries of a listing. Filters compose with And and Or and add their
+// arguments to the query they are built into, so placeholders are always numbered correctly
+type Filter interface {
+	build(q *query) string
+}
+
+// query collects the positional arguments of a statement while its conditions are built
+type query struct {
+	args []interface{}
+}
+
+// arg adds v to the arguments and returns its placeholder
+func (q *query) arg(v interface{}) string {
+	q.args = append(q.args, v)
+	return fmt.Sprintf("$%d", len(q.args))
+}
+
+// where returns f as a condition, TRUE when f is nil
+func (q *query) where(f Filter) string {
+	if f == nil {
+		return "TRUE"
+	}
+	return f.build(q)
+}
+
+type filterFunc func(q *query) string
+
+func (f filterFunc) build(q *query) string { return f(q) }
+
+type junction struct {
+	op      string
+	filters []Filter
+}
+
+func (j junction) build(q *query) string {
+	var parts []string
+	for _, f := range j.filters {
+		if f != nil {
+			parts = append(parts, f.build(q))
+		}
+	}
+	switch len(parts) {
+	case 0:
+		// an empty AND matches everything, an empty OR nothing
+		if j.op == " AND " {
+			return "TRUE"
+		}
+		return "FALSE"
+	case 1:
+		return parts[0]
+	}
+	return "(" + strings.Join(parts, j.op) + ")"
+}
+
+// And matches entries matching all filters, nil filters are skipped
+func And(filters ...Filter) Filter {
+	return junction{op: " AND ", filters: filters}
+}
+
+// Or matches entries matching any of the filters, nil filters are skipped
+func Or(filters ...Filter) Filter {
+	return junction{op: " OR ", filters: filters}
+}
+
+// InDomain matches entries with a URI on a host of the registrable domain or its subdomains, as returned
+// by urimatch.Domain. Regular expression and never matching URIs have no host and are not considered
+func InDomain(domain string) Filter {
+	return filterFunc(func(q *query) string {
+		return fmt.Sprintf(`EXISTS (SELECT 1 FROM jsonb_array_elements(e.uris) u WHERE u->>'host' = %s OR u->>'host' LIKE %s)`,
+			q.arg(domain), q.arg("%."+escapeLike(domain)))
+	})
+}
+
+// InFolder matches entries filed directly in the folder, or with recursive also in any of its subfolders
+func InFolder(id uuid.UUID, recursive bool) Filter {
+	return filterFunc(func(q *query) string {
+		if !recursive {
+			return "e.folder_id = " + q.arg(id)
+		}
+		return fmt.Sprintf(`e.folder_id IN (WITH RECURSIVE tree AS (
+			SELECT id FROM vault_folders WHERE id=%s
+			UNION ALL
+			SELECT f.id FROM vault_folders f JOIN tree t ON f.parent_id=t.id
+		) SELECT id FROM tree)`, q.arg(id))
+	})
+}
+
+// FolderIs matches entries in the folder at exactly this path
+func FolderIs(path string) Filter {
+	return filterFunc(func(q *query) string {
+		return "vault_folder_path(e.folder_id) = " + q.arg(path)
+	})
+}
+
+// FolderPrefix matches entries in the folder at this path or any of its subfolders
+func FolderPrefix(path string) Filter {
+	path = strings.TrimSuffix(path, FolderSeparator)
+	return filterFunc(func(q *query) string {
+		return fmt.Sprintf("(vault_folder_path(e.folder_id) = %s OR vault_folder_path(e.folder_id) LIKE %s)",
+			q.arg(path), q.arg(escapeLike(path+FolderSeparator)+"%"))
+	})
+}
+
+// HasAllTags matches entries carrying every tag of the set
+func HasAllTags(tags ...string) Filter {
+	return filterFunc(func(q *query) string {
+		return "e.tags @> " + q.arg(pq.Array(tags))
+	})
+}
+
+// HasAnyTag matches entries carrying at least one tag of the set
+func HasAnyTag(tags ...string) Filter {
+	return filterFunc(func(q *query) string {
+		return "e.tags && " + q.arg(pq.Array(tags))
+	})
+}
+
+// TypeIn matches entries of any of the types
+func TypeIn(types ...string) Filter {
+	return filterFunc(func(q *query) string {
+		return "e.type = ANY(" + q.arg(pq.Array(types)) + ")"
+	})
+}
+
+// HasCustomField matches entries with a custom field of any of the names
+func HasCustomField(names ...string) Filter {
+	return filterFunc(func(q *query) string {
+		return "EXISTS (SELECT 1 FROM jsonb_array_elements(e.custom_fields) f WHERE f->>'name' = ANY(" + q.arg(pq.Array(names)) + "))"
+	})
+}
+
+// CreatedBetween matches entries created in [from, to), a zero bound is open
+func CreatedBetween(from time.Time, to time.Time) Filter {
+	return timeRange("e.created_at", from, to)
+}
+
+// UpdatedBetween matches entries last updated in [from, to), a zero bound is open
+func UpdatedBetween(from time.Time, to time.Time) Filter {
+	return timeRange("e.updated_at", from, to)
+}
+
+func timeRange(column string, from time.Time, to time.Time) Filter {
+	var bounds []Filter
+	if !from.IsZero() {
+		bounds = append(bounds, filterFunc(func(q *query) string { return column + " >= " + q.arg(from) }))
+	}
+	if !to.IsZero() {
+		bounds = append(bounds, filterFunc(func(q *query) string { return column + " < " + q.arg(to) }))
+	}
+	return And(bounds...)
+}
@@ -0,0 +1,257 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/AleksZelenchuk/vault-server/pkg/auth"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"strings"
+)
+
+var FolderNotFound = errors.New("folder not found")
+var FolderExists = errors.New("a folder with this name already exists")
+var FolderNotEmpty = errors.New("folder is not empty")
+var FolderCycle = errors.New("a folder cannot be moved into itself or its subfolders")
+
+// FolderSeparator separates the folder names of a path
+const FolderSeparator = "/"
+
+// folderColumns selects a folder of vault_folders f with its path and number of entries
+const folderColumns = `f.*, vault_folder_path(f.id) AS path,
+	(SELECT COUNT(*) FROM vault_entries e WHERE e.folder_id=f.id) AS entry_count`
+
+// folderTree selects the ids of the folder $1 and all of its subfolders
+const folderTree = `WITH RECURSIVE tree AS (
+		SELECT id FROM vault_folders WHERE id=$1
+		UNION ALL
+		SELECT f.id FROM vault_folders f JOIN tree t ON f.parent_id=t.id
+	)`
+
+// CreateFolder creates a folder of the active user under f.ParentId, or at the top level when it is not
+// set, and fills in the stored fields
+func (s *Store) CreateFolder(ctx context.Context, f *Folder) error {
+	userId, _ := auth.UserIDFromContext(ctx)
+	if userId == "" {
+		return NoUserId
+	}
+
+	// INSERT ... SELECT does not infer parameter types from the target columns, hence the casts
+	var id uuid.UUID
+	err := s.db.GetContext(ctx, &id, `
+		INSERT INTO vault_folders (id, user_id, parent_id, name)
+		SELECT CAST($1 AS UUID), CAST($2 AS UUID), CAST($3 AS UUID), CAST($4 AS TEXT)
+		WHERE CAST($3 AS UUID) IS NULL OR EXISTS (SELECT 1 FROM vault_folders WHERE id=CAST($3 AS UUID) AND user_id=CAST($2 AS UUID))
+		RETURNING id`, f.ID, userId, f.ParentId, f.Name)
+	if err != nil {
+		return folderError(err)
+	}
+
+	created, err := s.GetFolder(ctx, id)
+	if err != nil {
+		return err
+	}
+	*f = *created
+	return nil
+}
+
+// GetFolder returns a folder of the active user
+func (s *Store) GetFolder(ctx context.Context, id uuid.UUID) (*Folder, error) {
+	userId, _ := auth.UserIDFromContext(ctx)
+	if userId == "" {
+		return nil, NoUserId
+	}
+
+	var f Folder
+	err := s.db.GetContext(ctx, &f, `SELECT `+folderColumns+` FROM vault_folders f WHERE f.id=$1 AND f.user_id=$2`, id, userId)
+	if err != nil {
+		return nil, folderError(err)
+	}
+	return &f, nil
+}
+
+// ListFolders returns all folders of the active user ordered by path, so parents precede their subfolders
+func (s *Store) ListFolders(ctx context.Context) ([]Folder, error) {
+	userId, _ := auth.UserIDFromContext(ctx)
+	if userId == "" {
+		return nil, NoUserId
+	}
+
+	var folders []Folder
+	err := s.db.SelectContext(ctx, &folders, `SELECT `+folderColumns+` FROM vault_folders f WHERE f.user_id=$1 ORDER BY path`, userId)
+	return folders, err
+}
+
+// RenameFolder renames a folder of the active user, the paths of its entries and subfolders follow
+func (s *Store) RenameFolder(ctx context.Context, id uuid.UUID, name string) (*Folder, error) {
+	userId, _ := auth.UserIDFromContext(ctx)
+	if userId == "" {
+		return nil, NoUserId
+	}
+
+	res, err := s.db.ExecContext(ctx, `UPDATE vault_folders SET name=$3, updated_at=NOW() WHERE id=$1 AND user_id=$2`, id, userId, name)
+	if err != nil {
+		return nil, folderError(err)
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return nil, err
+	} else if n == 0 {
+		return nil, FolderNotFound
+	}
+	return s.GetFolder(ctx, id)
+}
+
+// MoveFolder moves a folder of the active user with its entries and subfolders under parent, or to the
+// top level when parent is not set. Moves of a user are serialized, so concurrent moves cannot form a cycle
+func (s *Store) MoveFolder(ctx context.Context, id uuid.UUID, parent uuid.NullUUID) (*Folder, error) {
+	userId, _ := auth.UserIDFromContext(ctx)
+	if userId == "" {
+		return nil, NoUserId
+	}
+
+	tx, err := s.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if _, err := tx.ExecContext(ctx, `SELECT pg_advisory_xact_lock(hashtext('vault_folders/' || $1))`, userId); err != nil {
+		return nil, err
+	}
+	var owned bool
+	if err := tx.GetContext(ctx, &owned, `SELECT EXISTS (SELECT 1 FROM vault_folders WHERE id=$1 AND user_id=$2)`, id, userId); err != nil {
+		return nil, err
+	}
+	if !owned {
+		return nil, FolderNotFound
+	}
+	if parent.Valid {
+		if err := tx.GetContext(ctx, &owned, `SELECT EXISTS (SELECT 1 FROM vault_folders WHERE id=$1 AND user_id=$2)`, parent.UUID, userId); err != nil {
+			return nil, err
+		}
+		if !owned {
+			return nil, FolderNotFound
+		}
+		var cycle bool
+		if err := tx.GetContext(ctx, &cycle, folderTree+` SELECT EXISTS (SELECT 1 FROM tree WHERE id=$2)`, id, parent.UUID); err != nil {
+			return nil, err
+		}
+		if cycle {
+			return nil, FolderCycle
+		}
+	}
+
+	if _, err := tx.ExecContext(ctx, `UPDATE vault_folders SET parent_id=$2, updated_at=NOW() WHERE id=$1`, id, parent); err != nil {
+		return nil, folderError(err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return s.GetFolder(ctx, id)
+}
+
+// DeleteFolder deletes an empty folder of the active user. With recursive its subfolders are deleted as
+// well and the entries of all of them move to the folder's parent, entries are never deleted
+func (s *Store) DeleteFolder(ctx context.Context, id uuid.UUID, recursive bool) error {
+	userId, _ := auth.UserIDFromContext(ctx)
+	if userId == "" {
+		return NoUserId
+	}
+
+	tx, err := s.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	var parent uuid.NullUUID
+	err = tx.GetContext(ctx, &parent, `SELECT parent_id FROM vault_folders WHERE id=$1 AND user_id=$2 FOR UPDATE`, id, userId)
+	if err != nil {
+		return folderError(err)
+	}
+	if !recursive {
+		var used bool
+		err := tx.GetContext(ctx, &used, `SELECT EXISTS (SELECT 1 FROM vault_folders WHERE parent_id=$1)
+			OR EXISTS (SELECT 1 FROM vault_entries WHERE folder_id=$1)`, id)
+		if err != nil {
+			return err
+		}
+		if used {
+			return FolderNotEmpty
+		}
+	}
+
+	_, err = tx.ExecContext(ctx, folderTree+` UPDATE vault_entries SET folder_id=$2 WHERE folder_id IN (SELECT id FROM tree)`, id, parent)
+	if err != nil {
+		return err
+	}
+	// subfolders are deleted by the cascade on parent_id
+	if _, err := tx.ExecContext(ctx, `DELETE FROM vault_folders WHERE id=$1`, id); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// ResolveFolderPath returns the folder of the active user at path, names separated by FolderSeparator.
+// Blank names are skipped. With create missing folders along the path are created, otherwise they are
+// FolderNotFound
+func (s *Store) ResolveFolderPath(ctx context.Context, path string, create bool) (uuid.UUID, error) {
+	userId, _ := auth.UserIDFromContext(ctx)
+	if userId == "" {
+		return uuid.Nil, NoUserId
+	}
+
+	var parent uuid.NullUUID
+	for _, name := range strings.Split(path, FolderSeparator) {
+		if strings.TrimSpace(name) == "" {
+			continue
+		}
+		if create {
+			_, err := s.db.ExecContext(ctx,
+				`INSERT INTO vault_folders (user_id, parent_id, name) VALUES ($1, $2, $3) ON CONFLICT DO NOTHING`, userId, parent, name)
+			if err != nil {
+				return uuid.Nil, err
+			}
+		}
+		var id uuid.UUID
+		err := s.db.GetContext(ctx, &id,
+			`SELECT id FROM vault_folders WHERE user_id=$1 AND parent_id IS NOT DISTINCT FROM $2 AND name=$3`, userId, parent, name)
+		if err != nil {
+			return uuid.Nil, folderError(err)
+		}
+		parent = uuid.NullUUID{UUID: id, Valid: true}
+	}
+	if !parent.Valid {
+		return uuid.Nil, FolderNotFound
+	}
+	return parent.UUID, nil
+}
+
+// validateFolder checks that an entry's folder belongs to the entry's owner
+func (s *Store) validateFolder(ctx context.Context, userId string, id uuid.NullUUID) error {
+	if !id.Valid {
+		return nil
+	}
+	var owned bool
+	err := s.db.GetContext(ctx, &owned, `SELECT EXISTS (SELECT 1 FROM vault_folders WHERE id=$1 AND user_id=$2)`, id.UUID, userId)
+	if err != nil {
+		return err
+	}
+	if !owned {
+		return FolderNotFound
+	}
+	return nil
+}
+
+// folderError maps missing rows and sibling name conflicts to the folder errors
+func folderError(err error) error {
+	var pqErr *pq.Error
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return FolderNotFound
+	case errors.As(err, &pqErr) && pqErr.Code == "23505":
+		return FolderExists
+	}
+	return err
+}
@@ -2,30 +2,236 @@ package storage
 
 import (
 	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
 	"github.com/google/uuid"
 	"github.com/lib/pq"
 	"time"
 )
 
+// Entry types as stored in vault_entries.type
+const (
+	EntryTypeLogin      = "login"
+	EntryTypeSecureNote = "secure_note"
+	EntryTypeCard       = "card"
+	EntryTypeIdentity   = "identity"
+	EntryTypeSshKey     = "ssh_key"
+	EntryTypeApiKey     = "api_key"
+)
+
 type Entry struct {
-	ID        uuid.UUID      `db:"id"`
-	UserId    string         `db:"user_id"`
-	Title     string         `db:"title"`
-	Username  string         `db:"username"`
-	Password  []byte         `db:"password"`
-	Notes     sql.NullString `db:"notes"`
-	Tags      pq.StringArray `db:"tags"`
-	Folder    sql.NullString `db:"folder"`
-	Domain    sql.NullString `db:"domain"`
-	CreatedAt time.Time      `db:"created_at"`
-	UpdatedAt time.Time      `db:"updated_at"`
+	ID       uuid.UUID      `db:"id"`
+	UserId   string         `db:"user_id"`
+	Title    string         `db:"title"`
+	Username string         `db:"username"`
+	Password []byte         `db:"password"`
+	Notes    sql.NullString `db:"notes"`
+	Tags     pq.StringArray `db:"tags"`
+	// Folder is the path of the entry's folder, it is only set by queries that resolve FolderId
+	Folder   sql.NullString `db:"folder"`
+	FolderId uuid.NullUUID  `db:"folder_id"`
+	Domain   sql.NullString `db:"domain"`
+	// ClientEncrypted entries hold client side ciphertext in Password and Notes, the server never decrypts them
+	ClientEncrypted bool `db:"client_encrypted"`
+	// PasswordFingerprint is a keyed hash of the password used to find reuse, nil for client encrypted entries
+	PasswordFingerprint []byte    `db:"password_fingerprint"`
+	PasswordChangedAt   time.Time `db:"password_changed_at"`
+	// RotationIntervalDays overrides the rotation interval of the entry's folder
+	RotationIntervalDays sql.NullInt32 `db:"rotation_interval_days"`
+	RotationNotifiedAt   sql.NullTime  `db:"rotation_notified_at"`
+	// Totp is the encrypted otpauth URI of the entry, nil when it has none
+	Totp []byte `db:"totp"`
+	Type string `db:"type"`
+	// Payload is the serialized payload of typed entries, encrypted with a key of its type
+	Payload      []byte       `db:"payload"`
+	CustomFields CustomFields `db:"custom_fields"`
+	URIs         EntryURIs    `db:"uris"`
+	Favorite     bool         `db:"favorite"`
+	// LastUsedAt is the last time the owner read or revealed the entry
+	LastUsedAt sql.NullTime `db:"last_used_at"`
+	CreatedAt  time.Time    `db:"created_at"`
+	UpdatedAt  time.Time    `db:"updated_at"`
+	// Reused is only set by List, it is true when another entry of the user has the same password
+	Reused bool `db:"reused"`
+	// Rank is only set by Search, higher ranks match the query better
+	Rank float64 `db:"rank"`
+	// RotationDueAt is only set by the rotation queries
+	RotationDueAt sql.NullTime `db:"rotation_due_at"`
+}
+
+// Custom field types as stored in vault_entries.custom_fields
+const (
+	CustomFieldText    = "text"
+	CustomFieldHidden  = "hidden"
+	CustomFieldBoolean = "boolean"
+	CustomFieldLinked  = "linked"
+)
+
+// CustomField is a user defined field of an entry, hidden values are encrypted like passwords
+type CustomField struct {
+	Name  string `json:"name"`
+	Type  string `json:"type"`
+	Value []byte `json:"value"`
+}
+
+// CustomFields are stored as a JSONB array
+type CustomFields []CustomField
+
+func (f CustomFields) Value() (driver.Value, error) {
+	if f == nil {
+		return []byte("[]"), nil
+	}
+	return json.Marshal(f)
+}
+
+func (f *CustomFields) Scan(src any) error {
+	switch v := src.(type) {
+	case nil:
+		*f = nil
+		return nil
+	case []byte:
+		return json.Unmarshal(v, f)
+	case string:
+		return json.Unmarshal([]byte(v), f)
+	}
+	return fmt.Errorf("cannot scan %T into CustomFields", src)
+}
+
+// EntryURI is a URI of an entry with the strategy URLs are matched against it, see pkg/urimatch.
+// Host is the host URLs matching the URI must be in, empty for strategies not bound to a host
+type EntryURI struct {
+	URI   string `json:"uri"`
+	Match string `json:"match"`
+	Host  string `json:"host,omitempty"`
+}
+
+// EntryURIs are stored as a JSONB array
+type EntryURIs []EntryURI
+
+func (u EntryURIs) Value() (driver.Value, error) {
+	if u == nil {
+		return []byte("[]"), nil
+	}
+	return json.Marshal(u)
+}
+
+func (u *EntryURIs) Scan(src any) error {
+	switch v := src.(type) {
+	case nil:
+		*u = nil
+		return nil
+	case []byte:
+		return json.Unmarshal(v, u)
+	case string:
+		return json.Unmarshal([]byte(v), u)
+	}
+	return fmt.Errorf("cannot scan %T into EntryURIs", src)
 }
 
 type User struct {
-	ID        uuid.UUID `db:"id"`
-	Email     string    `db:"email"`
-	Username  string    `db:"username"`
-	Password  []byte    `db:"password"`
+	ID       uuid.UUID `db:"id"`
+	Email    string    `db:"email"`
+	Username string    `db:"username"`
+	Password []byte    `db:"password"`
+	// PasswordEncrypted marks legacy rows whose bcrypt hash is encrypted with the master key
+	PasswordEncrypted bool `db:"password_encrypted"`
+	IsAdmin           bool `db:"is_admin"`
+	// SrpSalt and SrpVerifier are set for users registered for SRP login, Password is empty for them
+	SrpSalt     []byte `db:"srp_salt"`
+	SrpVerifier []byte `db:"srp_verifier"`
+	KdfParams
 	CreatedAt time.Time `db:"created_at"`
 	UpdatedAt time.Time `db:"updated_at"`
 }
+
+// KdfParams are the Argon2id parameters zero-knowledge clients use to derive their vault key
+type KdfParams struct {
+	Salt      []byte        `db:"kdf_salt"`
+	Time      sql.NullInt32 `db:"kdf_time"`
+	MemoryKiB sql.NullInt32 `db:"kdf_memory"`
+	Threads   sql.NullInt32 `db:"kdf_threads"`
+}
+
+type EmergencyContact struct {
+	ID                uuid.UUID    `db:"id"`
+	GrantorId         string       `db:"grantor_id"`
+	GranteeId         string       `db:"grantee_id"`
+	AccessLevel       string       `db:"access_level"`
+	WaitPeriodSeconds int64        `db:"wait_period_seconds"`
+	Status            string       `db:"status"`
+	RequestedAt       sql.NullTime `db:"requested_at"`
+	CreatedAt         time.Time    `db:"created_at"`
+	UpdatedAt         time.Time    `db:"updated_at"`
+}
+
+type AuditEvent struct {
+	ID        uuid.UUID      `db:"id"`
+	Seq       int64          `db:"seq"`
+	ActorId   sql.NullString `db:"actor_id"`
+	OwnerId   sql.NullString `db:"owner_id"`
+	Action    string         `db:"action"`
+	EntryId   sql.NullString `db:"entry_id"`
+	Peer      sql.NullString `db:"peer"`
+	Outcome   string         `db:"outcome"`
+	PrevHash  []byte         `db:"prev_hash"`
+	Hash      []byte         `db:"hash"`
+	CreatedAt time.Time      `db:"created_at"`
+}
+
+type AuditCheckpoint struct {
+	Seq       int64     `db:"seq"`
+	Hash      []byte    `db:"hash"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+// PasswordPolicy is a user's policy, or the organization wide one when UserId is empty
+type PasswordPolicy struct {
+	ID               uuid.UUID      `db:"id"`
+	UserId           sql.NullString `db:"user_id"`
+	MinLength        int            `db:"min_length"`
+	RequireLowercase bool           `db:"require_lowercase"`
+	RequireUppercase bool           `db:"require_uppercase"`
+	RequireDigits    bool           `db:"require_digits"`
+	RequireSymbols   bool           `db:"require_symbols"`
+	MinWords         int            `db:"min_words"`
+	CreatedAt        time.Time      `db:"created_at"`
+	UpdatedAt        time.Time      `db:"updated_at"`
+}
+
+// Folder is a node of a user's folder tree, top level folders have no parent
+type Folder struct {
+	ID       uuid.UUID     `db:"id"`
+	UserId   string        `db:"user_id"`
+	ParentId uuid.NullUUID `db:"parent_id"`
+	Name     string        `db:"name"`
+	// RotationIntervalDays applies to the entries of the folder and its subfolders without an interval of their own
+	RotationIntervalDays sql.NullInt32 `db:"rotation_interval_days"`
+	CreatedAt            time.Time     `db:"created_at"`
+	UpdatedAt            time.Time     `db:"updated_at"`
+	// Path and EntryCount are only set by queries on folders
+	Path       string `db:"path"`
+	EntryCount int    `db:"entry_count"`
+}
+
+// Tag is a tag of a user's entries with the number of entries carrying it
+type Tag struct {
+	Name  string `db:"name"`
+	Count int    `db:"count"`
+}
+
+// Attachment is the metadata of a file attached to an entry, its content lives in a blob store
+type Attachment struct {
+	ID          uuid.UUID `db:"id"`
+	EntryId     uuid.UUID `db:"entry_id"`
+	UserId      string    `db:"user_id"`
+	FileName    string    `db:"file_name"`
+	ContentType string    `db:"content_type"`
+	// Size is the plaintext size in bytes, quotas are computed from it
+	Size    int64  `db:"size"`
+	Backend string `db:"backend"`
+	BlobRef string `db:"blob_ref"`
+	// EncryptionKey is the attachment's content key encrypted with the master key
+	EncryptionKey []byte    `db:"encryption_key"`
+	CreatedAt     time.Time `db:"created_at"`
+}
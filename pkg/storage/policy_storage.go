@@ -0,0 +1,124 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/AleksZelenchuk/vault-server/pkg/auth"
+	"github.com/jmoiron/sqlx"
+)
+
+type PolicyStore struct{ db *sqlx.DB }
+
+func NewPolicyStore(db *sqlx.DB) *PolicyStore {
+	return &PolicyStore{db: db}
+}
+
+// Effective returns the policy that applies to the active user: the organization policy tightened by
+// the user's own one, a user cannot relax the organization policy. It returns nil without error when
+// neither exists
+func (s *PolicyStore) Effective(ctx context.Context) (*PasswordPolicy, error) {
+	userId, _ := auth.UserIDFromContext(ctx)
+	if userId == "" {
+		return nil, NoUserId
+	}
+
+	var policies []PasswordPolicy
+	err := s.db.SelectContext(ctx, &policies,
+		`SELECT * FROM vault_password_policies WHERE user_id=$1 OR user_id IS NULL`, userId)
+	if err != nil {
+		return nil, err
+	}
+	if len(policies) == 0 {
+		return nil, nil
+	}
+
+	effective := policies[0]
+	for _, p := range policies[1:] {
+		effective.MinLength = max(effective.MinLength, p.MinLength)
+		effective.MinWords = max(effective.MinWords, p.MinWords)
+		effective.RequireLowercase = effective.RequireLowercase || p.RequireLowercase
+		effective.RequireUppercase = effective.RequireUppercase || p.RequireUppercase
+		effective.RequireDigits = effective.RequireDigits || p.RequireDigits
+		effective.RequireSymbols = effective.RequireSymbols || p.RequireSymbols
+	}
+	return &effective, nil
+}
+
+// Get returns the active user's own policy, or the organization policy when organization is set
+func (s *PolicyStore) Get(ctx context.Context, organization bool) (*PasswordPolicy, error) {
+	userId, _ := auth.UserIDFromContext(ctx)
+	if userId == "" {
+		return nil, NoUserId
+	}
+
+	var policy PasswordPolicy
+	var err error
+	if organization {
+		err = s.db.GetContext(ctx, &policy, `SELECT * FROM vault_password_policies WHERE user_id IS NULL`)
+	} else {
+		err = s.db.GetContext(ctx, &policy, `SELECT * FROM vault_password_policies WHERE user_id=$1`, userId)
+	}
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &policy, nil
+}
+
+// SetUserPolicy creates or replaces the active user's own policy
+func (s *PolicyStore) SetUserPolicy(ctx context.Context, p *PasswordPolicy) (*PasswordPolicy, error) {
+	userId, _ := auth.UserIDFromContext(ctx)
+	if userId == "" {
+		return nil, NoUserId
+	}
+
+	var policy PasswordPolicy
+	err := s.db.GetContext(ctx, &policy, `
+		INSERT INTO vault_password_policies
+			(user_id, min_length, require_lowercase, require_uppercase, require_digits, require_symbols, min_words)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (user_id) DO UPDATE SET
+			min_length=EXCLUDED.min_length, require_lowercase=EXCLUDED.require_lowercase,
+			require_uppercase=EXCLUDED.require_uppercase, require_digits=EXCLUDED.require_digits,
+			require_symbols=EXCLUDED.require_symbols, min_words=EXCLUDED.min_words, updated_at=NOW()
+		RETURNING *`,
+		userId, p.MinLength, p.RequireLowercase, p.RequireUppercase, p.RequireDigits, p.RequireSymbols, p.MinWords)
+	if err != nil {
+		return nil, err
+	}
+	return &policy, nil
+}
+
+// SetOrganizationPolicy creates or replaces the organization wide policy, only admins may change it
+func (s *PolicyStore) SetOrganizationPolicy(ctx context.Context, p *PasswordPolicy) (*PasswordPolicy, error) {
+	userId, _ := auth.UserIDFromContext(ctx)
+	if userId == "" {
+		return nil, NoUserId
+	}
+	admin, err := isAdmin(ctx, s.db, userId)
+	if err != nil {
+		return nil, err
+	}
+	if !admin {
+		return nil, PermissionDenied
+	}
+
+	var policy PasswordPolicy
+	err = s.db.GetContext(ctx, &policy, `
+		INSERT INTO vault_password_policies
+			(user_id, min_length, require_lowercase, require_uppercase, require_digits, require_symbols, min_words)
+		VALUES (NULL, $1, $2, $3, $4, $5, $6)
+		ON CONFLICT ((user_id IS NULL)) WHERE user_id IS NULL DO UPDATE SET
+			min_length=EXCLUDED.min_length, require_lowercase=EXCLUDED.require_lowercase,
+			require_uppercase=EXCLUDED.require_uppercase, require_digits=EXCLUDED.require_digits,
+			require_symbols=EXCLUDED.require_symbols, min_words=EXCLUDED.min_words, updated_at=NOW()
+		RETURNING *`,
+		p.MinLength, p.RequireLowercase, p.RequireUppercase, p.RequireDigits, p.RequireSymbols, p.MinWords)
+	if err != nil {
+		return nil, err
+	}
+	return &policy, nil
+}
@@ -0,0 +1,102 @@
+package storage
+
+import (
+	"context"
+	"github.com/AleksZelenchuk/vault-server/pkg/auth"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"time"
+)
+
+// rotationDue selects entries with a rotation interval together with the time their password is due.
+// An entry's own interval wins over its folder's, folders without an interval inherit the closest
+// ancestor's and an entry interval of 0 disables rotation for it. Only login entries have passwords to rotate
+const rotationDue = `
+	SELECT e.*, vault_folder_path(e.folder_id) AS folder,
+		e.password_changed_at + make_interval(days => COALESCE(e.rotation_interval_days, vault_folder_rotation_days(e.folder_id))) AS rotation_due_at
+	FROM vault_entries e
+	WHERE e.type='login' AND COALESCE(e.rotation_interval_days, vault_folder_rotation_days(e.folder_id)) > 0`
+
+// ListDueForRotation returns the active user's entries whose password is due before the given time,
+// most overdue first. Passwords and hidden custom field values are not returned
+func (s *Store) ListDueForRotation(ctx context.Context, before time.Time) ([]Entry, error) {
+	userId, _ := auth.UserIDFromContext(ctx)
+	if userId == "" {
+		return nil, NoUserId
+	}
+
+	var entries []Entry
+	err := s.db.SelectContext(ctx, &entries,
+		`SELECT * FROM (`+rotationDue+`) due WHERE user_id=$1 AND rotation_due_at <= $2 ORDER BY rotation_due_at`,
+		userId, before)
+	if err != nil {
+		return nil, err
+	}
+	for i := range entries {
+		if err := openListed(&entries[i], false); err != nil {
+			return nil, err
+		}
+	}
+	return entries, nil
+}
+
+// ListRotationNotificationsDue returns the entries of all users that are due and whose owner was not
+// notified since the password last changed, ordered by user. It is used by the rotation scheduler
+func (s *Store) ListRotationNotificationsDue(ctx context.Context) ([]Entry, error) {
+	var entries []Entry
+	err := s.db.SelectContext(ctx, &entries,
+		`SELECT * FROM (`+rotationDue+`) due
+		WHERE rotation_due_at <= NOW() AND rotation_notified_at IS NULL
+		ORDER BY user_id, rotation_due_at`)
+	if err != nil {
+		return nil, err
+	}
+	for i := range entries {
+		if err := openListed(&entries[i], false); err != nil {
+			return nil, err
+		}
+	}
+	return entries, nil
+}
+
+// MarkRotationNotified records that the owners of the entries were told to rotate their passwords,
+// the mark is cleared when the password changes
+func (s *Store) MarkRotationNotified(ctx context.Context, ids []uuid.UUID) error {
+	_, err := s.db.ExecContext(ctx,
+		`UPDATE vault_entries SET rotation_notified_at=NOW() WHERE id = ANY($1)`, pq.Array(ids))
+	return err
+}
+
+// SetFolderRotation sets the rotation interval of the active user's folder, 0 removes it
+func (s *Store) SetFolderRotation(ctx context.Context, id uuid.UUID, days int32) error {
+	userId, _ := auth.UserIDFromContext(ctx)
+	if userId == "" {
+		return NoUserId
+	}
+
+	res, err := s.db.ExecContext(ctx,
+		`UPDATE vault_folders SET rotation_interval_days=NULLIF($3, 0), updated_at=NOW() WHERE id=$1 AND user_id=$2`,
+		id, userId, days)
+	if err != nil {
+		return err
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return err
+	} else if n == 0 {
+		return FolderNotFound
+	}
+	return nil
+}
+
+// ListFolderRotations returns the active user's folders with a rotation interval of their own
+func (s *Store) ListFolderRotations(ctx context.Context) ([]Folder, error) {
+	userId, _ := auth.UserIDFromContext(ctx)
+	if userId == "" {
+		return nil, NoUserId
+	}
+
+	var folders []Folder
+	err := s.db.SelectContext(ctx, &folders, `SELECT `+folderColumns+` FROM vault_folders f
+		WHERE f.user_id=$1 AND f.rotation_interval_days IS NOT NULL ORDER BY path`, userId)
+	return folders, err
+}
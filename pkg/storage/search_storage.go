@@ -0,0 +1,59 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"github.com/AleksZelenchuk/vault-server/pkg/auth"
+	"github.com/AleksZelenchuk/vault-server/pkg/search"
+	"strings"
+)
+
+// searchVector must match the expression of vault_entries_search_idx
+const searchVector = `vault_entry_search_vector(e.title, e.username, e."domain", e.tags)`
+
+// Search returns up to limit of the active user's entries matching q, best ranked first. Entries only
+// filtered by tag, folder or domain terms are ranked by their last update. Entries are opened like in
+// List, passwords are only decrypted with revealPasswords
+func (s *Store) Search(ctx context.Context, q *search.Query, limit int, revealPasswords bool) ([]Entry, error) {
+	userId, _ := auth.UserIDFromContext(ctx)
+	if userId == "" {
+		return nil, NoUserId
+	}
+
+	b := &query{}
+	rank := `0`
+	where := ` WHERE e.user_id=` + b.arg(userId)
+	if tsq := q.TSQuery(); tsq != "" {
+		p := b.arg(tsq)
+		rank = fmt.Sprintf(`ts_rank(%s, to_tsquery('simple', %s))`, searchVector, p)
+		where += fmt.Sprintf(` AND %s @@ to_tsquery('simple', %s)`, searchVector, p)
+	}
+	if len(q.Tags) > 0 {
+		where += " AND " + HasAllTags(q.Tags...).build(b)
+	}
+	for _, folder := range q.Folders {
+		where += ` AND lower(vault_folder_path(e.folder_id)) = lower(` + b.arg(folder) + `)`
+	}
+	for _, domain := range q.Domains {
+		where += " AND " + InDomain(domain).build(b)
+	}
+	limitArg := b.arg(limit)
+
+	var entries []Entry
+	err := s.db.SelectContext(ctx, &entries, fmt.Sprintf(`SELECT e.*, vault_folder_path(e.folder_id) AS folder, %s AS rank FROM vault_entries e%s
+		ORDER BY rank DESC, e.updated_at DESC, e.id LIMIT %s`, rank, where, limitArg), b.args...)
+	if err != nil {
+		return nil, err
+	}
+	for i := range entries {
+		if err := openListed(&entries[i], revealPasswords); err != nil {
+			return nil, err
+		}
+	}
+	return entries, nil
+}
+
+// escapeLike escapes the wildcards of a LIKE pattern
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
@@ -4,10 +4,13 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"fmt"
 	"github.com/AleksZelenchuk/vault-server/pkg/auth"
 	"github.com/google/uuid"
 	"github.com/jmoiron/sqlx"
-	"github.com/lib/pq"
+	"strconv"
+	"strings"
+	"time"
 )
 
 type Store struct{ db *sqlx.DB }
@@ -24,15 +27,193 @@ func (s *Store) Create(ctx context.Context, e *Entry) (sql.Result, error) {
 	if userId == "" {
 		return nil, NoUserId
 	}
+	if err := s.validateFolder(ctx, userId, e.FolderId); err != nil {
+		return nil, err
+	}
+
+	if err := sealPassword(userId, e); err != nil {
+		return nil, err
+	}
+	if err := sealTotp(e); err != nil {
+		return nil, err
+	}
+	if err := sealPayload(e); err != nil {
+		return nil, err
+	}
+	if err := sealCustomFields(e); err != nil {
+		return nil, err
+	}
+	query := "INSERT INTO vault_entries (id, title, username, password, notes, tags, folder_id, user_id, domain, client_encrypted, password_fingerprint, rotation_interval_days, totp, type, payload, custom_fields, uris, favorite) VALUES (:id, :title, :username, :password, :notes, :tags, :folder_id, :user_id, :domain, :client_encrypted, :password_fingerprint, :rotation_interval_days, :totp, :type, :payload, :custom_fields, :uris, :favorite)"
+
+	return s.db.NamedExecContext(ctx, query, e)
+}
+
+// Update replaces the fields and payload of an existing entry of the active user, its type cannot change. The password, its fingerprint and
+// the client encryption flag are only replaced when updatePassword is set, which also restarts the
+// password's rotation interval. The TOTP secret is only replaced when updateTotp is set, an empty Totp
+// removes it
+func (s *Store) Update(ctx context.Context, e *Entry, updatePassword bool, updateTotp bool) error {
+	userId, _ := auth.UserIDFromContext(ctx)
+	if userId == "" {
+		return NoUserId
+	}
+	if err := s.validateUserPermission(ctx, e.ID); err != nil {
+		return err
+	}
+	if err := s.validateFolder(ctx, userId, e.FolderId); err != nil {
+		return err
+	}
+
+	if err := sealPayload(e); err != nil {
+		return err
+	}
+	if err := sealCustomFields(e); err != nil {
+		return err
+	}
+	query := `UPDATE vault_entries SET title=:title, username=:username, notes=:notes, tags=:tags, folder_id=:folder_id,
+		domain=:domain, rotation_interval_days=:rotation_interval_days, payload=:payload, custom_fields=:custom_fields,
+		uris=:uris, updated_at=NOW()`
+	if updatePassword {
+		if err := sealPassword(userId, e); err != nil {
+			return err
+		}
+		query += `, password=:password, password_fingerprint=:password_fingerprint, client_encrypted=:client_encrypted,
+			password_changed_at=NOW(), rotation_notified_at=NULL`
+	}
+	if updateTotp {
+		if err := sealTotp(e); err != nil {
+			return err
+		}
+		query += `, totp=:totp`
+	}
+	query += ` WHERE id=:id`
+
+	res, err := s.db.NamedExecContext(ctx, query, e)
+	if err != nil {
+		return err
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if ra == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
 
+// sealPassword fingerprints and encrypts the plaintext password of e in place, client encrypted entries
+// are stored exactly as received and get no fingerprint, neither do entries without a password
+func sealPassword(userId string, e *Entry) error {
+	e.PasswordFingerprint = nil
+	if e.ClientEncrypted {
+		return nil
+	}
+	if len(e.Password) > 0 {
+		fp, err := Fingerprint(userId, e.Password)
+		if err != nil {
+			return err
+		}
+		e.PasswordFingerprint = fp
+	}
 	enc, err := Encrypt(e.Password)
 	if err != nil {
-		return nil, err
+		return err
 	}
 	e.Password = enc
-	query := "INSERT INTO vault_entries (id, title, username, password, notes, tags, folder, user_id, domain) VALUES (:id, :title, :username, :password, :notes, :tags, :folder, :user_id, :domain)"
+	return nil
+}
 
-	return s.db.NamedExecContext(ctx, query, e)
+// sealPayload encrypts the payload of e in place with the key of its type. Payloads are encrypted for
+// client encrypted entries too, only their secret fields are sealed by the client
+func sealPayload(e *Entry) error {
+	if e.Type == "" {
+		e.Type = EntryTypeLogin
+	}
+	if len(e.Payload) == 0 {
+		e.Payload = nil
+		return nil
+	}
+	enc, err := EncryptPayload(e.Type, e.Payload)
+	if err != nil {
+		return err
+	}
+	e.Payload = enc
+	return nil
+}
+
+// sealCustomFields encrypts the hidden custom field values of e in place, client encrypted entries carry
+// them sealed by the client
+func sealCustomFields(e *Entry) error {
+	if e.ClientEncrypted {
+		return nil
+	}
+	for i := range e.CustomFields {
+		f := &e.CustomFields[i]
+		if f.Type != CustomFieldHidden {
+			continue
+		}
+		enc, err := Encrypt(f.Value)
+		if err != nil {
+			return err
+		}
+		f.Value = enc
+	}
+	return nil
+}
+
+// openCustomFields decrypts the hidden custom field values of e in place, or clears them when reveal is
+// false so ciphertext never leaves the store
+func openCustomFields(e *Entry, reveal bool) error {
+	for i := range e.CustomFields {
+		f := &e.CustomFields[i]
+		if f.Type != CustomFieldHidden {
+			continue
+		}
+		if !reveal {
+			f.Value = nil
+			continue
+		}
+		if e.ClientEncrypted {
+			continue
+		}
+		dec, err := Decrypt(f.Value)
+		if err != nil {
+			return err
+		}
+		f.Value = dec
+	}
+	return nil
+}
+
+// openPayload decrypts the payload of e in place
+func openPayload(e *Entry) error {
+	if e.Payload == nil {
+		return nil
+	}
+	dec, err := DecryptPayload(e.Type, e.Payload)
+	if err != nil {
+		return err
+	}
+	e.Payload = dec
+	return nil
+}
+
+// sealTotp encrypts the TOTP URI of e in place, an empty URI is stored as NULL
+func sealTotp(e *Entry) error {
+	if len(e.Totp) == 0 {
+		e.Totp = nil
+		return nil
+	}
+	if e.ClientEncrypted {
+		return nil
+	}
+	enc, err := Encrypt(e.Totp)
+	if err != nil {
+		return err
+	}
+	e.Totp = enc
+	return nil
 }
 
 func (s *Store) Get(ctx context.Context, id uuid.UUID) (*Entry, error) {
@@ -42,15 +223,52 @@ func (s *Store) Get(ctx context.Context, id uuid.UUID) (*Entry, error) {
 	}
 
 	var e Entry
-	err := s.db.GetContext(ctx, &e, `SELECT * FROM vault_entries WHERE id=$1 AND user_id=$2`, id, userId)
+	err := s.db.GetContext(ctx, &e, `SELECT *, vault_folder_path(folder_id) AS folder FROM vault_entries WHERE id=$1 AND user_id=$2`, id, userId)
 	if err != nil {
 		return nil, err
 	}
+	if err := openPayload(&e); err != nil {
+		return nil, err
+	}
+	if err := openCustomFields(&e, true); err != nil {
+		return nil, err
+	}
+	if e.ClientEncrypted {
+		return &e, nil
+	}
 	dec, err := Decrypt(e.Password)
 	if err != nil {
 		return nil, err
 	}
 	e.Password = dec
+	if e.Totp != nil {
+		if e.Totp, err = Decrypt(e.Totp); err != nil {
+			return nil, err
+		}
+	}
+	return &e, nil
+}
+
+// GetMetadata returns the entry without decrypting its password, Password and hidden custom field values
+// are cleared. The payload is decrypted, the service redacts its secret fields
+func (s *Store) GetMetadata(ctx context.Context, id uuid.UUID) (*Entry, error) {
+	userId, _ := auth.UserIDFromContext(ctx)
+	if userId == "" {
+		return nil, NoUserId
+	}
+
+	var e Entry
+	err := s.db.GetContext(ctx, &e, `SELECT *, vault_folder_path(folder_id) AS folder FROM vault_entries WHERE id=$1 AND user_id=$2`, id, userId)
+	if err != nil {
+		return nil, err
+	}
+	e.Password = nil
+	if err := openPayload(&e); err != nil {
+		return nil, err
+	}
+	if err := openCustomFields(&e, false); err != nil {
+		return nil, err
+	}
 	return &e, nil
 }
 
@@ -76,39 +294,221 @@ func (s *Store) Delete(ctx context.Context, id uuid.UUID) (bool, error) {
 	return true, nil
 }
 
-func (s *Store) List(ctx context.Context, domain string, folder string, tags []string) ([]Entry, error) {
-	query := `SELECT * FROM vault_entries WHERE 1=1`
+// ListOptions filters and pages a listing, After is the cursor of the previous page. Passwords are only
+// decrypted with RevealPasswords, otherwise they are cleared
+type ListOptions struct {
+	Filter          Filter
+	OrderBy         string
+	Desc            bool
+	PageSize        int
+	After           *Cursor
+	RevealPasswords bool
+}
+
+// ListResult is one page of a listing. Next is nil on the last page, Total counts the entries matching
+// the filters across all pages
+type ListResult struct {
+	Entries []Entry
+	Next    *Cursor
+	Total   int
+}
+
+// listOrderColumns maps the sort keys a listing accepts to the expressions entries are ordered by, all
+// ascending. Favorites sort before other entries and never used entries before used ones
+var listOrderColumns = map[string][]string{
+	"title":        {"e.title"},
+	"created_at":   {"e.created_at"},
+	"updated_at":   {"e.updated_at"},
+	"favorite":     {"NOT e.favorite", "e.title"},
+	"last_used_at": {"COALESCE(e.last_used_at, '-infinity')"},
+}
 
+// List returns a page of the active user's entries matching the filters in the order of opts.OrderBy,
+// ties are broken by id so every entry is listed exactly once across pages. An empty types list matches
+// all types and fieldNames matches entries with a custom field of any of the names. Payloads are
+// decrypted, hidden custom field values are cleared
+func (s *Store) List(ctx context.Context, opts ListOptions) (*ListResult, error) {
 	userId, _ := auth.UserIDFromContext(ctx)
 	if userId == "" {
 		return nil, NoUserId
 	}
-	var args []interface{}
+	columns, ok := listOrderColumns[opts.OrderBy]
+	if !ok {
+		return nil, fmt.Errorf("unsupported sort key %q", opts.OrderBy)
+	}
 
-	query += ` AND user_id=$1`
-	args = append(args, userId)
+	q := &query{}
+	where := fmt.Sprintf(` FROM vault_entries e WHERE e.user_id=%s AND %s`, q.arg(userId), q.where(opts.Filter))
 
-	if len(domain) > 0 {
-		query += ` AND "domain" LIKE $2`
-		args = append(args, "%"+domain+"%")
+	result := &ListResult{}
+	if err := s.db.GetContext(ctx, &result.Total, `SELECT COUNT(*)`+where, q.args...); err != nil {
+		return nil, err
 	}
 
-	if folder != "" {
-		query += ` AND folder=$3`
-		args = append(args, folder)
+	direction, compare := "", ">"
+	if opts.Desc {
+		direction, compare = " DESC", "<"
 	}
-	if len(tags) > 0 {
-		query += ` AND tags @> $4`
-		args = append(args, pq.Array(tags))
+	if opts.After != nil {
+		if len(opts.After.Values) != len(columns) {
+			return nil, InvalidCursor
+		}
+		// the values are passed as text, Postgres converts them to the types of their columns
+		var values []string
+		for _, v := range opts.After.Values {
+			values = append(values, q.arg(v))
+		}
+		where += fmt.Sprintf(` AND (%s, e.id) %s (%s, %s)`,
+			strings.Join(columns, ", "), compare, strings.Join(values, ", "), q.arg(opts.After.ID))
 	}
+	// one entry more than the page size tells whether another page follows
+	where += fmt.Sprintf(` ORDER BY %s%s, e.id%s LIMIT %s`,
+		strings.Join(columns, direction+", "), direction, direction, q.arg(opts.PageSize+1))
 
 	var entries []Entry
-	err := s.db.SelectContext(ctx, &entries, query, args...)
+	err := s.db.SelectContext(ctx, &entries, `SELECT e.*, vault_folder_path(e.folder_id) AS folder, EXISTS (
+		SELECT 1 FROM vault_entries o
+		WHERE o.user_id=e.user_id AND o.id<>e.id AND o.password_fingerprint=e.password_fingerprint
+	) AS reused`+where, q.args...)
+	if err != nil {
+		return nil, err
+	}
+	if len(entries) > opts.PageSize {
+		entries = entries[:opts.PageSize]
+		last := entries[len(entries)-1]
+		result.Next = &Cursor{OrderBy: opts.OrderBy, Desc: opts.Desc, Values: sortKey(&last, opts.OrderBy), ID: last.ID}
+	}
+
+	for i := range entries {
+		if err := openListed(&entries[i], opts.RevealPasswords); err != nil {
+			return nil, err
+		}
+	}
+	result.Entries = entries
+	return result, nil
+}
+
+// openListed decrypts the payload of a listed entry and clears its hidden custom field values. The
+// password is decrypted with revealPassword and cleared otherwise, client encrypted passwords are kept
+// as stored
+func openListed(e *Entry, revealPassword bool) error {
+	switch {
+	case !revealPassword:
+		e.Password = nil
+	case !e.ClientEncrypted && e.Password != nil:
+		password, err := Decrypt(e.Password)
+		if err != nil {
+			return err
+		}
+		e.Password = password
+	}
+	if err := openPayload(e); err != nil {
+		return err
+	}
+	return openCustomFields(e, false)
+}
+
+// sortKey returns the values of e for the expressions of listOrderColumns[orderBy] in their text form
+func sortKey(e *Entry, orderBy string) []string {
+	switch orderBy {
+	case "title":
+		return []string{e.Title}
+	case "created_at":
+		return []string{e.CreatedAt.Format(time.RFC3339Nano)}
+	case "updated_at":
+		return []string{e.UpdatedAt.Format(time.RFC3339Nano)}
+	case "favorite":
+		return []string{strconv.FormatBool(!e.Favorite), e.Title}
+	case "last_used_at":
+		if !e.LastUsedAt.Valid {
+			return []string{"-infinity"}
+		}
+		return []string{e.LastUsedAt.Time.Format(time.RFC3339Nano)}
+	}
+	return nil
+}
+
+// ListDecrypted returns all login entries of the active user with decrypted passwords, client encrypted
+// entries are returned as stored
+func (s *Store) ListDecrypted(ctx context.Context) ([]Entry, error) {
+	userId, _ := auth.UserIDFromContext(ctx)
+	if userId == "" {
+		return nil, NoUserId
+	}
+
+	var entries []Entry
+	err := s.db.SelectContext(ctx, &entries, `SELECT * FROM vault_entries WHERE user_id=$1 AND type='login' ORDER BY created_at`, userId)
+	if err != nil {
+		return nil, err
+	}
+	for i := range entries {
+		if entries[i].ClientEncrypted {
+			continue
+		}
+		dec, err := Decrypt(entries[i].Password)
+		if err != nil {
+			return nil, err
+		}
+		entries[i].Password = dec
+	}
+	return entries, nil
+}
+
+// ListReused returns the entries of the active user whose password is shared with another of their
+// entries, ordered so that entries with the same password are adjacent
+func (s *Store) ListReused(ctx context.Context) ([]Entry, error) {
+	userId, _ := auth.UserIDFromContext(ctx)
+	if userId == "" {
+		return nil, NoUserId
+	}
+
+	var entries []Entry
+	err := s.db.SelectContext(ctx, &entries, `
+		SELECT * FROM vault_entries
+		WHERE user_id=$1 AND password_fingerprint IN (
+			SELECT password_fingerprint FROM vault_entries
+			WHERE user_id=$1 AND password_fingerprint IS NOT NULL
+			GROUP BY password_fingerprint HAVING COUNT(*) > 1
+		)
+		ORDER BY password_fingerprint, created_at`, userId)
+	if err != nil {
+		return nil, err
+	}
+	for i := range entries {
+		entries[i].Password = nil
+	}
+	return entries, nil
+}
+
+// BackfillFingerprints fingerprints entries created before fingerprints existed, it returns how many
+// entries were updated
+func (s *Store) BackfillFingerprints(ctx context.Context) (int, error) {
+	var entries []Entry
+	err := s.db.SelectContext(ctx, &entries,
+		`SELECT * FROM vault_entries WHERE password_fingerprint IS NULL AND NOT client_encrypted AND type='login'`)
+	if err != nil {
+		return 0, err
+	}
 
-	for _, entry := range entries {
-		entry.Password, _ = Decrypt(entry.Password)
+	updated := 0
+	for _, e := range entries {
+		password, err := Decrypt(e.Password)
+		if err != nil {
+			return updated, err
+		}
+		fp, err := Fingerprint(e.UserId, password)
+		clear(password)
+		if err != nil {
+			return updated, err
+		}
+		_, err = s.db.ExecContext(ctx,
+			`UPDATE vault_entries SET password_fingerprint=$1 WHERE id=$2 AND password_fingerprint IS NULL`, fp, e.ID)
+		if err != nil {
+			return updated, err
+		}
+		updated++
 	}
-	return entries, err
+	return updated, nil
 }
 
 // validateUserPermission we need to check if given used have permission to perform action with the requested entry
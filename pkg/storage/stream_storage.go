@@ -0,0 +1,57 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"github.com/AleksZelenchuk/vault-server/pkg/auth"
+)
+
+// Stream reads the active user's entries matching filter through a server side cursor in batches of
+// batchSize, ordered by creation. Entries are opened row by row like in List, passwords are only
+// decrypted with revealPasswords. Only one batch is held in memory: send is called with each batch
+// before the next one is fetched, and an error from send or a cancelled context stops the stream
+func (s *Store) Stream(ctx context.Context, filter Filter, revealPasswords bool, batchSize int, send func([]Entry) error) error {
+	userId, _ := auth.UserIDFromContext(ctx)
+	if userId == "" {
+		return NoUserId
+	}
+
+	tx, err := s.db.BeginTxx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	q := &query{}
+	declare := fmt.Sprintf(`DECLARE vault_entries_stream NO SCROLL CURSOR FOR
+		SELECT e.*, vault_folder_path(e.folder_id) AS folder FROM vault_entries e WHERE e.user_id=%s AND %s ORDER BY e.created_at, e.id`, q.arg(userId), q.where(filter))
+	if _, err := tx.ExecContext(ctx, declare, q.args...); err != nil {
+		return err
+	}
+
+	fetch := fmt.Sprintf(`FETCH FORWARD %d FROM vault_entries_stream`, batchSize)
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		var batch []Entry
+		if err := tx.SelectContext(ctx, &batch, fetch); err != nil {
+			return err
+		}
+		if len(batch) == 0 {
+			return tx.Commit()
+		}
+		for i := range batch {
+			if err := openListed(&batch[i], revealPasswords); err != nil {
+				return err
+			}
+		}
+		if err := send(batch); err != nil {
+			return err
+		}
+		if len(batch) < batchSize {
+			return tx.Commit()
+		}
+	}
+}
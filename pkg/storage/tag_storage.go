@@ -0,0 +1,112 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"github.com/AleksZelenchuk/vault-server/pkg/auth"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+var TagNotFound = errors.New("tag not found")
+var TagExists = errors.New("tag already exists")
+
+// ListTags returns all tags of the active user's entries with their number of entries, ordered by name
+func (s *Store) ListTags(ctx context.Context) ([]Tag, error) {
+	userId, _ := auth.UserIDFromContext(ctx)
+	if userId == "" {
+		return nil, NoUserId
+	}
+
+	var tags []Tag
+	err := s.db.SelectContext(ctx, &tags, `
+		SELECT t AS name, COUNT(*) AS count
+		FROM vault_entries e, unnest(e.tags) t
+		WHERE e.user_id=$1
+		GROUP BY t
+		ORDER BY t`, userId)
+	return tags, err
+}
+
+// SuggestTags returns up to limit tags of the active user starting with prefix, ignoring case, the most
+// used first
+func (s *Store) SuggestTags(ctx context.Context, prefix string, limit int) ([]Tag, error) {
+	userId, _ := auth.UserIDFromContext(ctx)
+	if userId == "" {
+		return nil, NoUserId
+	}
+
+	var tags []Tag
+	err := s.db.SelectContext(ctx, &tags, `
+		SELECT t AS name, COUNT(*) AS count
+		FROM vault_entries e, unnest(e.tags) t
+		WHERE e.user_id=$1 AND lower(t) LIKE lower($2)
+		GROUP BY t
+		ORDER BY count DESC, t
+		LIMIT $3`, userId, escapeLike(prefix)+"%", limit)
+	return tags, err
+}
+
+// RenameTag renames a tag on all entries of the active user and returns the ids of the changed entries.
+// Renaming onto a tag that is already in use is TagExists, MergeTags joins tags
+func (s *Store) RenameTag(ctx context.Context, from string, to string) ([]uuid.UUID, error) {
+	return s.replaceTags(ctx, []string{from}, to, false)
+}
+
+// MergeTags replaces the source tags by target on all entries of the active user and returns the ids of
+// the changed entries. target may already be in use, entries never carry a tag twice
+func (s *Store) MergeTags(ctx context.Context, sources []string, target string) ([]uuid.UUID, error) {
+	return s.replaceTags(ctx, sources, target, true)
+}
+
+// replaceTags replaces sources by target in a single transaction, so concurrent readers see either all
+// entries renamed or none. Each entry keeps the position of the first replaced tag
+func (s *Store) replaceTags(ctx context.Context, sources []string, target string, merge bool) ([]uuid.UUID, error) {
+	userId, _ := auth.UserIDFromContext(ctx)
+	if userId == "" {
+		return nil, NoUserId
+	}
+
+	tx, err := s.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	// serialize tag changes of a user, so a rename cannot race another rename onto the same tag
+	if _, err := tx.ExecContext(ctx, `SELECT pg_advisory_xact_lock(hashtext('vault_tags/' || $1))`, userId); err != nil {
+		return nil, err
+	}
+	if !merge {
+		var used bool
+		err := tx.GetContext(ctx, &used, `SELECT EXISTS (SELECT 1 FROM vault_entries WHERE user_id=$1 AND $2 = ANY(tags))`, userId, target)
+		if err != nil {
+			return nil, err
+		}
+		if used {
+			return nil, TagExists
+		}
+	}
+
+	var ids []uuid.UUID
+	err = tx.SelectContext(ctx, &ids, `
+		UPDATE vault_entries e SET
+			tags=(
+				SELECT array_agg(r.tag ORDER BY r.pos)
+				FROM (
+					SELECT CASE WHEN t = ANY($2) THEN $3 ELSE t END AS tag, MIN(u.pos) AS pos
+					FROM unnest(e.tags) WITH ORDINALITY AS u(t, pos)
+					GROUP BY 1
+				) r
+			),
+			updated_at=NOW()
+		WHERE e.user_id=$1 AND e.tags && $2
+		RETURNING e.id`, userId, pq.Array(sources), target)
+	if err != nil {
+		return nil, err
+	}
+	if len(ids) == 0 {
+		return nil, TagNotFound
+	}
+	return ids, tx.Commit()
+}
@@ -0,0 +1,38 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"github.com/AleksZelenchuk/vault-server/pkg/auth"
+)
+
+// ListURLCandidates returns the entries of the active user with a URI that may match a URL of the
+// registrable domain: URIs on a host in the domain and regular expressions, ordered by title. Callers
+// decide the actual matches with pkg/urimatch. Entries are opened like in List
+func (s *Store) ListURLCandidates(ctx context.Context, domain string, revealPasswords bool) ([]Entry, error) {
+	userId, _ := auth.UserIDFromContext(ctx)
+	if userId == "" {
+		return nil, NoUserId
+	}
+
+	regex := filterFunc(func(q *query) string {
+		return `EXISTS (SELECT 1 FROM jsonb_array_elements(e.uris) u WHERE u->>'match' = 'regex')`
+	})
+	q := &query{}
+	where := fmt.Sprintf(`e.user_id=%s AND %s`, q.arg(userId), q.where(Or(InDomain(domain), regex)))
+
+	var entries []Entry
+	err := s.db.SelectContext(ctx, &entries, `
+		SELECT e.*, vault_folder_path(e.folder_id) AS folder FROM vault_entries e
+		WHERE `+where+`
+		ORDER BY e.title, e.id`, q.args...)
+	if err != nil {
+		return nil, err
+	}
+	for i := range entries {
+		if err := openListed(&entries[i], revealPasswords); err != nil {
+			return nil, err
+		}
+	}
+	return entries, nil
+}
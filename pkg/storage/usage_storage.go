@@ -0,0 +1,64 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"github.com/AleksZelenchuk/vault-server/pkg/auth"
+	"github.com/google/uuid"
+)
+
+// SetFavorite marks or unmarks an entry of the active user as favorite. Like use, this is no change of
+// the entry and keeps updated_at
+func (s *Store) SetFavorite(ctx context.Context, id uuid.UUID, favorite bool) error {
+	userId, _ := auth.UserIDFromContext(ctx)
+	if userId == "" {
+		return NoUserId
+	}
+
+	res, err := s.db.ExecContext(ctx, `UPDATE vault_entries SET favorite=$3 WHERE id=$1 AND user_id=$2`, id, userId, favorite)
+	if err != nil {
+		return err
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return err
+	} else if n == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+// MarkUsed sets the last use of an entry of the active user to now
+func (s *Store) MarkUsed(ctx context.Context, id uuid.UUID) error {
+	userId, _ := auth.UserIDFromContext(ctx)
+	if userId == "" {
+		return NoUserId
+	}
+
+	_, err := s.db.ExecContext(ctx, `UPDATE vault_entries SET last_used_at=NOW() WHERE id=$1 AND user_id=$2`, id, userId)
+	return err
+}
+
+// ListRecent returns up to limit used entries of the active user, the most recently used first. Entries
+// are opened like in List
+func (s *Store) ListRecent(ctx context.Context, limit int, revealPasswords bool) ([]Entry, error) {
+	userId, _ := auth.UserIDFromContext(ctx)
+	if userId == "" {
+		return nil, NoUserId
+	}
+
+	var entries []Entry
+	err := s.db.SelectContext(ctx, &entries, `
+		SELECT e.*, vault_folder_path(e.folder_id) AS folder FROM vault_entries e
+		WHERE e.user_id=$1 AND e.last_used_at IS NOT NULL
+		ORDER BY e.last_used_at DESC, e.id
+		LIMIT $2`, userId, limit)
+	if err != nil {
+		return nil, err
+	}
+	for i := range entries {
+		if err := openListed(&entries[i], revealPasswords); err != nil {
+			return nil, err
+		}
+	}
+	return entries, nil
+}
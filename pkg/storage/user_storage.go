@@ -2,7 +2,9 @@ package storage
 
 import (
 	"context"
+	"crypto/rand"
 	"database/sql"
+	"github.com/AleksZelenchuk/vault-server/pkg/auth"
 	"github.com/google/uuid"
 	"github.com/jmoiron/sqlx"
 )
@@ -13,13 +15,11 @@ func NewUserStore(db *sqlx.DB) *UserStore {
 	return &UserStore{db: db}
 }
 
+// CreateUser stores a new user, Password must already be a self-describing hash (PHC string)
+// and is stored as it is, SRP users have no password at all
 func (s *UserStore) CreateUser(ctx context.Context, e *User) (sql.Result, error) {
-	enc, err := Encrypt(e.Password)
-	if err != nil {
-		return nil, err
-	}
-	e.Password = enc
-	query := "INSERT INTO vault_users (id, email, username, password) VALUES (:id, :email, :username, :password)"
+	e.PasswordEncrypted = false
+	query := "INSERT INTO vault_users (id, email, username, password, password_encrypted, srp_salt, srp_verifier) VALUES (:id, :email, :username, :password, :password_encrypted, :srp_salt, :srp_verifier)"
 
 	return s.db.NamedExecContext(ctx, query, e)
 }
@@ -31,14 +31,24 @@ func (s *UserStore) GetByUsername(ctx context.Context, username string) (*User,
 	if err != nil {
 		return nil, err
 	}
+	if !e.PasswordEncrypted || len(e.Password) == 0 {
+		return &e, nil
+	}
 	dec, err := Decrypt(e.Password)
 	if err != nil {
 		return nil, err
 	}
 	e.Password = dec
+	e.PasswordEncrypted = false
 	return &e, nil
 }
 
+// UpdatePasswordHash replaces the stored hash, used to upgrade legacy hashes after a successful login
+func (s *UserStore) UpdatePasswordHash(ctx context.Context, id uuid.UUID, hash []byte) error {
+	_, err := s.db.ExecContext(ctx, `UPDATE vault_users SET password=$2, password_encrypted=FALSE, updated_at=NOW() WHERE id=$1`, id, hash)
+	return err
+}
+
 func (s *UserStore) DeleteUser(ctx context.Context, id uuid.UUID) (bool, error) {
 	_, err := s.db.ExecContext(ctx, `DELETE FROM vault_users WHERE id=$1`, id)
 	if err != nil {
@@ -46,3 +56,32 @@ func (s *UserStore) DeleteUser(ctx context.Context, id uuid.UUID) (bool, error)
 	}
 	return true, nil
 }
+
+// KdfParams returns the zero-knowledge key derivation parameters of the active user. They are created
+// from defaults with a fresh random salt on first use and stay fixed afterwards, otherwise clients could
+// no longer decrypt their entries
+func (s *UserStore) KdfParams(ctx context.Context, defaults KdfParams) (*KdfParams, error) {
+	userId, _ := auth.UserIDFromContext(ctx)
+	if userId == "" {
+		return nil, NoUserId
+	}
+
+	salt := make([]byte, 16)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+	_, err := s.db.ExecContext(ctx, `
+		UPDATE vault_users SET kdf_salt=$2, kdf_time=$3, kdf_memory=$4, kdf_threads=$5, updated_at=NOW()
+		WHERE id=$1 AND kdf_salt IS NULL`,
+		userId, salt, defaults.Time, defaults.MemoryKiB, defaults.Threads)
+	if err != nil {
+		return nil, err
+	}
+
+	var p KdfParams
+	err = s.db.GetContext(ctx, &p, `SELECT kdf_salt, kdf_time, kdf_memory, kdf_threads FROM vault_users WHERE id=$1`, userId)
+	if err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
@@ -0,0 +1,98 @@
+package strength
+
+import "strings"
+
+// adjacencyGraph maps a key to its neighbours, one string per direction holding the unshifted and
+// shifted character of the neighbouring key, or "" when there is none
+type adjacencyGraph struct {
+	name          string
+	neighbours    map[rune][]string
+	startingKeys  float64
+	averageDegree float64
+}
+
+// keyboard layouts in the format used by zxcvbn: every token is a key with its unshifted and shifted
+// character, slanted layouts are indented one more column per row
+const (
+	qwertyLayout = "" +
+		"`~ 1! 2@ 3# 4$ 5% 6^ 7& 8* 9( 0) -_ =+\n" +
+		"    qQ wW eE rR tT yY uU iI oO pP [{ ]} \\|\n" +
+		"     aA sS dD fF gG hH jJ kK lL ;: '\"\n" +
+		"      zZ xX cC vV bB nN mM ,< .> /?"
+
+	dvorakLayout = "" +
+		"`~ 1! 2@ 3# 4$ 5% 6^ 7& 8* 9( 0) [{ ]}\n" +
+		"    '\" ,< .> pP yY fF gG cC rR lL /? =+ \\|\n" +
+		"     aA oO eE uU iI dD hH tT nN sS -_\n" +
+		"      ;: qQ jJ kK xX bB mM wW vV zZ"
+
+	keypadLayout = "" +
+		"  / * -\n" +
+		"7 8 9 +\n" +
+		"4 5 6\n" +
+		"1 2 3\n" +
+		"  0 ."
+)
+
+var keyboardGraphs = []*adjacencyGraph{
+	buildGraph("qwerty", qwertyLayout, true),
+	buildGraph("dvorak", dvorakLayout, true),
+	buildGraph("keypad", keypadLayout, false),
+}
+
+type coord struct{ x, y int }
+
+func buildGraph(name, layout string, slanted bool) *adjacencyGraph {
+	positions := make(map[coord]string)
+	xUnit := 2
+	if slanted {
+		xUnit = 3
+	}
+
+	for y, line := range strings.Split(layout, "\n") {
+		slant := 0
+		if slanted {
+			slant = y
+		}
+		for i := 0; i < len(line); i++ {
+			if line[i] == ' ' || (i > 0 && line[i-1] != ' ') {
+				continue
+			}
+			end := strings.IndexByte(line[i:], ' ')
+			if end < 0 {
+				end = len(line) - i
+			}
+			positions[coord{(i - slant) / xUnit, y}] = line[i : i+end]
+		}
+	}
+
+	g := &adjacencyGraph{name: name, neighbours: make(map[rune][]string)}
+	var degrees int
+	for pos, token := range positions {
+		var around []string
+		for _, c := range adjacentCoords(pos, slanted) {
+			around = append(around, positions[c])
+		}
+		for _, r := range token {
+			g.neighbours[r] = around
+		}
+		for _, n := range around {
+			if n != "" {
+				degrees++
+			}
+		}
+	}
+	g.startingKeys = float64(len(positions))
+	g.averageDegree = float64(degrees) / float64(len(positions))
+	return g
+}
+
+// adjacentCoords lists neighbours clockwise starting from the left, slanted keyboards have six and
+// aligned keypads eight of them
+func adjacentCoords(c coord, slanted bool) []coord {
+	x, y := c.x, c.y
+	if slanted {
+		return []coord{{x - 1, y}, {x, y - 1}, {x + 1, y - 1}, {x + 1, y}, {x, y + 1}, {x - 1, y + 1}}
+	}
+	return []coord{{x - 1, y}, {x - 1, y - 1}, {x, y - 1}, {x + 1, y - 1}, {x + 1, y}, {x + 1, y + 1}, {x, y + 1}, {x - 1, y + 1}}
+}
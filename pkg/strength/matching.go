@@ -0,0 +1,534 @@
+package strength
+
+import (
+	"bufio"
+	"embed"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// Word lists are derived from zxcvbn (https://github.com/dropbox/zxcvbn, MIT license), one word per line
+// ordered by frequency
+//
+//go:embed wordlists/*.txt
+var wordlistFS embed.FS
+
+const (
+	patternDictionary = "dictionary"
+	patternSpatial    = "spatial"
+	patternRepeat     = "repeat"
+	patternSequence   = "sequence"
+	patternDate       = "date"
+	patternBruteforce = "bruteforce"
+
+	dictPasswords  = "passwords"
+	dictEnglish    = "english"
+	dictNames      = "names"
+	dictSurnames   = "surnames"
+	dictUserInputs = "user_inputs"
+)
+
+// Match is a part of the password explained by one pattern
+type Match struct {
+	Pattern string
+	// I and J are the rune offsets of the first and last character of Token
+	I, J    int
+	Token   string
+	Guesses float64
+
+	// dictionary
+	Dictionary string
+	Rank       int
+	Reversed   bool
+	L33t       bool
+	sub        map[rune]rune
+
+	// spatial
+	Graph        string
+	Turns        int
+	ShiftedCount int
+
+	// repeat
+	BaseToken   string
+	baseGuesses float64
+	RepeatCount int
+
+	// sequence
+	sequenceSpace int
+	Ascending     bool
+
+	// date
+	Year      int
+	Separator string
+}
+
+// maxWordLength bounds dictionary lookups, no word in the lists is longer
+const maxWordLength = 32
+
+var loadDictionaries = sync.OnceValue(func() map[string]map[string]int {
+	dicts := make(map[string]map[string]int)
+	for _, name := range []string{dictPasswords, dictEnglish, dictNames, dictSurnames} {
+		f, err := wordlistFS.Open("wordlists/" + name + ".txt")
+		if err != nil {
+			panic(err)
+		}
+		ranked := make(map[string]int)
+		scanner := bufio.NewScanner(f)
+		for scanner.Scan() {
+			if w := scanner.Text(); w != "" {
+				ranked[w] = len(ranked) + 1
+			}
+		}
+		f.Close()
+		dicts[name] = ranked
+	}
+	return dicts
+})
+
+// rankedUserInputs turns inputs like the entry title or username into a dictionary of the inputs and
+// the words they consist of
+func rankedUserInputs(inputs []string) map[string]int {
+	ranked := make(map[string]int)
+	add := func(w string) {
+		if _, ok := ranked[w]; !ok && w != "" {
+			ranked[w] = len(ranked) + 1
+		}
+	}
+	for _, in := range inputs {
+		in = strings.ToLower(strings.TrimSpace(in))
+		add(in)
+		for _, w := range strings.FieldsFunc(in, func(r rune) bool { return !unicode.IsLetter(r) && !unicode.IsDigit(r) }) {
+			add(w)
+		}
+	}
+	return ranked
+}
+
+// omnimatch returns every match of every pattern, sorted by position
+func omnimatch(password []rune, userInputs map[string]int) []*Match {
+	dicts := loadDictionaries()
+	var matches []*Match
+	matches = append(matches, dictionaryMatch(password, dicts, userInputs)...)
+	matches = append(matches, reverseDictionaryMatch(password, dicts, userInputs)...)
+	matches = append(matches, l33tMatch(password, dicts, userInputs)...)
+	matches = append(matches, spatialMatch(password)...)
+	matches = append(matches, repeatMatch(password)...)
+	matches = append(matches, sequenceMatch(password)...)
+	matches = append(matches, dateMatch(password)...)
+	sortMatches(matches)
+	return matches
+}
+
+func sortMatches(matches []*Match) {
+	sort.SliceStable(matches, func(a, b int) bool {
+		if matches[a].I != matches[b].I {
+			return matches[a].I < matches[b].I
+		}
+		return matches[a].J < matches[b].J
+	})
+}
+
+func dictionaryMatch(password []rune, dicts map[string]map[string]int, userInputs map[string]int) []*Match {
+	lower := []rune(strings.ToLower(string(password)))
+	if len(lower) != len(password) {
+		// lowercasing changed the rune count, offsets would not line up
+		return nil
+	}
+	var matches []*Match
+	lookup := func(name string, ranked map[string]int) {
+		for i := range lower {
+			for j := i; j < len(lower) && j-i < maxWordLength; j++ {
+				word := string(lower[i : j+1])
+				if rank, ok := ranked[word]; ok {
+					matches = append(matches, &Match{
+						Pattern:    patternDictionary,
+						I:          i,
+						J:          j,
+						Token:      string(password[i : j+1]),
+						Dictionary: name,
+						Rank:       rank,
+					})
+				}
+			}
+		}
+	}
+	for name, ranked := range dicts {
+		lookup(name, ranked)
+	}
+	if len(userInputs) > 0 {
+		lookup(dictUserInputs, userInputs)
+	}
+	return matches
+}
+
+func reverseDictionaryMatch(password []rune, dicts map[string]map[string]int, userInputs map[string]int) []*Match {
+	reversed := reverseRunes(password)
+	matches := dictionaryMatch(reversed, dicts, userInputs)
+	for _, m := range matches {
+		m.Token = string(reverseRunes([]rune(m.Token)))
+		m.Reversed = true
+		m.I, m.J = len(password)-1-m.J, len(password)-1-m.I
+	}
+	return matches
+}
+
+// l33tTable lists the letters a substitution character can stand for
+var l33tTable = map[rune][]rune{
+	'4': {'a'}, '@': {'a'},
+	'8': {'b'},
+	'(': {'c'}, '{': {'c'}, '[': {'c'}, '<': {'c'},
+	'3': {'e'},
+	'6': {'g'}, '9': {'g'},
+	'1': {'i', 'l'}, '!': {'i'}, '|': {'i', 'l'},
+	'7': {'l', 't'},
+	'0': {'o'},
+	'$': {'s'}, '5': {'s'},
+	'+': {'t'},
+	'%': {'x'},
+	'2': {'z'},
+}
+
+// maxL33tVariants bounds the substitutions tried for one substring
+const maxL33tVariants = 32
+
+func l33tMatch(password []rune, dicts map[string]map[string]int, userInputs map[string]int) []*Match {
+	lower := []rune(strings.ToLower(string(password)))
+	if len(lower) != len(password) {
+		return nil
+	}
+	var matches []*Match
+	for i := range lower {
+		for j := i; j < len(lower) && j-i < maxWordLength; j++ {
+			token := lower[i : j+1]
+			for _, variant := range l33tVariants(token) {
+				word := string(variant.word)
+				check := func(name string, ranked map[string]int) {
+					if rank, ok := ranked[word]; ok {
+						matches = append(matches, &Match{
+							Pattern:    patternDictionary,
+							I:          i,
+							J:          j,
+							Token:      string(password[i : j+1]),
+							Dictionary: name,
+							Rank:       rank,
+							L33t:       true,
+							sub:        variant.sub,
+						})
+					}
+				}
+				for name, ranked := range dicts {
+					check(name, ranked)
+				}
+				if len(userInputs) > 0 {
+					check(dictUserInputs, userInputs)
+				}
+			}
+		}
+	}
+	return matches
+}
+
+type l33tVariant struct {
+	word []rune
+	sub  map[rune]rune
+}
+
+// l33tVariants returns every way to undo substitutions in token, it is empty when token has none.
+// Single characters are not considered, "4" is no more a word than "a" is guessable
+func l33tVariants(token []rune) []l33tVariant {
+	if len(token) < 2 {
+		return nil
+	}
+	variants := []l33tVariant{{word: []rune{}, sub: map[rune]rune{}}}
+	substituted := false
+	for _, r := range token {
+		letters, ok := l33tTable[r]
+		if !ok {
+			for k := range variants {
+				variants[k].word = append(variants[k].word, r)
+			}
+			continue
+		}
+		substituted = true
+		var next []l33tVariant
+		for _, v := range variants {
+			if len(next) >= maxL33tVariants {
+				break
+			}
+			for _, letter := range letters {
+				// one substitution character stands for the same letter throughout the token
+				if prev, seen := v.sub[r]; seen && prev != letter {
+					continue
+				}
+				sub := make(map[rune]rune, len(v.sub)+1)
+				for k, l := range v.sub {
+					sub[k] = l
+				}
+				sub[r] = letter
+				word := append(append([]rune{}, v.word...), letter)
+				next = append(next, l33tVariant{word: word, sub: sub})
+				if len(next) >= maxL33tVariants {
+					break
+				}
+			}
+		}
+		variants = next
+	}
+	if !substituted {
+		return nil
+	}
+	return variants
+}
+
+func spatialMatch(password []rune) []*Match {
+	var matches []*Match
+	for _, g := range keyboardGraphs {
+		i := 0
+		for i < len(password)-1 {
+			j := i + 1
+			lastDirection := -1
+			turns := 0
+			shifted := 0
+			if g.name != "keypad" && strings.ContainsRune(shiftedChars, password[i]) {
+				shifted = 1
+			}
+			for {
+				found := false
+				if j < len(password) {
+					cur := password[j]
+					for direction, adjacent := range g.neighbours[password[j-1]] {
+						pos := strings.IndexRune(adjacent, cur)
+						if adjacent == "" || pos < 0 {
+							continue
+						}
+						found = true
+						if pos > 0 {
+							shifted++
+						}
+						if direction != lastDirection {
+							turns++
+							lastDirection = direction
+						}
+						break
+					}
+				}
+				if found {
+					j++
+					continue
+				}
+				if j-i > 2 {
+					matches = append(matches, &Match{
+						Pattern:      patternSpatial,
+						I:            i,
+						J:            j - 1,
+						Token:        string(password[i:j]),
+						Graph:        g.name,
+						Turns:        turns,
+						ShiftedCount: shifted,
+					})
+				}
+				i = j
+				break
+			}
+		}
+	}
+	return matches
+}
+
+const shiftedChars = "~!@#$%^&*()_+QWERTYUIOP{}|ASDFGHJKL:\"ZXCVBNM<>?"
+
+// repeatMatch finds runs of a repeated base token such as "aaa" or "abcabc", preferring the longest run
+// and, for runs of equal length, the shortest base
+func repeatMatch(password []rune) []*Match {
+	var matches []*Match
+	i := 0
+	for i < len(password) {
+		bestLen, bestBase := 0, 0
+		for base := 1; i+2*base <= len(password); base++ {
+			count := 1
+			for i+(count+1)*base <= len(password) &&
+				string(password[i+count*base:i+(count+1)*base]) == string(password[i:i+base]) {
+				count++
+			}
+			if count >= 2 && count*base > bestLen {
+				bestLen, bestBase = count*base, base
+			}
+		}
+		if bestLen == 0 {
+			i++
+			continue
+		}
+		base := string(password[i : i+bestBase])
+		matches = append(matches, &Match{
+			Pattern:     patternRepeat,
+			I:           i,
+			J:           i + bestLen - 1,
+			Token:       string(password[i : i+bestLen]),
+			BaseToken:   base,
+			baseGuesses: estimate([]rune(base), nil).Guesses,
+			RepeatCount: bestLen / bestBase,
+		})
+		i += bestLen
+	}
+	return matches
+}
+
+// maxSequenceDelta is the largest step between characters still considered a sequence, e.g. "aceg"
+const maxSequenceDelta = 5
+
+func sequenceMatch(password []rune) []*Match {
+	if len(password) < 2 {
+		return nil
+	}
+	var matches []*Match
+	update := func(i, j int, delta int) {
+		if j-i <= 1 && abs(delta) != 1 {
+			return
+		}
+		if delta == 0 || abs(delta) > maxSequenceDelta {
+			return
+		}
+		token := string(password[i : j+1])
+		space := 26
+		switch {
+		case isAll(token, "abcdefghijklmnopqrstuvwxyz"), isAll(token, "ABCDEFGHIJKLMNOPQRSTUVWXYZ"):
+		case isAll(token, "0123456789"):
+			space = 10
+		default:
+			// other unicode sequences
+			space = 26
+		}
+		matches = append(matches, &Match{
+			Pattern:       patternSequence,
+			I:             i,
+			J:             j,
+			Token:         token,
+			sequenceSpace: space,
+			Ascending:     delta > 0,
+		})
+	}
+
+	i := 0
+	lastDelta := 0
+	for k := 1; k < len(password); k++ {
+		delta := int(password[k]) - int(password[k-1])
+		if k == 1 {
+			lastDelta = delta
+			continue
+		}
+		if delta == lastDelta {
+			continue
+		}
+		update(i, k-1, lastDelta)
+		i = k - 1
+		lastDelta = delta
+	}
+	update(i, len(password)-1, lastDelta)
+	return matches
+}
+
+var (
+	yearPattern = regexp.MustCompile(`19\d\d|20\d\d`)
+	datePattern = regexp.MustCompile(`\d{1,4}[\s/\\_.-]\d{1,2}[\s/\\_.-]\d{1,4}`)
+)
+
+// dateMatch finds years and separated dates such as 13.05.1987 or 1987-5-13
+func dateMatch(password []rune) []*Match {
+	var matches []*Match
+	s := string(password)
+	if len(s) != len(password) {
+		// offsets below are byte offsets, they only equal rune offsets for ASCII passwords
+		return nil
+	}
+
+	for _, loc := range yearPattern.FindAllStringIndex(s, -1) {
+		year, _ := strconv.Atoi(s[loc[0]:loc[1]])
+		matches = append(matches, &Match{Pattern: patternDate, I: loc[0], J: loc[1] - 1, Token: s[loc[0]:loc[1]], Year: year})
+	}
+
+	for i := 0; i < len(s); i++ {
+		loc := datePattern.FindStringIndex(s[i:])
+		if loc == nil {
+			break
+		}
+		start, end := i+loc[0], i+loc[1]
+		token := s[start:end]
+		parts := strings.FieldsFunc(token, func(r rune) bool { return r < '0' || r > '9' })
+		separators := strings.Map(func(r rune) rune {
+			if r >= '0' && r <= '9' {
+				return -1
+			}
+			return r
+		}, token)
+		if len(parts) == 3 && len(separators) == 2 && separators[0] == separators[1] {
+			if year, ok := dateYear(parts); ok {
+				matches = append(matches, &Match{
+					Pattern:   patternDate,
+					I:         start,
+					J:         end - 1,
+					Token:     token,
+					Year:      year,
+					Separator: separators[:1],
+				})
+			}
+		}
+		i = start
+	}
+	return matches
+}
+
+// dateYear accepts day-month-year, month-day-year and year-month-day orders
+func dateYear(parts []string) (int, bool) {
+	n := make([]int, 3)
+	for k, p := range parts {
+		n[k], _ = strconv.Atoi(p)
+	}
+	validDayMonth := func(a, b int) bool {
+		return (a >= 1 && a <= 31 && b >= 1 && b <= 12) || (a >= 1 && a <= 12 && b >= 1 && b <= 31)
+	}
+	if len(parts[2]) != 3 && validDayMonth(n[0], n[1]) {
+		return twoDigitYear(n[2], len(parts[2])), true
+	}
+	if len(parts[0]) == 4 && validDayMonth(n[2], n[1]) {
+		return n[0], true
+	}
+	return 0, false
+}
+
+func twoDigitYear(y, digits int) int {
+	if digits > 2 {
+		return y
+	}
+	if y > 50 {
+		return 1900 + y
+	}
+	return 2000 + y
+}
+
+func reverseRunes(r []rune) []rune {
+	out := make([]rune, len(r))
+	for i, c := range r {
+		out[len(r)-1-i] = c
+	}
+	return out
+}
+
+func isAll(s, set string) bool {
+	for _, r := range s {
+		if !strings.ContainsRune(set, r) {
+			return false
+		}
+	}
+	return true
+}
+
+func abs(v int) int {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
@@ -0,0 +1,406 @@
+// Package strength estimates password strength the way zxcvbn does: the password is split into the
+// sequence of dictionary words, keyboard patterns, repeats, sequences, dates and brute forced characters
+// that is cheapest to guess, and the guess count is mapped to a score from 0 to 4.
+package strength
+
+import (
+	"math"
+	"time"
+	"unicode"
+)
+
+const (
+	// passwords are truncated before matching, longer ones only get stronger
+	maxPasswordLength = 100
+
+	bruteforceCardinality           = 10
+	minGuessesSingleChar            = 10
+	minGuessesMultiChar             = 50
+	minGuessesBeforeGrowingSequence = 10000
+	minYearSpace                    = 20
+)
+
+// Result is the strength estimate of a password
+type Result struct {
+	// Score is 0 (too guessable) to 4 (very unguessable)
+	Score        int
+	Guesses      float64
+	GuessesLog10 float64
+	// Sequence is the cheapest way to guess the password
+	Sequence []*Match
+	// Warning explains a low score, it is empty for scores above 2
+	Warning string
+}
+
+// Estimate scores password. userInputs such as the username or entry title are treated as dictionary
+// words, passwords made of them are weak
+func Estimate(password string, userInputs ...string) *Result {
+	runes := []rune(password)
+	if len(runes) > maxPasswordLength {
+		runes = runes[:maxPasswordLength]
+	}
+	r := estimate(runes, rankedUserInputs(userInputs))
+	r.Score = score(r.Guesses)
+	if r.Score <= 2 {
+		r.Warning = warning(r.Sequence)
+	}
+	return r
+}
+
+func estimate(password []rune, userInputs map[string]int) *Result {
+	matches := omnimatch(password, userInputs)
+	guesses, sequence := mostGuessableSequence(password, matches)
+	return &Result{Guesses: guesses, GuessesLog10: math.Log10(guesses), Sequence: sequence}
+}
+
+func score(guesses float64) int {
+	const delta = 5
+	switch {
+	case guesses < 1e3+delta:
+		return 0
+	case guesses < 1e6+delta:
+		return 1
+	case guesses < 1e8+delta:
+		return 2
+	case guesses < 1e10+delta:
+		return 3
+	default:
+		return 4
+	}
+}
+
+// mostGuessableSequence finds the sequence of non-overlapping matches covering the password with the
+// lowest guess count: l! * (product of match guesses) + D^(l-1) for a sequence of l matches. Gaps are
+// filled with brute force matches
+func mostGuessableSequence(password []rune, matches []*Match) (float64, []*Match) {
+	n := len(password)
+	if n == 0 {
+		return 1, nil
+	}
+
+	byEnd := make([][]*Match, n)
+	for _, m := range matches {
+		byEnd[m.J] = append(byEnd[m.J], m)
+	}
+
+	// best[k][l] is the cheapest sequence of l matches covering password[0..k]
+	type step struct {
+		m  *Match
+		pi float64
+		g  float64
+	}
+	best := make([]map[int]step, n)
+	for k := range best {
+		best[k] = make(map[int]step)
+	}
+
+	update := func(m *Match, l int) {
+		k := m.J
+		pi := matchGuesses(m, n)
+		if l > 1 {
+			pi *= best[m.I-1][l-1].pi
+		}
+		g := factorial(l)*pi + math.Pow(minGuessesBeforeGrowingSequence, float64(l-1))
+		// a shorter sequence that is at least as cheap makes this one pointless
+		for other, s := range best[k] {
+			if other <= l && s.g <= g {
+				return
+			}
+		}
+		best[k][l] = step{m: m, pi: pi, g: g}
+	}
+
+	bruteforceUpdate := func(k int) {
+		update(bruteforceMatch(password, 0, k), 1)
+		for i := 1; i <= k; i++ {
+			m := bruteforceMatch(password, i, k)
+			for l, s := range best[i-1] {
+				// adjacent brute force matches are always better merged
+				if s.m.Pattern == patternBruteforce {
+					continue
+				}
+				update(m, l+1)
+			}
+		}
+	}
+
+	for k := 0; k < n; k++ {
+		for _, m := range byEnd[k] {
+			if m.I > 0 {
+				for l := range best[m.I-1] {
+					update(m, l+1)
+				}
+			} else {
+				update(m, 1)
+			}
+		}
+		bruteforceUpdate(k)
+	}
+
+	// unwind the cheapest sequence ending at the last character
+	bestL, bestG := 0, math.Inf(1)
+	for l, s := range best[n-1] {
+		if s.g < bestG || (s.g == bestG && l < bestL) {
+			bestL, bestG = l, s.g
+		}
+	}
+	sequence := make([]*Match, bestL)
+	k := n - 1
+	for l := bestL; l > 0; l-- {
+		m := best[k][l].m
+		sequence[l-1] = m
+		k = m.I - 1
+	}
+	return bestG, sequence
+}
+
+func bruteforceMatch(password []rune, i, j int) *Match {
+	return &Match{Pattern: patternBruteforce, I: i, J: j, Token: string(password[i : j+1])}
+}
+
+// matchGuesses estimates and caches the guesses needed for m alone, matches shorter than the password
+// need at least a minimum so that a sequence of tiny matches does not look cheaper than it is
+func matchGuesses(m *Match, passwordLength int) float64 {
+	if m.Guesses > 0 {
+		return m.Guesses
+	}
+	tokenLength := m.J - m.I + 1
+	minGuesses := 1.0
+	if tokenLength < passwordLength {
+		minGuesses = minGuessesMultiChar
+		if tokenLength == 1 {
+			minGuesses = minGuessesSingleChar
+		}
+	}
+
+	var guesses float64
+	switch m.Pattern {
+	case patternBruteforce:
+		guesses = bruteforceGuesses(m)
+	case patternDictionary:
+		guesses = dictionaryGuesses(m)
+	case patternSpatial:
+		guesses = spatialGuesses(m)
+	case patternRepeat:
+		guesses = m.baseGuesses * float64(m.RepeatCount)
+	case patternSequence:
+		guesses = sequenceGuesses(m)
+	case patternDate:
+		guesses = dateGuesses(m)
+	}
+	m.Guesses = math.Max(guesses, minGuesses)
+	return m.Guesses
+}
+
+func bruteforceGuesses(m *Match) float64 {
+	length := m.J - m.I + 1
+	guesses := math.Pow(bruteforceCardinality, float64(length))
+	if math.IsInf(guesses, 1) {
+		guesses = math.MaxFloat64
+	}
+	// brute force must never beat a proper match of the same length
+	minGuesses := float64(minGuessesMultiChar + 1)
+	if length == 1 {
+		minGuesses = minGuessesSingleChar + 1
+	}
+	return math.Max(guesses, minGuesses)
+}
+
+func dictionaryGuesses(m *Match) float64 {
+	guesses := float64(m.Rank) * uppercaseVariations(m.Token) * l33tVariations(m)
+	if m.Reversed {
+		guesses *= 2
+	}
+	return guesses
+}
+
+// uppercaseVariations counts the capitalisations an attacker tries before this one, common ones such as
+// "Password" or "PASSWORD" are cheap
+func uppercaseVariations(token string) float64 {
+	var upper, lower int
+	runes := []rune(token)
+	for _, r := range runes {
+		switch {
+		case unicode.IsUpper(r):
+			upper++
+		case unicode.IsLower(r):
+			lower++
+		}
+	}
+	if upper == 0 {
+		return 1
+	}
+	firstUpper := unicode.IsUpper(runes[0])
+	lastUpper := unicode.IsUpper(runes[len(runes)-1])
+	if lower == 0 || (upper == 1 && (firstUpper || lastUpper)) {
+		return 2
+	}
+	return variations(upper, lower)
+}
+
+func l33tVariations(m *Match) float64 {
+	if !m.L33t {
+		return 1
+	}
+	total := 1.0
+	for subbed, letter := range m.sub {
+		var s, u int
+		for _, r := range []rune(m.Token) {
+			switch unicode.ToLower(r) {
+			case subbed:
+				s++
+			case letter:
+				u++
+			}
+		}
+		if s == 0 || u == 0 {
+			total *= 2
+		} else {
+			total *= variations(s, u)
+		}
+	}
+	return total
+}
+
+// variations is the sum of (a+b choose i) for i in 1..min(a, b)
+func variations(a, b int) float64 {
+	total := 0.0
+	for i := 1; i <= min(a, b); i++ {
+		total += nCk(a+b, i)
+	}
+	return total
+}
+
+func spatialGuesses(m *Match) float64 {
+	g := graphByName(m.Graph)
+	length := m.J - m.I + 1
+	guesses := 0.0
+	for i := 2; i <= length; i++ {
+		possibleTurns := min(m.Turns, i-1)
+		for j := 1; j <= possibleTurns; j++ {
+			guesses += nCk(i-1, j-1) * g.startingKeys * math.Pow(g.averageDegree, float64(j))
+		}
+	}
+	if m.ShiftedCount > 0 {
+		unshifted := length - m.ShiftedCount
+		if unshifted == 0 {
+			guesses *= 2
+		} else {
+			guesses *= variations(m.ShiftedCount, unshifted)
+		}
+	}
+	return guesses
+}
+
+func graphByName(name string) *adjacencyGraph {
+	for _, g := range keyboardGraphs {
+		if g.name == name {
+			return g
+		}
+	}
+	return keyboardGraphs[0]
+}
+
+func sequenceGuesses(m *Match) float64 {
+	var base float64
+	switch []rune(m.Token)[0] {
+	case 'a', 'A', 'z', 'Z', '0', '1', '9':
+		// obvious starting points
+		base = 4
+	default:
+		base = float64(m.sequenceSpace)
+	}
+	if !m.Ascending {
+		base *= 2
+	}
+	return base * float64(len([]rune(m.Token)))
+}
+
+func dateGuesses(m *Match) float64 {
+	yearSpace := math.Max(math.Abs(float64(m.Year-time.Now().Year())), minYearSpace)
+	if m.Separator == "" && len(m.Token) == 4 {
+		// a year on its own
+		return yearSpace
+	}
+	guesses := yearSpace * 365
+	if m.Separator != "" {
+		guesses *= 4
+	}
+	return guesses
+}
+
+func nCk(n, k int) float64 {
+	if k > n {
+		return 0
+	}
+	if k == 0 {
+		return 1
+	}
+	r := 1.0
+	for d := 1; d <= k; d++ {
+		r *= float64(n)
+		r /= float64(d)
+		n--
+	}
+	return r
+}
+
+func factorial(n int) float64 {
+	f := 1.0
+	for i := 2; i <= n; i++ {
+		f *= float64(i)
+	}
+	return f
+}
+
+// warning explains the weakest part of a low scoring password
+func warning(sequence []*Match) string {
+	if len(sequence) == 0 {
+		return "Use a few words, avoid common phrases"
+	}
+	// the longest match dominates the estimate
+	longest := sequence[0]
+	for _, m := range sequence[1:] {
+		if m.J-m.I > longest.J-longest.I {
+			longest = m
+		}
+	}
+
+	switch longest.Pattern {
+	case patternDictionary:
+		switch {
+		case longest.Dictionary == dictUserInputs:
+			return "Passwords based on the entry title or username are easy to guess"
+		case longest.Dictionary == dictPasswords && len(sequence) == 1 && !longest.L33t && !longest.Reversed:
+			if longest.Rank <= 10 {
+				return "This is a top-10 common password"
+			}
+			if longest.Rank <= 100 {
+				return "This is a top-100 common password"
+			}
+			return "This is a very common password"
+		case longest.Dictionary == dictPasswords:
+			return "This is similar to a commonly used password"
+		case longest.Dictionary == dictEnglish && len(sequence) == 1:
+			return "A word by itself is easy to guess"
+		case longest.Dictionary == dictNames || longest.Dictionary == dictSurnames:
+			return "Names and surnames are easy to guess"
+		}
+		return "Common words are easy to guess"
+	case patternSpatial:
+		if longest.Turns == 1 {
+			return "Straight rows of keys are easy to guess"
+		}
+		return "Short keyboard patterns are easy to guess"
+	case patternRepeat:
+		if len([]rune(longest.BaseToken)) == 1 {
+			return `Repeats like "aaa" are easy to guess`
+		}
+		return `Repeats like "abcabcabc" are only slightly harder to guess than "abc"`
+	case patternSequence:
+		return "Sequences like abc or 6543 are easy to guess"
+	case patternDate:
+		return "Dates and years are easy to guess"
+	}
+	return "Add another word or two, uncommon words are better"
+}
@@ -0,0 +1,157 @@
+// Package totp parses otpauth:// URIs and generates RFC 6238 time based one-time codes, including the
+// five character codes used by Steam Guard.
+package totp
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base32"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	AlgorithmSHA1   = "SHA1"
+	AlgorithmSHA256 = "SHA256"
+	AlgorithmSHA512 = "SHA512"
+
+	DefaultDigits = 6
+	DefaultPeriod = 30
+
+	steamAlphabet = "23456789BCDFGHJKMNPQRTVWXY"
+	steamDigits   = 5
+)
+
+var ErrInvalidURI = errors.New("totp: invalid otpauth URI")
+
+// Key is a parsed TOTP configuration
+type Key struct {
+	Issuer    string
+	Account   string
+	Secret    []byte
+	Algorithm string
+	Digits    int
+	Period    int
+	// Steam keys generate Steam Guard codes, Digits is ignored for them
+	Steam bool
+}
+
+// Parse accepts otpauth://totp/ URIs with the secret, issuer, algorithm, digits and period parameters,
+// and Steam keys given as steam://<secret> or as an otpauth URI with encoder=steam
+func Parse(uri string) (*Key, error) {
+	if secret, ok := strings.CutPrefix(uri, "steam://"); ok {
+		s, err := decodeSecret(secret)
+		if err != nil {
+			return nil, err
+		}
+		return &Key{Issuer: "Steam", Secret: s, Algorithm: AlgorithmSHA1, Digits: steamDigits, Period: DefaultPeriod, Steam: true}, nil
+	}
+
+	u, err := url.Parse(uri)
+	if err != nil || u.Scheme != "otpauth" {
+		return nil, ErrInvalidURI
+	}
+	if u.Host != "totp" {
+		return nil, fmt.Errorf("%w: only totp is supported", ErrInvalidURI)
+	}
+	q := u.Query()
+
+	k := &Key{Algorithm: AlgorithmSHA1, Digits: DefaultDigits, Period: DefaultPeriod, Issuer: q.Get("issuer")}
+	label := strings.TrimPrefix(u.Path, "/")
+	if issuer, account, ok := strings.Cut(label, ":"); ok {
+		if k.Issuer == "" {
+			k.Issuer = issuer
+		}
+		k.Account = strings.TrimSpace(account)
+	} else {
+		k.Account = label
+	}
+
+	if k.Secret, err = decodeSecret(q.Get("secret")); err != nil {
+		return nil, err
+	}
+	if a := q.Get("algorithm"); a != "" {
+		k.Algorithm = strings.ToUpper(a)
+		if k.Algorithm != AlgorithmSHA1 && k.Algorithm != AlgorithmSHA256 && k.Algorithm != AlgorithmSHA512 {
+			return nil, fmt.Errorf("%w: unsupported algorithm %s", ErrInvalidURI, a)
+		}
+	}
+	if d := q.Get("digits"); d != "" {
+		if k.Digits, err = strconv.Atoi(d); err != nil || (k.Digits != 6 && k.Digits != 8) {
+			return nil, fmt.Errorf("%w: digits must be 6 or 8", ErrInvalidURI)
+		}
+	}
+	if p := q.Get("period"); p != "" {
+		if k.Period, err = strconv.Atoi(p); err != nil || k.Period <= 0 || k.Period > 3600 {
+			return nil, fmt.Errorf("%w: invalid period", ErrInvalidURI)
+		}
+	}
+	if strings.EqualFold(q.Get("encoder"), "steam") {
+		k.Steam = true
+		k.Digits = steamDigits
+	}
+	return k, nil
+}
+
+func decodeSecret(secret string) ([]byte, error) {
+	secret = strings.ToUpper(strings.NewReplacer(" ", "", "-", "", "=", "").Replace(secret))
+	if secret == "" {
+		return nil, fmt.Errorf("%w: missing secret", ErrInvalidURI)
+	}
+	s, err := base32.StdEncoding.WithPadding(base32.NoPadding).DecodeString(secret)
+	if err != nil {
+		return nil, fmt.Errorf("%w: secret is not base32", ErrInvalidURI)
+	}
+	return s, nil
+}
+
+// Code returns the code valid at t and the seconds until it expires
+func (k *Key) Code(t time.Time) (string, int) {
+	unix := t.Unix()
+	counter := uint64(unix / int64(k.Period))
+	remaining := k.Period - int(unix%int64(k.Period))
+
+	var msg [8]byte
+	binary.BigEndian.PutUint64(msg[:], counter)
+	mac := hmac.New(k.hash(), k.Secret)
+	mac.Write(msg[:])
+	sum := mac.Sum(nil)
+
+	// dynamic truncation, RFC 4226 section 5.3
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	if k.Steam {
+		code := make([]byte, steamDigits)
+		for i := range code {
+			code[i] = steamAlphabet[value%uint32(len(steamAlphabet))]
+			value /= uint32(len(steamAlphabet))
+		}
+		return string(code), remaining
+	}
+
+	mod := uint32(1)
+	for i := 0; i < k.Digits; i++ {
+		mod *= 10
+	}
+	return fmt.Sprintf("%0*d", k.Digits, value%mod), remaining
+}
+
+func (k *Key) hash() func() hash.Hash {
+	switch k.Algorithm {
+	case AlgorithmSHA256:
+		return sha256.New
+	case AlgorithmSHA512:
+		return sha512.New
+	default:
+		return sha1.New
+	}
+}
@@ -0,0 +1,106 @@
+package totp
+
+import (
+	"encoding/base32"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+// seeds of RFC 6238 appendix B, one per algorithm
+var rfcSeeds = map[string]string{
+	AlgorithmSHA1:   "12345678901234567890",
+	AlgorithmSHA256: "12345678901234567890123456789012",
+	AlgorithmSHA512: "1234567890123456789012345678901234567890123456789012345678901234",
+}
+
+func TestRFC6238Vectors(t *testing.T) {
+	cases := []struct {
+		unix      int64
+		algorithm string
+		code      string
+	}{
+		{59, AlgorithmSHA1, "94287082"},
+		{59, AlgorithmSHA256, "46119246"},
+		{59, AlgorithmSHA512, "90693936"},
+		{1111111109, AlgorithmSHA1, "07081804"},
+		{1111111109, AlgorithmSHA256, "68084774"},
+		{1111111109, AlgorithmSHA512, "25091201"},
+		{1111111111, AlgorithmSHA1, "14050471"},
+		{1111111111, AlgorithmSHA256, "67062674"},
+		{1111111111, AlgorithmSHA512, "99943326"},
+		{1234567890, AlgorithmSHA1, "89005924"},
+		{1234567890, AlgorithmSHA256, "91819424"},
+		{1234567890, AlgorithmSHA512, "93441116"},
+		{2000000000, AlgorithmSHA1, "69279037"},
+		{2000000000, AlgorithmSHA256, "90698825"},
+		{2000000000, AlgorithmSHA512, "38618901"},
+		{20000000000, AlgorithmSHA1, "65353130"},
+		{20000000000, AlgorithmSHA256, "77737706"},
+		{20000000000, AlgorithmSHA512, "47863826"},
+	}
+	for _, c := range cases {
+		// the vectors go through Parse so the otpauth parameters are covered as well
+		secret := base32.StdEncoding.EncodeToString([]byte(rfcSeeds[c.algorithm]))
+		k, err := Parse("otpauth://totp/Example:alice?secret=" + secret + "&algorithm=" + c.algorithm + "&digits=8&period=30")
+		if err != nil {
+			t.Fatalf("Parse: %v", err)
+		}
+		code, remaining := k.Code(time.Unix(c.unix, 0))
+		if code != c.code {
+			t.Errorf("%s at %d = %s, want %s", c.algorithm, c.unix, code, c.code)
+		}
+		if want := 30 - int(c.unix%30); remaining != want {
+			t.Errorf("%s at %d: %d seconds remaining, want %d", c.algorithm, c.unix, remaining, want)
+		}
+	}
+}
+
+func TestSixDigits(t *testing.T) {
+	k := &Key{Secret: []byte(rfcSeeds[AlgorithmSHA1]), Algorithm: AlgorithmSHA1, Digits: 6, Period: 30}
+	// the last six digits of the eight digit vectors
+	if code, _ := k.Code(time.Unix(1111111109, 0)); code != "081804" {
+		t.Errorf("code = %s, want 081804", code)
+	}
+}
+
+func TestSteam(t *testing.T) {
+	secret := base32.StdEncoding.EncodeToString([]byte(rfcSeeds[AlgorithmSHA1]))
+	for _, uri := range []string{"steam://" + secret, "otpauth://totp/Steam:alice?secret=" + secret + "&encoder=steam"} {
+		k, err := Parse(uri)
+		if err != nil {
+			t.Fatalf("Parse(%q): %v", uri, err)
+		}
+		code, _ := k.Code(time.Unix(1111111109, 0))
+		if len(code) != steamDigits || strings.Trim(code, steamAlphabet) != "" {
+			t.Errorf("Parse(%q) code %q is not a Steam Guard code", uri, code)
+		}
+	}
+}
+
+func TestParse(t *testing.T) {
+	k, err := Parse("otpauth://totp/ACME%20Co:john@example.com?secret=JBSWY3DPEHPK3PXP&issuer=ACME%20Co")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if k.Issuer != "ACME Co" || k.Account != "john@example.com" || k.Algorithm != AlgorithmSHA1 ||
+		k.Digits != DefaultDigits || k.Period != DefaultPeriod || k.Steam {
+		t.Errorf("unexpected key %+v", k)
+	}
+
+	invalid := []string{
+		"https://example.com/?secret=JBSWY3DPEHPK3PXP",
+		"otpauth://hotp/x?secret=JBSWY3DPEHPK3PXP",
+		"otpauth://totp/x",
+		"otpauth://totp/x?secret=not-base32!",
+		"otpauth://totp/x?secret=JBSWY3DPEHPK3PXP&algorithm=MD5",
+		"otpauth://totp/x?secret=JBSWY3DPEHPK3PXP&digits=7",
+		"otpauth://totp/x?secret=JBSWY3DPEHPK3PXP&period=0",
+	}
+	for _, uri := range invalid {
+		if _, err := Parse(uri); !errors.Is(err, ErrInvalidURI) {
+			t.Errorf("Parse(%q) = %v, want ErrInvalidURI", uri, err)
+		}
+	}
+}
@@ -0,0 +1,161 @@
+// Package urimatch decides which entry URIs match a URL for autofill. Base domains are derived with the
+// public suffix list compiled into golang.org/x/net/publicsuffix, so the server never fetches it.
+package urimatch
+
+import (
+	"errors"
+	"fmt"
+	"golang.org/x/net/publicsuffix"
+	"net"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// Match strategies as stored with entry URIs
+const (
+	// BaseDomain matches URLs whose registrable domain equals the URI's, e.g. login.bank.com and bank.com
+	BaseDomain = "base_domain"
+	// Host matches URLs with the same host and port, a missing port is the default port of the scheme. A
+	// host name without scheme and port matches the host on the default port of any scheme
+	Host = "host"
+	// StartsWith matches URLs on the same host that start with the URI
+	StartsWith = "starts_with"
+	// Regex matches URLs matching the URI as a regular expression
+	Regex = "regex"
+	// Never keeps a URI on the entry without ever matching it
+	Never = "never"
+
+	MaxURILen = 2048
+)
+
+var ErrInvalidURL = errors.New("invalid url")
+
+// ParseURL parses an absolute URL, a missing scheme defaults to https so "bank.com/login" is accepted
+func ParseURL(raw string) (*url.URL, error) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" || len(raw) > MaxURILen {
+		return nil, ErrInvalidURL
+	}
+	if !strings.Contains(raw, "://") {
+		raw = "https://" + raw
+	}
+	u, err := url.Parse(raw)
+	if err != nil || u.Hostname() == "" {
+		return nil, ErrInvalidURL
+	}
+	return u, nil
+}
+
+// Hostname returns the lower case host of u without port and trailing dot
+func Hostname(u *url.URL) string {
+	return strings.TrimSuffix(strings.ToLower(u.Hostname()), ".")
+}
+
+// RegistrableDomain returns the public suffix of host plus one label, e.g. bank.co.uk for
+// login.bank.co.uk. IP addresses and hosts that are a public suffix themselves, such as localhost,
+// are returned unchanged
+func RegistrableDomain(host string) string {
+	if net.ParseIP(host) != nil {
+		return host
+	}
+	domain, err := publicsuffix.EffectiveTLDPlusOne(host)
+	if err != nil {
+		return host
+	}
+	return domain
+}
+
+// Domain returns the registrable domain of a URL or host name, e.g. bank.com for https://login.bank.com/
+func Domain(s string) (string, error) {
+	u, err := ParseURL(s)
+	if err != nil {
+		return "", err
+	}
+	return RegistrableDomain(Hostname(u)), nil
+}
+
+// Validate checks a URI for its strategy and returns the host URLs it can match must be in, empty for
+// regular expressions and Never
+func Validate(uri string, strategy string) (string, error) {
+	switch strategy {
+	case Regex:
+		if len(uri) > MaxURILen {
+			return "", fmt.Errorf("uri must be at most %d bytes", MaxURILen)
+		}
+		if _, err := regexp.Compile(uri); err != nil {
+			return "", fmt.Errorf("invalid regular expression %q: %v", uri, err)
+		}
+		return "", nil
+	case Never:
+		if len(uri) > MaxURILen {
+			return "", fmt.Errorf("uri must be at most %d bytes", MaxURILen)
+		}
+		return "", nil
+	case StartsWith:
+		// without a scheme the prefix would never match, so it must be given explicitly
+		if !strings.Contains(uri, "://") {
+			return "", fmt.Errorf("starts_with uri %q must include a scheme", uri)
+		}
+	case BaseDomain, Host:
+	default:
+		return "", fmt.Errorf("unknown match strategy %q", strategy)
+	}
+	u, err := ParseURL(uri)
+	if err != nil {
+		return "", fmt.Errorf("invalid uri %q", uri)
+	}
+	return Hostname(u), nil
+}
+
+// Matches reports whether a URI stored with strategy matches target. URIs that fail to parse never match
+func Matches(uri string, strategy string, target *url.URL) bool {
+	switch strategy {
+	case Regex:
+		re, err := regexp.Compile(uri)
+		return err == nil && re.MatchString(target.String())
+	case Never:
+		return false
+	}
+	u, err := ParseURL(uri)
+	if err != nil {
+		return false
+	}
+	host, targetHost := Hostname(u), Hostname(target)
+	switch strategy {
+	case BaseDomain:
+		return RegistrableDomain(host) == RegistrableDomain(targetHost)
+	case Host:
+		if host != targetHost {
+			return false
+		}
+		if !strings.Contains(uri, "://") && u.Port() == "" {
+			// a bare host name stands for the default port of any scheme
+			return port(target) == defaultPort(target.Scheme)
+		}
+		return port(u) == port(target)
+	case StartsWith:
+		// the host check keeps https://bank.com from matching https://bank.com.evil.example
+		return host == targetHost && strings.HasPrefix(target.String(), strings.TrimSpace(uri))
+	}
+	return false
+}
+
+// port returns the port of u, the default port of its scheme when none is given
+func port(u *url.URL) string {
+	if p := u.Port(); p != "" {
+		return p
+	}
+	return defaultPort(u.Scheme)
+}
+
+// defaultPort returns the port of scheme, empty for schemes without one such as androidapp
+func defaultPort(scheme string) string {
+	switch strings.ToLower(scheme) {
+	case "https":
+		return "443"
+	case "http":
+		return "80"
+	}
+	return ""
+}
@@ -0,0 +1,120 @@
+package urimatch
+
+import "testing"
+
+func TestMatches(t *testing.T) {
+	cases := []struct {
+		name     string
+		uri      string
+		strategy string
+		target   string
+		want     bool
+	}{
+		{"base domain itself", "bank.com", BaseDomain, "https://bank.com/", true},
+		{"base domain subdomain", "bank.com", BaseDomain, "https://login.bank.com/signin", true},
+		{"base domain from subdomain", "https://www.bank.com/", BaseDomain, "https://login.bank.com/", true},
+		{"base domain ignores scheme and port", "https://bank.com", BaseDomain, "http://bank.com:8080/", true},
+		{"base domain ignores case and trailing dot", "Bank.COM", BaseDomain, "https://LOGIN.bank.com./", true},
+		{"base domain lookalike prefix", "bank.com", BaseDomain, "https://notbank.com/", false},
+		{"base domain lookalike suffix", "bank.com", BaseDomain, "https://bank.com.evil/", false},
+		{"base domain lookalike subdomain", "bank.com", BaseDomain, "https://bank.com.evil.net/", false},
+		{"base domain co.uk", "a.bank.co.uk", BaseDomain, "https://b.bank.co.uk/", true},
+		{"base domain co.uk other site", "bank.co.uk", BaseDomain, "https://other.co.uk/", false},
+		{"base domain github.io sites differ", "foo.github.io", BaseDomain, "https://bar.github.io/", false},
+		{"base domain github.io same site", "foo.github.io", BaseDomain, "https://foo.github.io/repo", true},
+		{"base domain github.io suffix itself", "github.io", BaseDomain, "https://foo.github.io/", false},
+		{"base domain ipv4", "192.168.1.1", BaseDomain, "http://192.168.1.1/admin", true},
+		{"base domain ipv4 differs", "192.168.1.1", BaseDomain, "http://192.168.1.10/", false},
+		{"base domain ipv6", "https://[::1]:8443/", BaseDomain, "http://[::1]/", true},
+		{"base domain localhost", "localhost:8080", BaseDomain, "http://localhost:3000/", true},
+
+		{"host exact", "https://login.bank.com", Host, "https://login.bank.com/x", true},
+		{"host subdomain", "bank.com", Host, "https://www.bank.com/", false},
+		{"host parent", "login.bank.com", Host, "https://bank.com/", false},
+		{"host explicit port", "bank.com:8443", Host, "https://bank.com:8443/a", true},
+		{"host other port", "bank.com:8443", Host, "https://bank.com/", false},
+		{"host default https port", "https://bank.com", Host, "https://bank.com:443/", true},
+		{"host default http port", "http://bank.com:80", Host, "http://bank.com/", true},
+		{"host other scheme default port", "https://bank.com", Host, "http://bank.com/", false},
+		{"host bare name any scheme", "bank.com", Host, "http://bank.com/", true},
+		{"host bare name other port", "bank.com", Host, "https://bank.com:8443/", false},
+		{"host ipv4 port", "10.0.0.1:9000", Host, "http://10.0.0.1:9000/", true},
+
+		{"starts with", "https://bank.com/login", StartsWith, "https://bank.com/login?next=/", true},
+		{"starts with other path", "https://bank.com/login", StartsWith, "https://bank.com/logout", false},
+		{"starts with lookalike host", "https://bank.com", StartsWith, "https://bank.com.evil.net/", false},
+		{"starts with other host", "https://bank.com/login", StartsWith, "https://evil.net/https://bank.com/login", false},
+		{"starts with subdomain", "https://bank.com/", StartsWith, "https://www.bank.com/", false},
+		{"starts with other scheme", "https://bank.com/", StartsWith, "http://bank.com/", false},
+
+		{"regex", `^https://([a-z]+\.)?bank\.com/`, Regex, "https://x.bank.com/a", true},
+		{"regex anchored", `^https://bank\.com/`, Regex, "https://evil.net/?https://bank.com/", false},
+		{"regex unanchored", `bank\.com`, Regex, "https://evil.net/?bank.com", true},
+
+		{"never", "bank.com", Never, "https://bank.com/", false},
+		{"unknown strategy", "bank.com", "fuzzy", "https://bank.com/", false},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			target, err := ParseURL(c.target)
+			if err != nil {
+				t.Fatalf("ParseURL(%q): %v", c.target, err)
+			}
+			if got := Matches(c.uri, c.strategy, target); got != c.want {
+				t.Fatalf("Matches(%q, %s, %q) = %t, want %t", c.uri, c.strategy, c.target, got, c.want)
+			}
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	cases := []struct {
+		uri      string
+		strategy string
+		host     string
+		ok       bool
+	}{
+		{"bank.com", BaseDomain, "bank.com", true},
+		{"https://Login.Bank.com:8443/path", Host, "login.bank.com", true},
+		{"androidapp://com.bank.app", Host, "com.bank.app", true},
+		{"https://bank.com/login", StartsWith, "bank.com", true},
+		{"bank.com/login", StartsWith, "", false},
+		{`^https://bank\.com/`, Regex, "", true},
+		{"(", Regex, "", false},
+		{"anything", Never, "", true},
+		{"", BaseDomain, "", false},
+		{"https://", Host, "", false},
+		{"bank.com", "fuzzy", "", false},
+	}
+	for _, c := range cases {
+		host, err := Validate(c.uri, c.strategy)
+		if (err == nil) != c.ok {
+			t.Errorf("Validate(%q, %s) error = %v, want ok %t", c.uri, c.strategy, err, c.ok)
+			continue
+		}
+		if host != c.host {
+			t.Errorf("Validate(%q, %s) host = %q, want %q", c.uri, c.strategy, host, c.host)
+		}
+	}
+}
+
+func TestDomain(t *testing.T) {
+	cases := map[string]string{
+		"bank.com":                    "bank.com",
+		"https://login.bank.com/x":    "bank.com",
+		"www.bank.co.uk":              "bank.co.uk",
+		"https://foo.github.io/":      "foo.github.io",
+		"http://192.168.1.1:8080/":    "192.168.1.1",
+		"localhost":                   "localhost",
+		"HTTPS://Login.Bank.COM./?q=": "bank.com",
+	}
+	for in, want := range cases {
+		got, err := Domain(in)
+		if err != nil || got != want {
+			t.Errorf("Domain(%q) = %q, %v, want %q", in, got, err, want)
+		}
+	}
+	if _, err := Domain(""); err == nil {
+		t.Error("Domain of an empty string succeeded")
+	}
+}
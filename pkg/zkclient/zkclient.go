@@ -0,0 +1,177 @@
+// Package zkclient is the reference client side implementation of the zero-knowledge mode.
+//
+// The client fetches its Argon2id parameters with VaultUserService.GetKdfParams, derives the vault key
+// from the master password with DeriveKey and seals secrets before they are sent in a VaultEntry.
+// The server stores the resulting blobs as they are and never sees the key or the plaintext.
+package zkclient
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/AleksZelenchuk/vault-server/gen/go/vaultpb"
+	"github.com/AleksZelenchuk/vault-server/gen/go/vaultuserpb"
+	"github.com/AleksZelenchuk/vault-server/pkg/entrytype"
+	"golang.org/x/crypto/argon2"
+)
+
+// Algorithm is the only key derivation algorithm supported by the server
+const Algorithm = "argon2id"
+
+// blobPrefix versions the sealed format: "zk1:" + base64(nonce || AES-256-GCM ciphertext)
+const blobPrefix = "zk1:"
+
+const nonceSize = 12
+
+var ErrInvalidBlob = errors.New("zkclient: invalid sealed blob")
+
+// Params are the Argon2id parameters of a user
+type Params struct {
+	Salt      []byte
+	Time      uint32
+	MemoryKiB uint32
+	Threads   uint8
+	KeyLength uint32
+}
+
+// ParamsFromProto converts a GetKdfParams response
+func ParamsFromProto(p *vaultuserpb.GetKdfParamsResponse) (Params, error) {
+	if p.Algorithm != Algorithm {
+		return Params{}, fmt.Errorf("zkclient: unsupported kdf algorithm %q", p.Algorithm)
+	}
+	if len(p.Salt) == 0 || p.Time == 0 || p.MemoryKib == 0 || p.Threads == 0 || p.Threads > 255 {
+		return Params{}, errors.New("zkclient: invalid kdf parameters")
+	}
+	if p.KeyLength != 32 {
+		return Params{}, fmt.Errorf("zkclient: unsupported key length %d", p.KeyLength)
+	}
+	return Params{
+		Salt:      p.Salt,
+		Time:      p.Time,
+		MemoryKiB: p.MemoryKib,
+		Threads:   uint8(p.Threads),
+		KeyLength: p.KeyLength,
+	}, nil
+}
+
+// Key is a derived vault key
+type Key struct {
+	aead cipher.AEAD
+}
+
+// DeriveKey derives the vault key from the master password
+func DeriveKey(masterPassword string, p Params) (*Key, error) {
+	raw := argon2.IDKey([]byte(masterPassword), p.Salt, p.Time, p.MemoryKiB, p.Threads, p.KeyLength)
+	return NewKey(raw)
+}
+
+// NewKey wraps a raw 32-byte key, e.g. one restored from the OS keychain
+func NewKey(raw []byte) (*Key, error) {
+	if len(raw) != 32 {
+		return nil, errors.New("zkclient: key must be 32 bytes")
+	}
+	block, err := aes.NewCipher(raw)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	return &Key{aead: aead}, nil
+}
+
+// Seal encrypts plaintext into a blob that can be stored in a VaultEntry string field
+func (k *Key) Seal(plaintext []byte) (string, error) {
+	nonce := make([]byte, nonceSize)
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return "", err
+	}
+	sealed := k.aead.Seal(nonce, nonce, plaintext, nil)
+	return blobPrefix + base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// Open decrypts a blob produced by Seal
+func (k *Key) Open(blob string) ([]byte, error) {
+	raw, err := decodeBlob(blob)
+	if err != nil {
+		return nil, err
+	}
+	return k.aead.Open(nil, raw[:nonceSize], raw[nonceSize:], nil)
+}
+
+// SealEntry encrypts the secret fields of e in place and marks it as client encrypted
+func (k *Key) SealEntry(e *vaultpb.VaultEntry) error {
+	for _, field := range secretFields(e) {
+		if *field == "" {
+			continue
+		}
+		sealed, err := k.Seal([]byte(*field))
+		if err != nil {
+			return err
+		}
+		*field = sealed
+	}
+	e.ClientEncrypted = true
+	return nil
+}
+
+// OpenEntry decrypts the secret fields of a client encrypted entry in place. Secrets are empty when the
+// entry comes from a listing, they are then left as is
+func (k *Key) OpenEntry(e *vaultpb.VaultEntry) error {
+	if !e.ClientEncrypted {
+		return nil
+	}
+	for _, field := range secretFields(e) {
+		if *field == "" {
+			continue
+		}
+		plain, err := k.Open(*field)
+		if err != nil {
+			return err
+		}
+		*field = string(plain)
+	}
+	e.ClientEncrypted = false
+	return nil
+}
+
+// secretFields returns the fields of e sealed by the client: password, notes, the TOTP URI, the secret
+// fields of the typed payload and hidden custom field values
+func secretFields(e *vaultpb.VaultEntry) []*string {
+	fields := []*string{&e.Password, &e.Notes}
+	if e.Totp != nil {
+		fields = append(fields, e.Totp)
+	}
+	for _, f := range entrytype.PayloadSecrets(e) {
+		fields = append(fields, f)
+	}
+	for _, f := range entrytype.HiddenFields(e) {
+		fields = append(fields, f)
+	}
+	return fields
+}
+
+// IsSealed reports whether s is a well-formed sealed blob, the server uses it to reject plaintext
+// sent for client encrypted entries by mistake
+func IsSealed(s string) bool {
+	_, err := decodeBlob(s)
+	return err == nil
+}
+
+func decodeBlob(blob string) ([]byte, error) {
+	if !strings.HasPrefix(blob, blobPrefix) {
+		return nil, ErrInvalidBlob
+	}
+	raw, err := base64.StdEncoding.DecodeString(blob[len(blobPrefix):])
+	if err != nil || len(raw) < nonceSize+16 {
+		return nil, ErrInvalidBlob
+	}
+	return raw, nil
+}
@@ -0,0 +1,14 @@
+CREATE EXTENSION IF NOT EXISTS "uuid-ossp";
+
+CREATE TABLE vault_emergency_contacts (
+                               id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+                               grantor_id UUID NOT NULL REFERENCES vault_users (id) ON DELETE CASCADE,
+                               grantee_id UUID NOT NULL REFERENCES vault_users (id) ON DELETE CASCADE,
+                               access_level TEXT NOT NULL DEFAULT 'view',
+                               wait_period_seconds BIGINT NOT NULL,
+                               status TEXT NOT NULL DEFAULT 'idle',
+                               requested_at TIMESTAMP WITH TIME ZONE,
+                               created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+                               updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+                               UNIQUE (grantor_id, grantee_id)
+);
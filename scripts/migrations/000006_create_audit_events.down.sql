@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS audit_events;
+
+ALTER TABLE vault_users
+    DROP COLUMN IF EXISTS is_admin;
@@ -0,0 +1,18 @@
+CREATE EXTENSION IF NOT EXISTS "uuid-ossp";
+
+ALTER TABLE vault_users
+    ADD COLUMN IF NOT EXISTS is_admin BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE TABLE audit_events (
+                               id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+                               actor_id TEXT,
+                               owner_id TEXT,
+                               action TEXT NOT NULL,
+                               entry_id TEXT,
+                               peer TEXT,
+                               outcome TEXT NOT NULL,
+                               created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX audit_events_actor_idx ON audit_events (actor_id, created_at);
+CREATE INDEX audit_events_owner_idx ON audit_events (owner_id, created_at);
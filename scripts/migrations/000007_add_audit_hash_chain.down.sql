@@ -0,0 +1,8 @@
+DROP TABLE IF EXISTS audit_checkpoints;
+
+DROP INDEX IF EXISTS audit_events_seq_idx;
+
+ALTER TABLE audit_events
+    DROP COLUMN IF EXISTS seq,
+    DROP COLUMN IF EXISTS prev_hash,
+    DROP COLUMN IF EXISTS hash;
@@ -0,0 +1,20 @@
+ALTER TABLE audit_events
+    ADD COLUMN IF NOT EXISTS seq BIGINT,
+    ADD COLUMN IF NOT EXISTS prev_hash BYTEA,
+    ADD COLUMN IF NOT EXISTS hash BYTEA;
+
+-- events recorded before chaining keep a NULL hash and are reported as unchained by verify-audit
+UPDATE audit_events a SET seq = o.seq
+FROM (SELECT id, row_number() OVER (ORDER BY created_at, id) AS seq FROM audit_events) o
+WHERE a.id = o.id;
+
+ALTER TABLE audit_events
+    ALTER COLUMN seq SET NOT NULL;
+
+CREATE UNIQUE INDEX audit_events_seq_idx ON audit_events (seq);
+
+CREATE TABLE audit_checkpoints (
+                               seq BIGINT PRIMARY KEY,
+                               hash BYTEA NOT NULL,
+                               created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
@@ -0,0 +1,8 @@
+ALTER TABLE vault_entries
+    DROP COLUMN IF EXISTS client_encrypted;
+
+ALTER TABLE vault_users
+    DROP COLUMN IF EXISTS kdf_salt,
+    DROP COLUMN IF EXISTS kdf_time,
+    DROP COLUMN IF EXISTS kdf_memory,
+    DROP COLUMN IF EXISTS kdf_threads;
@@ -0,0 +1,8 @@
+ALTER TABLE vault_users
+    ADD COLUMN IF NOT EXISTS kdf_salt BYTEA,
+    ADD COLUMN IF NOT EXISTS kdf_time INTEGER,
+    ADD COLUMN IF NOT EXISTS kdf_memory INTEGER,
+    ADD COLUMN IF NOT EXISTS kdf_threads INTEGER;
+
+ALTER TABLE vault_entries
+    ADD COLUMN IF NOT EXISTS client_encrypted BOOLEAN NOT NULL DEFAULT FALSE;
@@ -0,0 +1,8 @@
+DELETE FROM vault_users WHERE password IS NULL;
+
+ALTER TABLE vault_users
+    ALTER COLUMN password SET NOT NULL;
+
+ALTER TABLE vault_users
+    DROP COLUMN IF EXISTS srp_salt,
+    DROP COLUMN IF EXISTS srp_verifier;
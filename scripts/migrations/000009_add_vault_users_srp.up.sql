@@ -0,0 +1,7 @@
+ALTER TABLE vault_users
+    ADD COLUMN IF NOT EXISTS srp_salt BYTEA,
+    ADD COLUMN IF NOT EXISTS srp_verifier BYTEA;
+
+-- users registered with SRP have no password hash
+ALTER TABLE vault_users
+    ALTER COLUMN password DROP NOT NULL;
@@ -0,0 +1,2 @@
+ALTER TABLE vault_users
+    DROP COLUMN IF EXISTS password_encrypted;
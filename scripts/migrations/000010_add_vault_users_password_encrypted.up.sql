@@ -0,0 +1,5 @@
+-- password hashes used to be encrypted with the master key, new PHC hashes are stored as they are
+ALTER TABLE vault_users
+    ADD COLUMN IF NOT EXISTS password_encrypted BOOLEAN NOT NULL DEFAULT FALSE;
+
+UPDATE vault_users SET password_encrypted = TRUE WHERE password IS NOT NULL;
@@ -0,0 +1,19 @@
+CREATE EXTENSION IF NOT EXISTS "uuid-ossp";
+
+-- a row without user_id is the organization wide policy. A user's own row is merged into it taking the
+-- stricter value of every field, so it can only tighten the organization policy, never relax it
+CREATE TABLE vault_password_policies (
+                               id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+                               user_id UUID REFERENCES vault_users (id) ON DELETE CASCADE,
+                               min_length INTEGER NOT NULL DEFAULT 0,
+                               require_lowercase BOOLEAN NOT NULL DEFAULT FALSE,
+                               require_uppercase BOOLEAN NOT NULL DEFAULT FALSE,
+                               require_digits BOOLEAN NOT NULL DEFAULT FALSE,
+                               require_symbols BOOLEAN NOT NULL DEFAULT FALSE,
+                               min_words INTEGER NOT NULL DEFAULT 0,
+                               created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+                               updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX vault_password_policies_user_id_idx ON vault_password_policies (user_id);
+CREATE UNIQUE INDEX vault_password_policies_organization_idx ON vault_password_policies ((user_id IS NULL)) WHERE user_id IS NULL;
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS vault_entries_password_fingerprint_idx;
+
+ALTER TABLE vault_entries
+    DROP COLUMN IF EXISTS password_fingerprint;
@@ -0,0 +1,4 @@
+ALTER TABLE vault_entries
+    ADD COLUMN IF NOT EXISTS password_fingerprint BYTEA;
+
+CREATE INDEX IF NOT EXISTS vault_entries_password_fingerprint_idx ON vault_entries (user_id, password_fingerprint);
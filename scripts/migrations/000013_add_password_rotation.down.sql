@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS vault_folder_rotation;
+
+ALTER TABLE vault_entries
+    DROP COLUMN IF EXISTS password_changed_at,
+    DROP COLUMN IF EXISTS rotation_interval_days,
+    DROP COLUMN IF EXISTS rotation_notified_at;
@@ -0,0 +1,18 @@
+CREATE EXTENSION IF NOT EXISTS "uuid-ossp";
+
+ALTER TABLE vault_entries
+    ADD COLUMN IF NOT EXISTS password_changed_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    ADD COLUMN IF NOT EXISTS rotation_interval_days INTEGER,
+    ADD COLUMN IF NOT EXISTS rotation_notified_at TIMESTAMP WITH TIME ZONE;
+
+-- the best guess for existing entries is their last modification
+UPDATE vault_entries SET password_changed_at = updated_at;
+
+CREATE TABLE vault_folder_rotation (
+                               user_id UUID NOT NULL REFERENCES vault_users (id) ON DELETE CASCADE,
+                               folder TEXT NOT NULL,
+                               rotation_interval_days INTEGER NOT NULL,
+                               created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+                               updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+                               PRIMARY KEY (user_id, folder)
+);
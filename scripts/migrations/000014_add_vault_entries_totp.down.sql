@@ -0,0 +1,2 @@
+ALTER TABLE vault_entries
+    DROP COLUMN IF EXISTS totp;
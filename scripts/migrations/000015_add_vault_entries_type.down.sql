@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS vault_entries_type_idx;
+
+ALTER TABLE vault_entries
+    DROP COLUMN IF EXISTS payload,
+    DROP COLUMN IF EXISTS type;
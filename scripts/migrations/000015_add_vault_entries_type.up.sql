@@ -0,0 +1,5 @@
+ALTER TABLE vault_entries
+    ADD COLUMN IF NOT EXISTS type TEXT NOT NULL DEFAULT 'login',
+    ADD COLUMN IF NOT EXISTS payload BYTEA;
+
+CREATE INDEX IF NOT EXISTS vault_entries_type_idx ON vault_entries (user_id, type);
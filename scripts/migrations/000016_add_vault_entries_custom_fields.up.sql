@@ -0,0 +1,2 @@
+ALTER TABLE vault_entries
+    ADD COLUMN IF NOT EXISTS custom_fields JSONB NOT NULL DEFAULT '[]';
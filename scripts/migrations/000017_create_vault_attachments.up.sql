@@ -0,0 +1,18 @@
+CREATE EXTENSION IF NOT EXISTS "uuid-ossp";
+
+-- content lives in the configured blob store, encryption_key is the attachment's own key wrapped with the master key
+CREATE TABLE vault_attachments (
+                               id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+                               entry_id UUID NOT NULL REFERENCES vault_entries (id) ON DELETE CASCADE,
+                               user_id UUID NOT NULL REFERENCES vault_users (id) ON DELETE CASCADE,
+                               file_name TEXT NOT NULL,
+                               content_type TEXT NOT NULL DEFAULT '',
+                               size BIGINT NOT NULL,
+                               backend TEXT NOT NULL,
+                               blob_ref TEXT NOT NULL,
+                               encryption_key BYTEA NOT NULL,
+                               created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX vault_attachments_entry_id_idx ON vault_attachments (entry_id);
+CREATE INDEX vault_attachments_user_id_idx ON vault_attachments (user_id);
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS vault_entries_search_idx;
+
+DROP FUNCTION IF EXISTS vault_entry_search_vector(TEXT, TEXT, TEXT, TEXT[], TEXT);
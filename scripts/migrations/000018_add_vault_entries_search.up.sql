@@ -0,0 +1,15 @@
+-- vault_entry_search_vector weighs the searchable metadata of an entry: title A, username B, domain and
+-- tags C, folder D. Search queries must use the same expression to hit the index
+CREATE OR REPLACE FUNCTION vault_entry_search_vector(title TEXT, username TEXT, domain TEXT, tags TEXT[], folder TEXT)
+    RETURNS tsvector
+    LANGUAGE sql
+    IMMUTABLE PARALLEL SAFE
+AS $$
+    SELECT setweight(to_tsvector('simple', coalesce(title, '')), 'A') ||
+           setweight(to_tsvector('simple', coalesce(username, '')), 'B') ||
+           setweight(to_tsvector('simple', coalesce(domain, '') || ' ' || coalesce(array_to_string(tags, ' '), '')), 'C') ||
+           setweight(to_tsvector('simple', coalesce(folder, '')), 'D')
+$$;
+
+CREATE INDEX IF NOT EXISTS vault_entries_search_idx ON vault_entries
+    USING GIN (vault_entry_search_vector(title, username, domain, tags, folder));
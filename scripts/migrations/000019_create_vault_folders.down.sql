@@ -0,0 +1,43 @@
+ALTER TABLE vault_entries
+    ADD COLUMN IF NOT EXISTS folder TEXT;
+
+UPDATE vault_entries SET folder = vault_folder_path(folder_id) WHERE folder_id IS NOT NULL;
+
+CREATE TABLE vault_folder_rotation (
+                               user_id UUID NOT NULL REFERENCES vault_users (id) ON DELETE CASCADE,
+                               folder TEXT NOT NULL,
+                               rotation_interval_days INTEGER NOT NULL,
+                               created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+                               updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+                               PRIMARY KEY (user_id, folder)
+);
+
+INSERT INTO vault_folder_rotation (user_id, folder, rotation_interval_days)
+SELECT user_id, vault_folder_path(id), rotation_interval_days FROM vault_folders WHERE rotation_interval_days IS NOT NULL;
+
+DROP INDEX IF EXISTS vault_entries_search_idx;
+DROP FUNCTION IF EXISTS vault_entry_search_vector(TEXT, TEXT, TEXT, TEXT[]);
+
+CREATE OR REPLACE FUNCTION vault_entry_search_vector(title TEXT, username TEXT, domain TEXT, tags TEXT[], folder TEXT)
+    RETURNS tsvector
+    LANGUAGE sql
+    IMMUTABLE PARALLEL SAFE
+AS $$
+    SELECT setweight(to_tsvector('simple', coalesce(title, '')), 'A') ||
+           setweight(to_tsvector('simple', coalesce(username, '')), 'B') ||
+           setweight(to_tsvector('simple', coalesce(domain, '') || ' ' || coalesce(array_to_string(tags, ' '), '')), 'C') ||
+           setweight(to_tsvector('simple', coalesce(folder, '')), 'D')
+$$;
+
+CREATE INDEX IF NOT EXISTS vault_entries_search_idx ON vault_entries
+    USING GIN (vault_entry_search_vector(title, username, domain, tags, folder));
+
+DROP FUNCTION IF EXISTS vault_folder_rotation_days(UUID);
+DROP FUNCTION IF EXISTS vault_folder_path(UUID);
+
+DROP INDEX IF EXISTS vault_entries_folder_id_idx;
+
+ALTER TABLE vault_entries
+    DROP COLUMN IF EXISTS folder_id;
+
+DROP TABLE IF EXISTS vault_folders;
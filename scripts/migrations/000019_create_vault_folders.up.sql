@@ -0,0 +1,124 @@
+CREATE EXTENSION IF NOT EXISTS "uuid-ossp";
+
+-- folders form a tree per user, deleting a folder deletes its subfolders
+CREATE TABLE vault_folders (
+                               id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+                               user_id UUID NOT NULL REFERENCES vault_users (id) ON DELETE CASCADE,
+                               parent_id UUID REFERENCES vault_folders (id) ON DELETE CASCADE,
+                               name TEXT NOT NULL,
+                               rotation_interval_days INTEGER,
+                               created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+                               updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+-- names are unique among siblings, top level folders share the nil parent
+CREATE UNIQUE INDEX vault_folders_name_idx ON vault_folders (user_id, COALESCE(parent_id, '00000000-0000-0000-0000-000000000000'), name);
+CREATE INDEX vault_folders_parent_id_idx ON vault_folders (parent_id);
+
+ALTER TABLE vault_entries
+    ADD COLUMN IF NOT EXISTS folder_id UUID REFERENCES vault_folders (id) ON DELETE SET NULL;
+
+-- every folder path in use is split on '/' into a chain of nested folders, empty names are skipped so
+-- "Work//Projects/" becomes Work > Projects. Entries and rotation intervals attach to the last folder
+CREATE TEMPORARY TABLE vault_folder_migration (
+                               user_id UUID NOT NULL,
+                               folder TEXT NOT NULL,
+                               folder_id UUID NOT NULL
+);
+
+DO $$
+DECLARE
+    legacy RECORD;
+    segment TEXT;
+    parent UUID;
+    child UUID;
+BEGIN
+    FOR legacy IN
+        SELECT n.user_id, n.folder, r.rotation_interval_days
+        FROM (
+            SELECT user_id, folder FROM vault_entries WHERE user_id IS NOT NULL AND folder IS NOT NULL AND folder <> ''
+            UNION
+            SELECT user_id, folder FROM vault_folder_rotation
+        ) n
+        LEFT JOIN vault_folder_rotation r ON r.user_id = n.user_id AND r.folder = n.folder
+    LOOP
+        parent := NULL;
+        FOREACH segment IN ARRAY string_to_array(legacy.folder, '/') LOOP
+            CONTINUE WHEN trim(segment) = '';
+            SELECT id INTO child FROM vault_folders
+            WHERE user_id = legacy.user_id AND parent_id IS NOT DISTINCT FROM parent AND name = segment;
+            IF NOT FOUND THEN
+                INSERT INTO vault_folders (user_id, parent_id, name)
+                VALUES (legacy.user_id, parent, segment)
+                RETURNING id INTO child;
+            END IF;
+            parent := child;
+        END LOOP;
+
+        -- a path of only separators stays at the top level
+        CONTINUE WHEN parent IS NULL;
+        IF legacy.rotation_interval_days IS NOT NULL THEN
+            UPDATE vault_folders SET rotation_interval_days = legacy.rotation_interval_days WHERE id = parent;
+        END IF;
+        INSERT INTO vault_folder_migration (user_id, folder, folder_id) VALUES (legacy.user_id, legacy.folder, parent);
+    END LOOP;
+END
+$$;
+
+UPDATE vault_entries e SET folder_id = m.folder_id
+FROM vault_folder_migration m
+WHERE m.user_id = e.user_id AND m.folder = e.folder;
+
+DROP TABLE vault_folder_migration;
+
+CREATE INDEX IF NOT EXISTS vault_entries_folder_id_idx ON vault_entries (folder_id);
+
+-- the search vector can no longer see folders, they are matched by path instead
+DROP INDEX IF EXISTS vault_entries_search_idx;
+DROP FUNCTION IF EXISTS vault_entry_search_vector(TEXT, TEXT, TEXT, TEXT[], TEXT);
+
+ALTER TABLE vault_entries
+    DROP COLUMN IF EXISTS folder;
+
+DROP TABLE IF EXISTS vault_folder_rotation;
+
+CREATE OR REPLACE FUNCTION vault_entry_search_vector(title TEXT, username TEXT, domain TEXT, tags TEXT[])
+    RETURNS tsvector
+    LANGUAGE sql
+    IMMUTABLE PARALLEL SAFE
+AS $$
+    SELECT setweight(to_tsvector('simple', coalesce(title, '')), 'A') ||
+           setweight(to_tsvector('simple', coalesce(username, '')), 'B') ||
+           setweight(to_tsvector('simple', coalesce(domain, '') || ' ' || coalesce(array_to_string(tags, ' '), '')), 'C')
+$$;
+
+CREATE INDEX IF NOT EXISTS vault_entries_search_idx ON vault_entries
+    USING GIN (vault_entry_search_vector(title, username, domain, tags));
+
+-- vault_folder_path returns the names from the top level folder down to the folder, separated by '/'
+CREATE OR REPLACE FUNCTION vault_folder_path(start_id UUID)
+    RETURNS TEXT
+    LANGUAGE sql
+    STABLE
+AS $$
+    WITH RECURSIVE chain AS (
+        SELECT id, parent_id, name, 0 AS depth FROM vault_folders WHERE id = start_id
+        UNION ALL
+        SELECT f.id, f.parent_id, f.name, c.depth + 1 FROM vault_folders f JOIN chain c ON f.id = c.parent_id
+    )
+    SELECT string_agg(name, '/' ORDER BY depth DESC) FROM chain
+$$;
+
+-- vault_folder_rotation_days returns the rotation interval of the folder or of its closest ancestor with one
+CREATE OR REPLACE FUNCTION vault_folder_rotation_days(start_id UUID)
+    RETURNS INTEGER
+    LANGUAGE sql
+    STABLE
+AS $$
+    WITH RECURSIVE chain AS (
+        SELECT id, parent_id, rotation_interval_days, 0 AS depth FROM vault_folders WHERE id = start_id
+        UNION ALL
+        SELECT f.id, f.parent_id, f.rotation_interval_days, c.depth + 1 FROM vault_folders f JOIN chain c ON f.id = c.parent_id
+    )
+    SELECT rotation_interval_days FROM chain WHERE rotation_interval_days IS NOT NULL ORDER BY depth LIMIT 1
+$$;
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS vault_entries_last_used_idx;
+
+ALTER TABLE vault_entries
+    DROP COLUMN IF EXISTS favorite,
+    DROP COLUMN IF EXISTS last_used_at;
@@ -0,0 +1,6 @@
+ALTER TABLE vault_entries
+    ADD COLUMN IF NOT EXISTS favorite BOOLEAN NOT NULL DEFAULT FALSE,
+    ADD COLUMN IF NOT EXISTS last_used_at TIMESTAMP WITH TIME ZONE;
+
+CREATE INDEX IF NOT EXISTS vault_entries_last_used_idx ON vault_entries (user_id, last_used_at DESC)
+    WHERE last_used_at IS NOT NULL;
@@ -0,0 +1,12 @@
+ALTER TABLE vault_entries
+    ADD COLUMN IF NOT EXISTS uris JSONB NOT NULL DEFAULT '[]';
+
+-- every existing domain becomes a base domain URI, its host is the domain without scheme, user info,
+-- port, path, query and fragment
+UPDATE vault_entries
+SET uris = jsonb_build_array(jsonb_build_object(
+        'uri', domain,
+        'match', 'base_domain',
+        'host', lower(regexp_replace(regexp_replace(regexp_replace(
+            domain, '^[A-Za-z][A-Za-z0-9+.-]*://', ''), '^[^@/]*@', ''), '[/:?#].*$', ''))))
+WHERE trim(coalesce(domain, '')) <> '';